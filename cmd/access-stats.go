@@ -0,0 +1,206 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// accessStatsMaxEntries bounds the number of tracked objects, the
+	// coldest entries are evicted when the tracker is full.
+	accessStatsMaxEntries = 10000
+
+	// accessStatsEvictBatch is the number of entries one eviction sweep
+	// removes, evicting in batches amortizes the cost of scanning all
+	// entries for the coldest ones.
+	accessStatsEvictBatch = accessStatsMaxEntries / 10
+
+	// accessStatsHalfLife is the time it takes for an access score to
+	// decay to half its value when the object is not accessed.
+	accessStatsHalfLife = time.Hour
+)
+
+// accessScore is a decayed access counter.
+type accessScore struct {
+	gets float64
+	puts float64
+	last time.Time
+}
+
+// decay ages the scores relative to the last recorded access.
+func (a *accessScore) decay(now time.Time) {
+	if dt := now.Sub(a.last); dt > 0 {
+		f := math.Exp2(-float64(dt) / float64(accessStatsHalfLife))
+		a.gets *= f
+		a.puts *= f
+	}
+}
+
+func (a *accessScore) total() float64 {
+	return a.gets + a.puts
+}
+
+// accessStats maintains approximate per-object access counters with
+// exponential decay so that recently hot objects rank above historically
+// busy ones.
+type accessStats struct {
+	sync.Mutex
+	entries  map[string]*accessScore
+	evicting bool
+}
+
+var globalAccessStats = &accessStats{entries: make(map[string]*accessScore)}
+
+// record counts a read or write access of bucket/object.
+func (s *accessStats) record(bucket, object string, write bool) {
+	key := pathJoin(bucket, object)
+	now := UTCNow()
+
+	s.Lock()
+	defer s.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &accessScore{last: now}
+		s.entries[key] = e
+		if len(s.entries) > accessStatsMaxEntries && !s.evicting {
+			// Scanning all entries for the coldest ones is too
+			// expensive for the request path, evict in the background.
+			s.evicting = true
+			go s.evictColdest()
+		}
+	}
+	e.decay(now)
+	if write {
+		e.puts++
+	} else {
+		e.gets++
+	}
+	e.last = now
+}
+
+// evictColdest removes the coldest entries until the tracker is below its
+// bound again. Stored scores decay from different last access times, they
+// are aged to a common point before comparing. The tracker may briefly
+// exceed its bound while a sweep is pending.
+func (s *accessStats) evictColdest() {
+	now := UTCNow()
+
+	type agedScore struct {
+		key   string
+		score float64
+	}
+
+	s.Lock()
+	aged := make([]agedScore, 0, len(s.entries))
+	for k, e := range s.entries {
+		f := 1.0
+		if dt := now.Sub(e.last); dt > 0 {
+			f = math.Exp2(-float64(dt) / float64(accessStatsHalfLife))
+		}
+		aged = append(aged, agedScore{key: k, score: e.total() * f})
+	}
+	s.Unlock()
+
+	// Sort outside the lock, record calls keep flowing meanwhile.
+	sort.Slice(aged, func(i, j int) bool {
+		return aged[i].score < aged[j].score
+	})
+
+	s.Lock()
+	for i := 0; i < len(aged) && len(s.entries) > accessStatsMaxEntries-accessStatsEvictBatch; i++ {
+		delete(s.entries, aged[i].key)
+	}
+	s.evicting = false
+	s.Unlock()
+}
+
+// AccessStatsEntry is a single entry of the top accessed objects or
+// prefixes returned by the admin extension API.
+type AccessStatsEntry struct {
+	Path       string    `json:"path"`
+	GetScore   float64   `json:"getScore"`
+	PutScore   float64   `json:"putScore"`
+	LastAccess time.Time `json:"lastAccess,omitempty"`
+}
+
+// top returns the hottest entries, aggregated per prefix when byPrefix is
+// set and optionally filtered by bucket.
+func (s *accessStats) top(count int, bucket string, byPrefix bool) []AccessStatsEntry {
+	now := UTCNow()
+
+	s.Lock()
+	merged := make(map[string]AccessStatsEntry, len(s.entries))
+	for k, e := range s.entries {
+		if bucket != "" && !strings.HasPrefix(k, bucket+SlashSeparator) {
+			continue
+		}
+		// Age the scores without mutating the entry.
+		f := 1.0
+		if dt := now.Sub(e.last); dt > 0 {
+			f = math.Exp2(-float64(dt) / float64(accessStatsHalfLife))
+		}
+		key := k
+		if byPrefix {
+			if dir := path.Dir(k); dir != "." {
+				key = dir
+			}
+		}
+		m := merged[key]
+		m.Path = key
+		m.GetScore += e.gets * f
+		m.PutScore += e.puts * f
+		if e.last.After(m.LastAccess) {
+			m.LastAccess = e.last
+		}
+		merged[key] = m
+	}
+	s.Unlock()
+
+	entries := make([]AccessStatsEntry, 0, len(merged))
+	for _, m := range merged {
+		entries = append(entries, m)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].GetScore+entries[i].PutScore > entries[j].GetScore+entries[j].PutScore
+	})
+	if count > 0 && len(entries) > count {
+		entries = entries[:count]
+	}
+	return entries
+}
+
+// recordAPIAccess records object level access statistics for the S3 data
+// path APIs.
+func recordAPIAccess(api, bucket, object string) {
+	if bucket == "" || object == "" {
+		return
+	}
+	switch api {
+	case "GetObject", "HeadObject":
+		globalAccessStats.record(bucket, object, false)
+	case "PutObject", "CopyObject", "CompleteMultipartUpload":
+		globalAccessStats.record(bucket, object, true)
+	}
+}
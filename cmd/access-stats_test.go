@@ -0,0 +1,114 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAccessStatsTop(t *testing.T) {
+	stats := &accessStats{entries: make(map[string]*accessScore)}
+
+	for range 3 {
+		stats.record("bucket", "prefix/hot", false)
+	}
+	stats.record("bucket", "prefix/cold", false)
+	stats.record("bucket", "other/obj", true)
+	stats.record("second", "obj", false)
+
+	top := stats.top(0, "", false)
+	if len(top) != 4 {
+		t.Fatalf("expected 4 entries, got %d", len(top))
+	}
+	if top[0].Path != "bucket/prefix/hot" {
+		t.Fatalf("expected bucket/prefix/hot to be hottest, got %s", top[0].Path)
+	}
+	if top[0].GetScore < 2.99 {
+		t.Fatalf("unexpected get score: %f", top[0].GetScore)
+	}
+
+	// Filtered by bucket.
+	top = stats.top(0, "second", false)
+	if len(top) != 1 || top[0].Path != "second/obj" {
+		t.Fatalf("unexpected bucket filtered entries: %+v", top)
+	}
+
+	// Aggregated per prefix.
+	top = stats.top(1, "bucket", true)
+	if len(top) != 1 || top[0].Path != "bucket/prefix" {
+		t.Fatalf("unexpected prefix aggregation: %+v", top)
+	}
+	if top[0].GetScore < 3.99 {
+		t.Fatalf("unexpected aggregated get score: %f", top[0].GetScore)
+	}
+}
+
+func TestAccessStatsDecay(t *testing.T) {
+	stats := &accessStats{entries: make(map[string]*accessScore)}
+	stats.record("bucket", "object", false)
+
+	// Pretend the access happened one half-life ago.
+	stats.entries["bucket/object"].last = UTCNow().Add(-accessStatsHalfLife)
+
+	top := stats.top(0, "", false)
+	if len(top) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(top))
+	}
+	if top[0].GetScore > 0.51 || top[0].GetScore < 0.49 {
+		t.Fatalf("expected score to decay to ~0.5, got %f", top[0].GetScore)
+	}
+}
+
+func TestAccessStatsEvict(t *testing.T) {
+	stats := &accessStats{entries: make(map[string]*accessScore)}
+	now := UTCNow()
+	for i := 0; i < accessStatsMaxEntries; i++ {
+		stats.entries[fmt.Sprintf("bucket/object-%d", i)] = &accessScore{gets: float64(i + 1), last: now}
+	}
+	// A nominally high but day-old score must rank below fresh low ones
+	// once aged to a common point.
+	coldest := "bucket/coldest"
+	stats.entries[coldest] = &accessScore{gets: 2, last: now.Add(-24 * time.Hour)}
+
+	stats.record("bucket", "new", false)
+
+	// The sweep runs in the background, wait for it to finish.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		stats.Lock()
+		evicting := stats.evicting
+		_, ok := stats.entries[coldest]
+		n := len(stats.entries)
+		stats.Unlock()
+		if !evicting {
+			if ok {
+				t.Fatal("expected the coldest entry to be evicted")
+			}
+			if n > accessStatsMaxEntries {
+				t.Fatalf("tracker exceeds max entries: %d", n)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("eviction sweep did not finish")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
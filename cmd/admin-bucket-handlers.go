@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,10 +34,13 @@ import (
 	"github.com/klauspost/compress/zip"
 	"github.com/minio/kms-go/kes"
 	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/internal/bucket/bandwidth"
 	"github.com/minio/minio/internal/bucket/lifecycle"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/versioning"
+	"github.com/minio/minio/internal/config/storageclass"
 	"github.com/minio/minio/internal/event"
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/kms"
@@ -94,7 +98,7 @@ func (a adminAPIHandlers) PutBucketQuotaConfigHandler(w http.ResponseWriter, r *
 		Quota:     data,
 		UpdatedAt: updatedAt,
 	}
-	if quotaConfig.Size == 0 && quotaConfig.Quota == 0 {
+	if quotaConfig.Size == 0 && quotaConfig.Quota == 0 && quotaConfig.MaxObjects == 0 {
 		bucketMeta.Quota = nil
 	}
 
@@ -138,6 +142,630 @@ func (a adminAPIHandlers) GetBucketQuotaConfigHandler(w http.ResponseWriter, r *
 	writeSuccessResponseJSON(w, configData)
 }
 
+// BucketQuotaUsage captures a bucket's current usage against its
+// configured quota limits, returned by the bucket-quota-usage admin
+// API so clients can pre-check capacity before large ingests.
+type BucketQuotaUsage struct {
+	Bucket         string `json:"bucket"`
+	QuotaSize      uint64 `json:"quotaSize,omitempty"`
+	MaxObjects     uint64 `json:"maxObjects,omitempty"`
+	CurrentSize    uint64 `json:"currentSize"`
+	CurrentObjects uint64 `json:"currentObjects"`
+}
+
+// GetBucketQuotaUsageHandler - gets bucket usage against its configured
+// quota limits. Usage is served from the data usage cache and may lag
+// the namespace by up to a scanner cycle.
+func (a adminAPIHandlers) GetBucketQuotaUsageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.GetBucketQuotaAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	usage := BucketQuotaUsage{Bucket: bucket}
+	q, _, err := globalBucketMetadataSys.GetQuotaConfig(ctx, bucket)
+	if err != nil && !errors.Is(err, BucketQuotaConfigNotFound{Bucket: bucket}) {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if q != nil && q.Type == madmin.HardQuota {
+		if q.Size > 0 {
+			usage.QuotaSize = q.Size
+		} else if q.Quota > 0 {
+			usage.QuotaSize = q.Quota
+		}
+		usage.MaxObjects = q.MaxObjects
+	}
+
+	bui := globalBucketQuotaSys.GetBucketUsageInfo(ctx, bucket)
+	usage.CurrentSize = bui.Size
+	usage.CurrentObjects = bui.ObjectsCount
+
+	data, err := json.Marshal(usage)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, data)
+}
+
+// IssueDeleteBucketTokenHandler - issues a short-lived single-use
+// confirmation token that must accompany a force DeleteBucket of a
+// non-empty bucket.
+func (a adminAPIHandlers) IssueDeleteBucketTokenHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ImportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	token, err := issueBucketDeleteToken(ctx, objectAPI, bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, data)
+}
+
+// SetBucketResponseHeadersConfigHandler - PUT Bucket response headers
+// configuration, the configured headers are injected into GET/HEAD
+// responses when object metadata does not already set them.
+func (a adminAPIHandlers) SetBucketResponseHeadersConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ImportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxEConfigJSONSize))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if len(data) > 0 {
+		if _, err = parseBucketResponseHeadersConfig(data); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+			return
+		}
+	}
+
+	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketResponseHeadersConfig, data); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketResponseHeadersConfigHandler - gets bucket response headers
+// configuration.
+func (a adminAPIHandlers) GetBucketResponseHeadersConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ExportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, err := globalBucketMetadataSys.GetResponseHeadersConfig(bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if config == nil {
+		config = &responseHeadersConfig{}
+	}
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, configData)
+}
+
+// SetBucketStorageClassConfigHandler - sets the default storage class
+// (EC:N) of a bucket, overriding the global default parity for new
+// objects written without an explicit storage class. The parity is
+// validated against the smallest erasure set.
+func (a adminAPIHandlers) SetBucketStorageClassConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ImportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxEConfigJSONSize))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if len(data) > 0 {
+		cfg, err := parseBucketStorageClassConfig(data)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+			return
+		}
+		if parity := cfg.Parity(); parity >= 0 {
+			for _, setDriveCount := range objectAPI.SetDriveCounts() {
+				if err := storageclass.ValidateParity(parity, setDriveCount); err != nil {
+					writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+					return
+				}
+			}
+		}
+	}
+
+	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketStorageClassConfigFile, data); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketStorageClassConfigHandler - gets the default storage class of
+// a bucket.
+func (a adminAPIHandlers) GetBucketStorageClassConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ExportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, err := globalBucketMetadataSys.GetStorageClassConfig(bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if config == nil {
+		config = &bucketStorageClassConfig{}
+	}
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, configData)
+}
+
+// SetBucketPlacementConfigHandler - sets the pool placement hints of a
+// bucket. New objects of the bucket are placed on the preferred pools as
+// long as they have space, lookups of existing objects are unaffected.
+func (a adminAPIHandlers) SetBucketPlacementConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ImportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxEConfigJSONSize))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if len(data) > 0 {
+		cfg, err := parseBucketPlacementConfig(data)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+			return
+		}
+		// SetDriveCounts returns one entry per pool.
+		poolCount := len(objectAPI.SetDriveCounts())
+		for _, pool := range cfg.Pools {
+			if pool >= poolCount {
+				writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON,
+					fmt.Errorf("pool index %d out of range, %d pools available", pool, poolCount)), r.URL)
+				return
+			}
+		}
+	}
+
+	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketPlacementConfigFile, data); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketPlacementConfigHandler - gets the pool placement hints of a
+// bucket.
+func (a adminAPIHandlers) GetBucketPlacementConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ExportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, err := globalBucketMetadataSys.GetPlacementConfig(bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if config == nil {
+		config = &bucketPlacementConfig{}
+	}
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, configData)
+}
+
+// SetBucketSoftPurgeConfigHandler - sets the soft purge window of a
+// bucket. While enabled, deleting a specific version preserves a copy in
+// the trash namespace that can be undeleted within the window.
+func (a adminAPIHandlers) SetBucketSoftPurgeConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ImportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxEConfigJSONSize))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if len(data) > 0 {
+		if _, err := parseSoftPurgeConfig(data); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+			return
+		}
+	}
+
+	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketSoftPurgeConfigFile, data); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketSoftPurgeConfigHandler - gets the soft purge window of a
+// bucket.
+func (a adminAPIHandlers) GetBucketSoftPurgeConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ExportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, err := globalBucketMetadataSys.GetSoftPurgeConfig(bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if config == nil {
+		config = &softPurgeConfig{}
+	}
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, configData)
+}
+
+// UndeleteObjectHandler - restores a trashed version as the latest
+// version of its object while the soft purge window has not elapsed.
+func (a adminAPIHandlers) UndeleteObjectHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+	object := vars["object"]
+	versionID := vars["versionId"]
+	if object == "" || versionID == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	oi, err := undeleteObjectVersion(ctx, objectAPI, bucket, object, versionID)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	resp, err := json.Marshal(map[string]string{
+		"bucket":    oi.Bucket,
+		"object":    oi.Name,
+		"versionId": oi.VersionID,
+		"etag":      oi.ETag,
+	})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, resp)
+}
+
+// SetBucketReplicationPauseHandler - pauses or resumes replication on a
+// bucket. Pausing is a kill switch for incident response: no new work is
+// dispatched to replication workers, queued and MRF state is preserved
+// and replays once replication is resumed.
+func (a adminAPIHandlers) SetBucketReplicationPauseHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.SetBucketTargetAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Pausing replication on a bucket without a replication config is a
+	// no-op, reject it to catch operator mistakes early.
+	if _, _, err := globalBucketMetadataSys.GetReplicationConfig(ctx, bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	cfg := replicationPauseConfig{
+		Paused:    r.Form.Get("pause") == "true",
+		UpdatedAt: UTCNow(),
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketReplicationPauseConfig, data); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketReplicationPauseHandler - gets the replication pause state of
+// a bucket.
+func (a adminAPIHandlers) GetBucketReplicationPauseHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.GetBucketTargetAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, err := globalBucketMetadataSys.GetReplicationPauseConfig(bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if config == nil {
+		config = &replicationPauseConfig{}
+	}
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, configData)
+}
+
+// SetBucketBandwidthLimitHandler - sets the egress bandwidth limit enforced
+// on regular GET requests for a bucket. The limit is the aggregate across the
+// cluster in bytes per second, a limit of zero removes throttling. The limit
+// is held in memory on each node and is not persisted across restarts.
+func (a adminAPIHandlers) SetBucketBandwidthLimitHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.SetBucketQuotaAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	limit, err := strconv.ParseInt(r.Form.Get("limit"), 10, 64)
+	if err != nil || limit < 0 {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	globalNotificationSys.SetBucketBandwidthLimit(ctx, bucket, limit)
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// BandwidthMonitorHandler - returns the aggregated bandwidth report for the
+// requested buckets from all nodes, covering both replication and egress
+// throttling.
+func (a adminAPIHandlers) BandwidthMonitorHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.BandwidthMonitorAction)
+	if objectAPI == nil {
+		return
+	}
+
+	var buckets []string
+	if v := r.Form.Get("buckets"); v != "" {
+		buckets = strings.Split(v, ",")
+	}
+	rpt := globalNotificationSys.GetBandwidthReports(ctx, buckets...)
+
+	// Flatten the map to a JSON friendly form, keyed by bucket
+	// or "bucket@arn" for replication targets.
+	stats := make(map[string]bandwidth.Details, len(rpt.BucketStats))
+	for opts, details := range rpt.BucketStats {
+		key := opts.Name
+		if opts.ReplicationARN != "" {
+			key = opts.Name + "@" + opts.ReplicationARN
+		}
+		stats[key] = details
+	}
+
+	data, err := json.Marshal(stats)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, data)
+}
+
 // SetRemoteTargetHandler - sets a remote target for bucket
 func (a adminAPIHandlers) SetRemoteTargetHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1055,6 +1683,71 @@ func (a adminAPIHandlers) ReplicationDiffHandler(w http.ResponseWriter, r *http.
 	}
 }
 
+// ReplicationMRFRequeueHandler - POST forces an immediate requeue of the MRF
+// backlog entries for a node or all nodes instead of waiting for the next
+// retry cycle.
+func (a adminAPIHandlers) ReplicationMRFRequeueHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if bucket exists.
+	if bucket != "" {
+		if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
+	node := r.Form.Get("node")
+	if node == "" {
+		node = "all"
+	}
+	if err := globalNotificationSys.RequeueReplicationMRF(ctx, bucket, node); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// ReplicationMRFDropHandler - DELETE drops the MRF backlog entries for a node
+// or all nodes so they are no longer retried.
+func (a adminAPIHandlers) ReplicationMRFDropHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if bucket exists.
+	if bucket != "" {
+		if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
+	node := r.Form.Get("node")
+	if node == "" {
+		node = "all"
+	}
+	if err := globalNotificationSys.DropReplicationMRF(ctx, bucket, node); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseHeadersOnly(w)
+}
+
 // ReplicationMRFHandler - POST returns info on entries in the MRF backlog for a node or all nodes
 func (a adminAPIHandlers) ReplicationMRFHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1113,3 +1806,119 @@ func (a adminAPIHandlers) ReplicationMRFHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 }
+
+// bucketProvisionTemplate describes the desired state of a bucket created
+// through the delegated provisioning API.
+type bucketProvisionTemplate struct {
+	Versioning    bool                `json:"versioning"`
+	ObjectLocking bool                `json:"objectLocking"`
+	Quota         *madmin.BucketQuota `json:"quota,omitempty"`
+	Tags          map[string]string   `json:"tags,omitempty"`
+	LifecycleXML  string              `json:"lifecycleXML,omitempty"`
+}
+
+// ProvisionBucketHandler - PUT /minio/admin/v3/provision-bucket?bucket={bucket}
+// ----------
+// Creates a bucket from a provisioning template, applying versioning,
+// object locking, quota, tags and lifecycle in a single delegated call.
+func (a adminAPIHandlers) ProvisionBucketHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ImportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+	if err := s3utils.CheckValidBucketNameStrict(bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidBucketName), r.URL)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxEConfigJSONSize))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	var template bucketProvisionTemplate
+	if err = json.Unmarshal(data, &template); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+
+	// Validate all template sections before creating anything.
+	var quotaData []byte
+	if template.Quota != nil {
+		if quotaData, err = json.Marshal(template.Quota); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		if _, err = parseBucketQuota(bucket, quotaData); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+	var tagsData []byte
+	if len(template.Tags) > 0 {
+		t, err := tags.NewTags(template.Tags, false)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		if tagsData, err = xml.Marshal(t); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+	var lifecycleData []byte
+	if template.LifecycleXML != "" {
+		bucketLifecycle, err := lifecycle.ParseLifecycleConfigWithID(strings.NewReader(template.LifecycleXML))
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		rcfg, _ := globalBucketObjectLockSys.Get(bucket)
+		if err = bucketLifecycle.Validate(rcfg); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		if lifecycleData, err = xml.Marshal(bucketLifecycle); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+
+	if err = objectAPI.MakeBucket(ctx, bucket, MakeBucketOptions{
+		LockEnabled:       template.ObjectLocking,
+		VersioningEnabled: template.Versioning || template.ObjectLocking,
+	}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	globalNotificationSys.LoadBucketMetadata(GlobalContext, bucket)
+
+	if quotaData != nil {
+		if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketQuotaConfigFile, quotaData); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+	if tagsData != nil {
+		if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketTaggingConfig, tagsData); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+	if lifecycleData != nil {
+		if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketLifecycleConfig, lifecycleData); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
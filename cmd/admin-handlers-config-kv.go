@@ -316,6 +316,32 @@ func (a adminAPIHandlers) ClearConfigHistoryKVHandler(w http.ResponseWriter, r *
 	}
 }
 
+// RestoreConfigBackupHandler - restores the cluster configuration and IAM
+// data from a scheduled backup snapshot, all nodes must be restarted to
+// pick up the restored state.
+func (a adminAPIHandlers) RestoreConfigBackupHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ConfigUpdateAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	bucket := pathClean(r.Form.Get("bucket"))
+	backupPath := pathClean(r.Form.Get("path"))
+	if bucket == "" || backupPath == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if err := restoreConfigBackup(ctx, objectAPI, bucket, backupPath); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
 // RestoreConfigHistoryKVHandler - restores a config with KV settings for the given KV id.
 func (a adminAPIHandlers) RestoreConfigHistoryKVHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -0,0 +1,195 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// erasureDistMismatch describes a single object version whose erasure
+// distribution in xl.meta does not match the current set layout.
+type erasureDistMismatch struct {
+	Object       string `json:"object"`
+	VersionID    string `json:"versionId,omitempty"`
+	PoolIndex    int    `json:"poolIndex"`
+	SetIndex     int    `json:"setIndex"`
+	ExpectedDist []int  `json:"expectedDistribution"`
+	FoundDist    []int  `json:"foundDistribution"`
+	Healed       bool   `json:"healed,omitempty"`
+}
+
+// erasureDistReport is the response of ValidateErasureDistHandler.
+type erasureDistReport struct {
+	Bucket     string                `json:"bucket"`
+	Prefix     string                `json:"prefix,omitempty"`
+	Scanned    int                   `json:"scanned"`
+	Mismatched []erasureDistMismatch `json:"mismatched,omitempty"`
+}
+
+// ValidateErasureDistHandler - GET /minio/admin/v3/validate-erasure-dist?bucket={bucket}&prefix={prefix}&sample={n}&fix={true|false}
+// ----------
+// Samples objects under the given bucket/prefix and validates that the
+// erasure distribution recorded in xl.meta matches the distribution the
+// current set layout would compute for the object, flagging objects
+// written under a previous topology. With 'fix=true' flagged objects are
+// additionally submitted for healing.
+func (a adminAPIHandlers) ValidateErasureDistHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	bucket := pathClean(r.Form.Get("bucket"))
+	if bucket == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	prefix := r.Form.Get("prefix")
+	fix := r.Form.Get("fix") == "true"
+
+	sample := 100
+	if v := r.Form.Get("sample"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+		sample = n
+	}
+
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan itemOrErr[ObjectInfo])
+	if err := z.Walk(walkCtx, bucket, prefix, results, WalkOptions{
+		LatestOnly: true,
+		Limit:      sample,
+	}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	report := erasureDistReport{
+		Bucket: bucket,
+		Prefix: prefix,
+	}
+	for res := range results {
+		if res.Err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, res.Err), r.URL)
+			return
+		}
+		oi := res.Item
+		if oi.DeleteMarker || oi.IsRemote() {
+			continue
+		}
+		report.Scanned++
+
+		mismatch, err := z.validateErasureDist(ctx, bucket, oi.Name, oi.VersionID)
+		if err != nil {
+			// object may have been deleted in the meantime, skip it.
+			if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+				continue
+			}
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		if mismatch == nil {
+			continue
+		}
+		if fix {
+			_, err := z.HealObject(ctx, bucket, oi.Name, oi.VersionID, madmin.HealOpts{
+				ScanMode: madmin.HealNormalScan,
+			})
+			mismatch.Healed = err == nil
+		}
+		report.Mismatched = append(report.Mismatched, *mismatch)
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, data)
+}
+
+// validateErasureDist compares the erasure distribution stored in xl.meta
+// for an object version against the distribution computed from the current
+// set layout, returns a non-nil mismatch description if they differ.
+func (z *erasureServerPools) validateErasureDist(ctx context.Context, bucket, object, versionID string) (*erasureDistMismatch, error) {
+	object = encodeDirObject(object)
+
+	idx, err := z.getPoolIdxExistingNoLock(ctx, bucket, object)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := z.serverPools[idx]
+	set := pool.getHashedSet(object)
+	fi, _, _, err := set.getObjectFileInfo(ctx, bucket, object, ObjectOptions{
+		VersionID: versionID,
+		NoLock:    true,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := hashOrder(pathJoin(bucket, object), set.setDriveCount)
+	matches := len(fi.Erasure.Distribution) == len(expected)
+	if matches {
+		for i := range expected {
+			if fi.Erasure.Distribution[i] != expected[i] {
+				matches = false
+				break
+			}
+		}
+	}
+	if matches {
+		return nil, nil
+	}
+
+	return &erasureDistMismatch{
+		Object:       fi.Name,
+		VersionID:    fi.VersionID,
+		PoolIndex:    idx,
+		SetIndex:     pool.getHashedSetIndex(object),
+		ExpectedDist: expected,
+		FoundDist:    fi.Erasure.Distribution,
+	}, nil
+}
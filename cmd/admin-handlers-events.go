@@ -0,0 +1,235 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/store"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// replayEventsResult is the JSON response of ReplayEventsHandler.
+type replayEventsResult struct {
+	TargetARN    string `json:"targetArn"`
+	EventsSent   int64  `json:"eventsSent"`
+	EventsFailed int64  `json:"eventsFailed"`
+	FirstError   string `json:"firstError,omitempty"`
+}
+
+// ReplayEventsHandler - POST /minio/admin/v3/replay-events?arn=...
+//
+// Re-sends bucket notification events to a chosen notification target,
+// to recover from consumer-side outages or data loss. Two sources are
+// supported:
+//
+//   - source=scan (default): regenerates s3:ObjectCreated:Put (and
+//     s3:ObjectRemoved:DeleteMarkerCreated) events by scanning object
+//     versions under bucket/prefix, optionally restricted to a
+//     before/after modification time window.
+//   - source=store: replays events persisted in the queue store of the
+//     target identified by sourceArn, optionally filtered by bucket,
+//     prefix and time window. Events are copied, not drained - the
+//     source store is left untouched.
+func (a adminAPIHandlers) ReplayEventsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, policy.ConfigUpdateAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	arn := r.Form.Get("arn")
+	target := lookupEventTarget(arn)
+	if target == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrARNNotification, nil), r.URL)
+		return
+	}
+
+	var before, after time.Time
+	var err error
+	if v := r.Form.Get("before"); v != "" {
+		if before, err = time.Parse(time.RFC3339, v); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+	if v := r.Form.Get("after"); v != "" {
+		if after, err = time.Parse(time.RFC3339, v); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+
+	bucket := r.Form.Get("bucket")
+	prefix := r.Form.Get("prefix")
+
+	result := replayEventsResult{TargetARN: arn}
+	switch source := r.Form.Get("source"); source {
+	case "store":
+		src := lookupEventTarget(r.Form.Get("sourceArn"))
+		if src == nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrARNNotification, nil), r.URL)
+			return
+		}
+		err = replayEventsFromStore(ctx, src, target, bucket, prefix, after, before, &result)
+	case "", "scan":
+		if bucket == "" {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+		if _, err = objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		err = replayEventsFromScan(ctx, objectAPI, target, bucket, prefix, after, before, cred.AccessKey, &result)
+	default:
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	buf, err := json.Marshal(result)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, buf)
+}
+
+// lookupEventTarget returns the registered notification target whose ARN
+// matches the given string, nil if there is none.
+func lookupEventTarget(arnStr string) event.Target {
+	if arnStr == "" {
+		return nil
+	}
+	region := globalSite.Region()
+	for tid, target := range globalEventNotifier.targetList.TargetMap() {
+		if tid.ToARN(region).String() == arnStr {
+			return target
+		}
+	}
+	return nil
+}
+
+// replayEventsFromScan walks object versions under bucket/prefix and
+// synthesizes notification events for each version whose modification
+// time falls within the given window.
+func replayEventsFromScan(ctx context.Context, objectAPI ObjectLayer, target event.Target, bucket, prefix string, after, before time.Time, accessKey string, result *replayEventsResult) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan itemOrErr[ObjectInfo])
+	if err := objectAPI.Walk(ctx, bucket, prefix, results, WalkOptions{}); err != nil {
+		return err
+	}
+
+	reqParams := map[string]string{
+		"region":      globalSite.Region(),
+		"principalId": accessKey,
+	}
+	for res := range results {
+		if res.Err != nil {
+			return res.Err
+		}
+		oi := res.Item
+		if !after.IsZero() && oi.ModTime.Before(after) {
+			continue
+		}
+		if !before.IsZero() && oi.ModTime.After(before) {
+			continue
+		}
+		eventName := event.ObjectCreatedPut
+		if oi.DeleteMarker {
+			eventName = event.ObjectRemovedDeleteMarkerCreated
+		}
+		args := eventArgs{
+			EventName:  eventName,
+			BucketName: bucket,
+			Object:     oi,
+			ReqParams:  reqParams,
+			Host:       globalLocalNodeName,
+			UserAgent:  "Internal: [Event-Replay]",
+		}
+		sendReplayEvent(target, args.ToEvent(true), result)
+	}
+	return nil
+}
+
+// replayEventsFromStore copies the events persisted in the source
+// target's queue store to the destination target, filtered by bucket,
+// prefix and event time window. Entries are not removed from the source
+// store.
+func replayEventsFromStore(ctx context.Context, src, target event.Target, bucket, prefix string, after, before time.Time, result *replayEventsResult) error {
+	eventStore, ok := src.Store().(store.Store[event.Event])
+	if !ok || eventStore == nil {
+		return NotImplemented{Message: "source target has no persistent event store"}
+	}
+
+	for _, key := range eventStore.List() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		events, err := eventStore.GetMultiple(key)
+		if err != nil {
+			// Entry may have been sent and deleted concurrently.
+			continue
+		}
+		for _, ev := range events {
+			if bucket != "" && ev.S3.Bucket.Name != bucket {
+				continue
+			}
+			if prefix != "" && !strings.HasPrefix(ev.S3.Object.Key, prefix) {
+				continue
+			}
+			if !after.IsZero() || !before.IsZero() {
+				eventTime, err := time.Parse(event.AMZTimeFormat, ev.EventTime)
+				if err != nil {
+					continue
+				}
+				if !after.IsZero() && eventTime.Before(after) {
+					continue
+				}
+				if !before.IsZero() && eventTime.After(before) {
+					continue
+				}
+			}
+			sendReplayEvent(target, ev, result)
+		}
+	}
+	return nil
+}
+
+func sendReplayEvent(target event.Target, ev event.Event, result *replayEventsResult) {
+	if err := target.Save(ev); err != nil {
+		result.EventsFailed++
+		if result.FirstError == "" {
+			result.FirstError = err.Error()
+		}
+		return
+	}
+	result.EventsSent++
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/minio/pkg/v3/policy"
+)
+
+func TestMatchedPolicyStatement(t *testing.T) {
+	p, err := policy.ParseConfig(bytes.NewReader([]byte(`{
+ "Version": "2012-10-17",
+ "Statement": [
+  {
+   "Sid": "AllowGet",
+   "Effect": "Allow",
+   "Action": ["s3:GetObject"],
+   "Resource": ["arn:aws:s3:::mybucket/*"]
+  },
+  {
+   "Sid": "DenySecret",
+   "Effect": "Deny",
+   "Action": ["s3:GetObject"],
+   "Resource": ["arn:aws:s3:::mybucket/secret/*"]
+  }
+ ]
+}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		object      string
+		wantSID     string
+		wantAllowed bool
+	}{
+		{"file.txt", "AllowGet", true},
+		{"secret/file.txt", "DenySecret", false},
+	}
+	for _, testCase := range testCases {
+		args := policy.Args{
+			AccountName:     "user1",
+			Action:          policy.GetObjectAction,
+			BucketName:      "mybucket",
+			ObjectName:      testCase.object,
+			ConditionValues: map[string][]string{},
+		}
+		if got := p.IsAllowed(args); got != testCase.wantAllowed {
+			t.Errorf("object %s: expected allowed=%v, got %v", testCase.object, testCase.wantAllowed, got)
+		}
+		statement := matchedPolicyStatement(*p, args)
+		if statement == nil {
+			t.Errorf("object %s: expected matched statement %s, got none", testCase.object, testCase.wantSID)
+			continue
+		}
+		if string(statement.SID) != testCase.wantSID {
+			t.Errorf("object %s: expected matched statement %s, got %s", testCase.object, testCase.wantSID, statement.SID)
+		}
+	}
+
+	// No statement matches an unrelated bucket.
+	args := policy.Args{
+		AccountName:     "user1",
+		Action:          policy.GetObjectAction,
+		BucketName:      "otherbucket",
+		ObjectName:      "file.txt",
+		ConditionValues: map[string][]string{},
+	}
+	if statement := matchedPolicyStatement(*p, args); statement != nil {
+		t.Errorf("expected no matched statement, got %s", statement.SID)
+	}
+}
@@ -293,8 +293,35 @@ func (a adminAPIHandlers) RebalanceStart(w http.ResponseWriter, r *http.Request)
 		buckets = append(buckets, bInfo.Name)
 	}
 
+	// Optional per-pool target utilization watermarks, a comma separated
+	// list of used-space percentages with one entry per pool. Pools with
+	// a watermark only rebalance enough data to reach it, empty entries
+	// fall back to the computed global free space goal.
+	var watermarks []float64
+	if v := r.Form.Get("watermark"); v != "" {
+		fields := strings.Split(v, ",")
+		if len(fields) != len(pools.serverPools) {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx,
+				fmt.Errorf("watermark expects %d comma separated entries, got %d", len(pools.serverPools), len(fields))), r.URL)
+			return
+		}
+		watermarks = make([]float64, len(fields))
+		for i, field := range fields {
+			if field == "" {
+				continue
+			}
+			pct, err := strconv.ParseFloat(field, 64)
+			if err != nil || pct <= 0 || pct >= 100 {
+				writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx,
+					fmt.Errorf("invalid watermark %q, expects a percentage between 0 and 100", field)), r.URL)
+				return
+			}
+			watermarks[i] = pct
+		}
+	}
+
 	var id string
-	if id, err = pools.initRebalanceMeta(ctx, buckets); err != nil {
+	if id, err = pools.initRebalanceMeta(ctx, buckets, watermarks); err != nil {
 		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
@@ -377,6 +404,78 @@ func (a adminAPIHandlers) RebalanceStop(w http.ResponseWriter, r *http.Request)
 	globalNotificationSys.LoadRebalanceMeta(ctx, false)
 }
 
+// RebalancePause suspends an ongoing rebalance operation. Data movement
+// stops but rebalance metadata and progress are preserved, rebalance
+// continues from where it left off on rebalance-resume.
+func (a adminAPIHandlers) RebalancePause(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.RebalanceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	pools, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	if !pools.IsRebalanceStarted() {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminRebalanceNotStarted), r.URL)
+		return
+	}
+
+	// Cancel the rebalance routines without marking the operation stopped.
+	globalNotificationSys.StopRebalance(r.Context())
+	writeSuccessResponseHeadersOnly(w)
+	adminLogIf(ctx, pools.saveRebalanceStats(GlobalContext, 0, rebalSavePausedAt))
+	globalNotificationSys.LoadRebalanceMeta(ctx, false)
+}
+
+// RebalanceResume resumes a paused rebalance operation from its saved
+// progress.
+func (a adminAPIHandlers) RebalanceResume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.RebalanceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	pools, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	// Load the latest rebalance metadata, this node may not have an
+	// up to date view when pause was proxied elsewhere.
+	meta := &rebalanceMeta{}
+	if err := meta.load(ctx, pools.serverPools[0]); err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminRebalanceNotStarted), r.URL)
+			return
+		}
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if !meta.StoppedAt.IsZero() || meta.PausedAt.IsZero() {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminRebalanceNotStarted), r.URL)
+		return
+	}
+
+	if err := pools.saveRebalanceStats(GlobalContext, 0, rebalSaveResumedAt); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Restart rebalance routines on participating pool leaders.
+	pools.StartRebalance()
+	writeSuccessResponseHeadersOnly(w)
+	globalNotificationSys.LoadRebalanceMeta(ctx, true)
+}
+
 func proxyDecommissionRequest(ctx context.Context, defaultEndPoint Endpoint, w http.ResponseWriter, r *http.Request) (proxy bool) {
 	host := env.Get("_MINIO_DECOM_ENDPOINT_HOST", defaultEndPoint.Host)
 	if host == "" {
@@ -391,3 +490,98 @@ func proxyDecommissionRequest(ctx context.Context, defaultEndPoint Endpoint, w h
 	}
 	return
 }
+
+// SetReadOnlyMode - POST /minio/admin/v3/maintenance/readonly?enable={true|false}[&pool={pool}]
+//
+// Flips the cluster, or a single pool, in or out of read-only maintenance
+// mode. While enabled all mutating S3 calls are rejected with a 503,
+// GET/LIST continue to be served - useful during storage migrations.
+func (a adminAPIHandlers) SetReadOnlyMode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, policy.ServiceFreezeAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+
+	cfg := readOnlyModeConfig{
+		Enabled:   vars["enable"] == "true",
+		Pool:      readOnlyModeAllPools,
+		UpdatedBy: cred.AccessKey,
+		UpdatedAt: UTCNow(),
+	}
+
+	if v := r.Form.Get("pool"); v != "" {
+		idx := globalEndpoints.GetPoolIdx(v)
+		if idx == -1 {
+			// We didn't find any matching pools, invalid input
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errInvalidArgument), r.URL)
+			return
+		}
+		cfg.Pool = idx
+	}
+
+	if err := saveReadOnlyModeConfig(ctx, objectAPI, cfg); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// ReadOnlyModeStatus - GET /minio/admin/v3/maintenance/readonly
+func (a adminAPIHandlers) ReadOnlyModeStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	cfg := globalReadOnlyMode.Load()
+	if cfg == nil {
+		cfg = &readOnlyModeConfig{Pool: readOnlyModeAllPools}
+	}
+
+	adminLogIf(ctx, json.NewEncoder(w).Encode(cfg))
+}
+
+// SetNodeDrain - POST /minio/admin/v3/maintenance/drain?enable={true|false}
+//
+// Drains the node serving this request: new S3 requests are rejected with a
+// 503 pointing clients at a peer, in-flight operations run to completion and
+// the replication MRF queue is flushed to disk. Poll NodeDrainStatus on the
+// same node until it reports ready for shutdown, then take the node down for
+// maintenance. Disabling puts the node back into rotation.
+func (a adminAPIHandlers) SetNodeDrain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, policy.ServiceFreezeAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if mux.Vars(r)["enable"] == "true" {
+		startNodeDrain(cred.AccessKey)
+	} else {
+		stopNodeDrain()
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// NodeDrainStatus - GET /minio/admin/v3/maintenance/drain
+//
+// Reports the drain progress of the node serving this request.
+func (a adminAPIHandlers) NodeDrainStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	adminLogIf(ctx, json.NewEncoder(w).Encode(localNodeDrainStatus()))
+}
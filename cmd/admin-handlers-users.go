@@ -161,8 +161,23 @@ func (a adminAPIHandlers) ListUsers(w http.ResponseWriter, r *http.Request) {
 		allCredentials[k] = v
 	}
 
+	// Attach last-used timestamps - older madmin clients ignore the extra
+	// field.
+	type userInfoWithLastUsed struct {
+		madmin.UserInfo
+		LastUsed *time.Time `json:"lastUsed,omitempty"`
+	}
+	userInfos := make(map[string]userInfoWithLastUsed, len(allCredentials))
+	for k, v := range allCredentials {
+		info := userInfoWithLastUsed{UserInfo: v}
+		if lu := globalAccessKeyLastUsed.get(k); !lu.IsZero() {
+			info.LastUsed = &lu
+		}
+		userInfos[k] = info
+	}
+
 	// Marshal the response
-	data, err := json.Marshal(allCredentials)
+	data, err := json.Marshal(userInfos)
 	if err != nil {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
 		return
@@ -993,14 +1008,24 @@ func (a adminAPIHandlers) InfoServiceAccount(w http.ResponseWriter, r *http.Requ
 		expiration = &svcAccount.Expiration
 	}
 
-	infoResp := madmin.InfoServiceAccountResp{
-		ParentUser:    svcAccount.ParentUser,
-		Name:          svcAccount.Name,
-		Description:   svcAccount.Description,
-		AccountStatus: svcAccount.Status,
-		ImpliedPolicy: impliedPolicy,
-		Policy:        string(policyJSON),
-		Expiration:    expiration,
+	// Attach the last-used timestamp - older madmin clients ignore the
+	// extra field.
+	infoResp := struct {
+		madmin.InfoServiceAccountResp
+		LastUsed *time.Time `json:"lastUsed,omitempty"`
+	}{
+		InfoServiceAccountResp: madmin.InfoServiceAccountResp{
+			ParentUser:    svcAccount.ParentUser,
+			Name:          svcAccount.Name,
+			Description:   svcAccount.Description,
+			AccountStatus: svcAccount.Status,
+			ImpliedPolicy: impliedPolicy,
+			Policy:        string(policyJSON),
+			Expiration:    expiration,
+		},
+	}
+	if lu := globalAccessKeyLastUsed.get(accessKey); !lu.IsZero() {
+		infoResp.LastUsed = &lu
 	}
 
 	data, err := json.Marshal(infoResp)
@@ -1518,7 +1543,10 @@ func (a adminAPIHandlers) AccountInfoHandler(w http.ResponseWriter, r *http.Requ
 			}
 
 			lcfg, _ := globalBucketObjectLockSys.Get(bucket.Name)
-			quota, _ := globalBucketQuotaSys.Get(ctx, bucket.Name)
+			var quota *madmin.BucketQuota
+			if qcfg, _ := globalBucketQuotaSys.Get(ctx, bucket.Name); qcfg != nil {
+				quota = &qcfg.BucketQuota
+			}
 			rcfg, _, _ := globalBucketMetadataSys.GetReplicationConfig(ctx, bucket.Name)
 			tcfg, _, _ := globalBucketMetadataSys.GetTaggingConfig(bucket.Name)
 
@@ -2035,6 +2063,23 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
 		return
 	}
+
+	// Optional `since` timestamp for incremental exports - only entities
+	// updated at or after this time are included.
+	var since time.Time
+	if v := r.Form.Get("since"); v != "" {
+		var terr error
+		since, terr = time.Parse(time.RFC3339, v)
+		if terr != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, fmt.Errorf("invalid `since` value: %w", terr)), r.URL)
+			return
+		}
+	}
+	// Entities without a recorded update time are always included.
+	include := func(updatedAt time.Time) bool {
+		return since.IsZero() || updatedAt.IsZero() || !updatedAt.Before(since)
+	}
+
 	// Initialize a zip writer which will provide a zipped content
 	// of bucket metadata
 	zipWriter := zip.NewWriter(w)
@@ -2068,12 +2113,18 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 		iamFile := pathJoin(iamAssetsDir, f)
 		switch f {
 		case allPoliciesFile:
-			allPolicies, err := globalIAMSys.ListPolicies(ctx, "")
+			policyDocs, err := globalIAMSys.ListPolicyDocs(ctx, "")
 			if err != nil {
 				adminLogIf(ctx, err)
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
 			}
+			allPolicies := make(map[string]policy.Policy, len(policyDocs))
+			for name, doc := range policyDocs {
+				if include(doc.UpdateDate) {
+					allPolicies[name] = doc.Policy
+				}
+			}
 
 			policiesData, err := json.Marshal(allPolicies)
 			if err != nil {
@@ -2093,6 +2144,9 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 			}
 			userAccounts := make(map[string]madmin.AddOrUpdateUserReq)
 			for u, uid := range userIdentities {
+				if !include(uid.UpdatedAt) {
+					continue
+				}
 				userAccounts[u] = madmin.AddOrUpdateUserReq{
 					SecretKey: uid.Credentials.SecretKey,
 					Status: func() madmin.AccountStatus {
@@ -2121,6 +2175,11 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
 			}
+			for group, gi := range groups {
+				if !include(gi.UpdatedAt) {
+					delete(groups, group)
+				}
+			}
 			groupData, err := json.Marshal(groups)
 			if err != nil {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
@@ -2144,6 +2203,9 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 					// skip site-replication service account.
 					continue
 				}
+				if !include(acc.UpdatedAt) {
+					continue
+				}
 				claims, err := globalIAMSys.GetClaimsForSvcAcc(ctx, acc.Credentials.AccessKey)
 				if err != nil {
 					writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
@@ -2194,7 +2256,13 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
 			}
-			userPolData, err := json.Marshal(mappedPoliciesToMap(userPolicyMap))
+			userPolMap := mappedPoliciesToMap(userPolicyMap)
+			for u, mp := range userPolMap {
+				if !include(mp.UpdatedAt) {
+					delete(userPolMap, u)
+				}
+			}
+			userPolData, err := json.Marshal(userPolMap)
 			if err != nil {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
@@ -2211,7 +2279,13 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
 			}
-			grpPolData, err := json.Marshal(mappedPoliciesToMap(groupPolicyMap))
+			grpPolMap := mappedPoliciesToMap(groupPolicyMap)
+			for g, mp := range grpPolMap {
+				if !include(mp.UpdatedAt) {
+					delete(grpPolMap, g)
+				}
+			}
+			grpPolData, err := json.Marshal(grpPolMap)
 			if err != nil {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
@@ -2228,7 +2302,13 @@ func (a adminAPIHandlers) ExportIAM(w http.ResponseWriter, r *http.Request) {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
 			}
-			userPolData, err := json.Marshal(mappedPoliciesToMap(userPolicyMap))
+			stsPolMap := mappedPoliciesToMap(userPolicyMap)
+			for u, mp := range stsPolMap {
+				if !include(mp.UpdatedAt) {
+					delete(stsPolMap, u)
+				}
+			}
+			userPolData, err := json.Marshal(stsPolMap)
 			if err != nil {
 				writeErrorResponse(ctx, w, exportError(ctx, err, iamFile, ""), r.URL)
 				return
@@ -2274,6 +2354,11 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 		return
 	}
 
+	// In merge mode entities that already exist on this cluster are left
+	// untouched and reported under `skipped` in the result, instead of
+	// being overwritten.
+	merge := r.Form.Get("merge") == "true"
+
 	var skipped, removed, added madmin.IAMEntities
 	var failed madmin.IAMErrEntities
 
@@ -2299,6 +2384,12 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 				return
 			}
 			for policyName, policy := range allPolicies {
+				if merge {
+					if _, perr := globalIAMSys.InfoPolicy(policyName); perr == nil {
+						skipped.Policies = append(skipped.Policies, policyName)
+						continue
+					}
+				}
 				if policy.IsEmpty() {
 					err = globalIAMSys.DeletePolicy(ctx, policyName, true)
 					removed.Policies = append(removed.Policies, policyName)
@@ -2343,6 +2434,10 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 				}
 
 				user, exists := globalIAMSys.GetUser(ctx, accessKey)
+				if merge && exists {
+					skipped.Users = append(skipped.Users, accessKey)
+					continue
+				}
 				if exists && (user.Credentials.IsTemp() || user.Credentials.IsServiceAccount()) {
 					// Updating STS credential is not allowed, and this API does not
 					// support updating service accounts.
@@ -2394,6 +2489,9 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 						writeErrorResponseJSON(ctx, w, importErrorWithAPIErr(ctx, ErrAdminResourceInvalidArgument, gerr, allGroupsFile, group), r.URL)
 						return
 					}
+				} else if merge {
+					skipped.Groups = append(skipped.Groups, group)
+					continue
 				}
 				if _, gerr := globalIAMSys.AddUsersToGroup(ctx, group, grpInfo.Members); gerr != nil {
 					failed.Groups = append(failed.Groups, madmin.IAMErrEntity{Name: group, Error: err})
@@ -2464,6 +2562,10 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 					updateReq = false
 				}
 				if updateReq {
+					if merge {
+						skipped.ServiceAccounts = append(skipped.ServiceAccounts, user)
+						continue
+					}
 					// If the service account exists, we remove it to ensure a
 					// clean import.
 					err := globalIAMSys.DeleteServiceAccount(ctx, svcAcctReq.AccessKey, true)
@@ -2524,6 +2626,12 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 					writeErrorResponseJSON(ctx, w, importError(ctx, errIAMActionNotAllowed, userPolicyMappingsFile, u), r.URL)
 					return
 				}
+				if merge {
+					if policies, _ := globalIAMSys.PolicyDBGet(u); len(policies) > 0 {
+						skipped.UserPolicies = append(skipped.UserPolicies, map[string][]string{u: policies})
+						continue
+					}
+				}
 				if _, err := globalIAMSys.PolicyDBSet(ctx, u, pm.Policies, regUser, false); err != nil {
 					failed.UserPolicies = append(
 						failed.UserPolicies,
@@ -2575,6 +2683,12 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 				if slices.Contains(skipped.Groups, g) {
 					continue
 				}
+				if merge {
+					if gd, gerr := globalIAMSys.GetGroupDescription(g); gerr == nil && gd.Policy != "" {
+						skipped.GroupPolicies = append(skipped.GroupPolicies, map[string][]string{g: strings.Split(gd.Policy, ",")})
+						continue
+					}
+				}
 				if _, err := globalIAMSys.PolicyDBSet(ctx, g, pm.Policies, unknownIAMUserType, true); err != nil {
 					failed.GroupPolicies = append(
 						failed.GroupPolicies,
@@ -2636,6 +2750,12 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 					return
 				}
 
+				if merge {
+					if policies, _ := globalIAMSys.PolicyDBGet(u); len(policies) > 0 {
+						skipped.STSPolicies = append(skipped.STSPolicies, map[string][]string{u: policies})
+						continue
+					}
+				}
 				if _, err := globalIAMSys.PolicyDBSet(ctx, u, pm.Policies, stsUser, false); err != nil {
 					failed.STSPolicies = append(
 						failed.STSPolicies,
@@ -2789,3 +2909,129 @@ func setReqInfoPolicyName(ctx context.Context, policyName string) {
 	reqInfo := logger.GetReqInfo(ctx)
 	reqInfo.SetTags("policyName", policyName)
 }
+
+const (
+	// defaultSecretRotationGrace is how long the previous secret key stays
+	// valid after rotation when the caller does not specify a grace window.
+	defaultSecretRotationGrace = 15 * time.Minute
+
+	// maxSecretRotationGrace caps the rotation grace window.
+	maxSecretRotationGrace = 7 * 24 * time.Hour
+)
+
+// rotateServiceAccountResp is the response of RotateServiceAccount.
+type rotateServiceAccountResp struct {
+	AccessKey            string    `json:"accessKey"`
+	SecretKey            string    `json:"secretKey"`
+	RotatedAt            time.Time `json:"rotatedAt"`
+	PreviousKeyValidTill time.Time `json:"previousKeyValidTill"`
+}
+
+// RotateServiceAccount - POST /minio/admin/v3/rotate-service-account?accessKey=<access_key>[&grace=<duration>]
+//
+// Generates a new secret key for a service account while keeping the previous
+// key valid for the grace window, so applications can roll credentials
+// without downtime.
+func (a adminAPIHandlers) RotateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Get current object layer instance.
+	objectAPI := newObjectLayerFn()
+	if objectAPI == nil || globalNotificationSys == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	cred, owner, s3Err := validateAdminSignature(ctx, r, "")
+	if s3Err != ErrNone {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
+	accessKey := mux.Vars(r)["accessKey"]
+	if accessKey == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	grace := defaultSecretRotationGrace
+	if v := r.Form.Get("grace"); v != "" {
+		var err error
+		grace, err = time.ParseDuration(v)
+		if err != nil || grace < 0 || grace > maxSecretRotationGrace {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminResourceInvalidArgument), r.URL)
+			return
+		}
+	}
+
+	svcAccount, _, err := globalIAMSys.GetServiceAccount(ctx, accessKey)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Permission checks:
+	//
+	// 1. Any account with the policy.UpdateServiceAccountAdminAction
+	// permission can rotate any service account.
+	//
+	// 2. The parent user and the service account itself may rotate the
+	// account's secret key - rotation does not widen privileges.
+	requestUser := cred.AccessKey
+	if cred.ParentUser != "" {
+		requestUser = cred.ParentUser
+	}
+	selfRotation := requestUser == svcAccount.ParentUser || cred.AccessKey == accessKey
+	if !selfRotation && !globalIAMSys.IsAllowed(policy.Args{
+		AccountName:     cred.AccessKey,
+		Groups:          cred.Groups,
+		Action:          policy.UpdateServiceAccountAdminAction,
+		ConditionValues: getConditionValues(r, "", cred),
+		IsOwner:         owner,
+		Claims:          cred.Claims,
+	}) {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
+		return
+	}
+
+	rotated, err := globalIAMSys.RotateServiceAccountSecret(ctx, accessKey, grace)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook - non-root user accounts are replicated.
+	// Peer sites receive the new secret right away, the grace window only
+	// applies on this site.
+	if svcAccount.ParentUser != globalActiveCred.AccessKey {
+		replLogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
+			Type: madmin.SRIAMItemSvcAcc,
+			SvcAccChange: &madmin.SRSvcAccChange{
+				Update: &madmin.SRSvcAccUpdate{
+					AccessKey: accessKey,
+					SecretKey: rotated.SecretKey,
+				},
+			},
+			UpdatedAt: UTCNow(),
+		}))
+	}
+
+	data, err := json.Marshal(rotateServiceAccountResp{
+		AccessKey:            accessKey,
+		SecretKey:            rotated.SecretKey,
+		RotatedAt:            rotated.RotatedAt,
+		PreviousKeyValidTill: rotated.PreviousKeyValidTill,
+	})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	encryptedData, err := madmin.EncryptData(cred.SecretKey, data)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, encryptedData)
+}
@@ -62,6 +62,7 @@ import (
 	"github.com/minio/pkg/v3/logger/message/log"
 	xnet "github.com/minio/pkg/v3/net"
 	"github.com/minio/pkg/v3/policy"
+	"github.com/minio/pkg/v3/wildcard"
 	"github.com/secure-io/sio-go"
 	"github.com/zeebo/xxh3"
 )
@@ -842,6 +843,33 @@ func (a adminAPIHandlers) MetricsHandler(w http.ResponseWriter, r *http.Request)
 // DataUsageInfoHandler - GET /minio/admin/v3/datausage?capacity={true}
 // ----------
 // Get server/cluster data usage info
+// ObjectAgeDistributionHandler - returns the per-bucket object age
+// distribution maintained by the scanner, split by storage class and by
+// last access time where available, aggregated across all nodes.
+//
+// GET /minio/admin/v3/object-age-dist?buckets={buckets}
+func (a adminAPIHandlers) ObjectAgeDistributionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.DataUsageInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	var buckets []string
+	if v := r.Form.Get("buckets"); v != "" {
+		buckets = strings.Split(v, ",")
+	}
+
+	data, err := json.Marshal(globalNotificationSys.GetObjectAgeStats(ctx, buckets...))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
 func (a adminAPIHandlers) DataUsageInfoHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -892,6 +920,18 @@ func lriToLockEntry(l lockRequesterInfo, now time.Time, resource, server string)
 	} else {
 		entry.Type = "READ"
 	}
+	if l.Queued {
+		// Not yet granted, waiting in the fair queue of this server.
+		entry.Type += " (QUEUED)"
+	}
+	if l.LeaseExpiry > 0 {
+		if now.After(time.Unix(0, l.LeaseExpiry)) {
+			// Lease ran out without renewal, lock is about to be reclaimed.
+			entry.Type += " (LEASE-EXPIRED)"
+		} else {
+			entry.Type += " (LEASED)"
+		}
+	}
 	return entry
 }
 
@@ -952,21 +992,102 @@ func (a adminAPIHandlers) ForceUnlockHandler(w http.ResponseWriter, r *http.Requ
 
 	vars := mux.Vars(r)
 
-	var args dsync.LockArgs
-	var lockers []dsync.NetLocker
+	var olderThan time.Duration
+	if v := r.Form.Get("olderThan"); v != "" {
+		var err error
+		olderThan, err = time.ParseDuration(v)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+
+	var exact, patterns []string
 	for _, path := range strings.Split(vars["paths"], ",") {
 		if path == "" {
 			continue
 		}
-		args.Resources = append(args.Resources, path)
+		if strings.ContainsAny(path, "*?") || strings.HasSuffix(path, SlashSeparator) {
+			patterns = append(patterns, path)
+			continue
+		}
+		exact = append(exact, path)
 	}
 
+	matchesPath := func(resource string) bool {
+		for _, p := range exact {
+			if resource == p {
+				return true
+			}
+		}
+		for _, p := range patterns {
+			if strings.HasSuffix(p, SlashSeparator) && strings.HasPrefix(resource, p) {
+				return true
+			}
+			if wildcard.Match(p, resource) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var lockers []dsync.NetLocker
 	for _, lks := range z.serverPools[0].erasureLockers {
 		lockers = append(lockers, lks...)
 	}
 
-	for _, locker := range lockers {
-		locker.ForceUnlock(ctx, args)
+	// Resolve patterns and the age filter against the locks currently held
+	// across all peers, such that matched locks can be released individually
+	// and audited one by one.
+	now := UTCNow()
+	seen := make(map[string]struct{})
+	var toRelease []dsync.LockArgs
+	for _, peerLock := range globalNotificationSys.GetLocks(ctx, r) {
+		if peerLock == nil {
+			continue
+		}
+		for resource, lris := range peerLock.Locks {
+			if !matchesPath(resource) {
+				continue
+			}
+			for _, lri := range lris {
+				if olderThan > 0 && now.Sub(time.Unix(0, lri.Timestamp)) < olderThan {
+					continue
+				}
+				if _, ok := seen[lri.UID]; ok {
+					continue
+				}
+				seen[lri.UID] = struct{}{}
+				toRelease = append(toRelease, dsync.LockArgs{UID: lri.UID, Resources: []string{resource}})
+				auditLogInternal(ctx, AuditLogOptions{
+					Event:   "force-unlock",
+					APIName: "ForceUnlock",
+					Tags: map[string]string{
+						"resource": resource,
+						"uid":      lri.UID,
+						"owner":    lri.Owner,
+						"source":   lri.Source,
+						"age":      now.Sub(time.Unix(0, lri.Timestamp)).String(),
+					},
+				})
+			}
+		}
+	}
+
+	for _, args := range toRelease {
+		for _, locker := range lockers {
+			locker.ForceUnlock(ctx, args)
+		}
+	}
+
+	// Exact paths are additionally released at the resource level as before,
+	// covering locks that appeared after the listing above - unless an age
+	// filter narrows the request down to the matched locks only.
+	if olderThan == 0 && len(exact) > 0 {
+		args := dsync.LockArgs{Resources: exact}
+		for _, locker := range lockers {
+			locker.ForceUnlock(ctx, args)
+		}
 	}
 }
 
@@ -1010,6 +1131,38 @@ func (a adminAPIHandlers) TopLocksHandler(w http.ResponseWriter, r *http.Request
 	writeSuccessResponseJSON(w, jsonBytes)
 }
 
+// TopAccessesHandler - returns the hottest objects or prefixes of this
+// node by approximate decayed access score. This is a MinIO extension
+// API intended for cache sizing and tiering decisions.
+func (a adminAPIHandlers) TopAccessesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	count := 10 // by default list only top 10 entries
+	if countStr := r.Form.Get("count"); countStr != "" {
+		var err error
+		count, err = strconv.Atoi(countStr)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+	bucket := r.Form.Get("bucket")
+	byPrefix := r.Form.Get("prefixes") == "true" // aggregate per prefix
+
+	jsonBytes, err := json.Marshal(globalAccessStats.top(count, bucket, byPrefix))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
 // StartProfilingResult contains the status of the starting
 // profiling action in a given server - deprecated API
 type StartProfilingResult struct {
@@ -151,6 +151,14 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Deprecated: Restart and stop MinIO service.
 		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/service").HandlerFunc(adminMiddleware(adminAPI.ServiceHandler, traceAllFlag)).Queries("action", "{action:.*}")
 
+		// Read-only maintenance mode
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/maintenance/readonly").HandlerFunc(adminMiddleware(adminAPI.SetReadOnlyMode, traceAllFlag)).Queries("enable", "{enable:true|false}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/maintenance/readonly").HandlerFunc(adminMiddleware(adminAPI.ReadOnlyModeStatus, traceAllFlag))
+
+		// Node drain for rolling maintenance, applies to the node serving the request.
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/maintenance/drain").HandlerFunc(adminMiddleware(adminAPI.SetNodeDrain, traceAllFlag)).Queries("enable", "{enable:true|false}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/maintenance/drain").HandlerFunc(adminMiddleware(adminAPI.NodeDrainStatus, traceAllFlag))
+
 		// Update all MinIO servers type=2
 		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/update").HandlerFunc(adminMiddleware(adminAPI.ServerUpdateV2Handler, traceAllFlag)).Queries("updateURL", "{updateURL:.*}", "type", "2")
 
@@ -165,6 +173,10 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/storageinfo").HandlerFunc(adminMiddleware(adminAPI.StorageInfoHandler, traceAllFlag))
 		// DataUsageInfo operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/datausageinfo").HandlerFunc(adminMiddleware(adminAPI.DataUsageInfoHandler, traceAllFlag))
+		// Scanner object age distribution
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/object-age-dist").HandlerFunc(adminMiddleware(adminAPI.ObjectAgeDistributionHandler, traceAllFlag))
+		// Shadow mirroring divergence report
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/shadow-report").HandlerFunc(adminMiddleware(adminAPI.ShadowReportHandler, traceAllFlag))
 		// Metrics operation
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/metrics").HandlerFunc(adminMiddleware(adminAPI.MetricsHandler, traceHdrsS3HFlag))
 
@@ -188,6 +200,8 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/start").HandlerFunc(adminMiddleware(adminAPI.RebalanceStart, traceAllFlag))
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/rebalance/status").HandlerFunc(adminMiddleware(adminAPI.RebalanceStatus, traceAllFlag))
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/stop").HandlerFunc(adminMiddleware(adminAPI.RebalanceStop, traceAllFlag))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/pause").HandlerFunc(adminMiddleware(adminAPI.RebalancePause, traceAllFlag))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/resume").HandlerFunc(adminMiddleware(adminAPI.RebalanceResume, traceAllFlag))
 		}
 
 		// Profiling operations - deprecated API
@@ -212,6 +226,7 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/list-config-history-kv").HandlerFunc(adminMiddleware(adminAPI.ListConfigHistoryKVHandler, traceAllFlag)).Queries("count", "{count:[0-9]+}")
 			adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/clear-config-history-kv").HandlerFunc(adminMiddleware(adminAPI.ClearConfigHistoryKVHandler)).Queries("restoreId", "{restoreId:.*}")
 			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/restore-config-history-kv").HandlerFunc(adminMiddleware(adminAPI.RestoreConfigHistoryKVHandler)).Queries("restoreId", "{restoreId:.*}")
+			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/restore-config-backup").HandlerFunc(adminMiddleware(adminAPI.RestoreConfigBackupHandler)).Queries("bucket", "{bucket:.*}", "path", "{path:.*}")
 		}
 
 		// Config import/export bulk operations
@@ -240,6 +255,7 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/info-service-account").HandlerFunc(adminMiddleware(adminAPI.InfoServiceAccount)).Queries("accessKey", "{accessKey:.*}")
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-service-accounts").HandlerFunc(adminMiddleware(adminAPI.ListServiceAccounts))
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/delete-service-account").HandlerFunc(adminMiddleware(adminAPI.DeleteServiceAccount)).Queries("accessKey", "{accessKey:.*}")
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/rotate-service-account").HandlerFunc(adminMiddleware(adminAPI.RotateServiceAccount)).Queries("accessKey", "{accessKey:.*}")
 
 		// STS accounts ops
 		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/temporary-account-info").HandlerFunc(adminMiddleware(adminAPI.TemporaryAccountInfo)).Queries("accessKey", "{accessKey:.*}")
@@ -256,6 +272,9 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Builtin IAM policy associations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/idp/builtin/policy-entities").HandlerFunc(adminMiddleware(adminAPI.ListPolicyMappingEntities))
 
+		// Simulate policy evaluation
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/simulate-policy").HandlerFunc(adminMiddleware(adminAPI.SimulatePolicy))
+
 		// Remove policy IAM
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-canned-policy").HandlerFunc(adminMiddleware(adminAPI.RemoveCannedPolicy)).Queries("name", "{name:.*}")
 
@@ -276,6 +295,8 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 
 		// User info
 		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/user-info").HandlerFunc(adminMiddleware(adminAPI.GetUserInfo)).Queries("accessKey", "{accessKey:.*}")
+		// Effective permissions matrix of a principal
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/effective-permissions").HandlerFunc(adminMiddleware(adminAPI.EffectivePermissionsHandler)).Queries("accessKey", "{accessKey:.*}")
 		// Add/Remove members from group
 		adminRouter.Methods(http.MethodPut).Path(adminVersion + "/update-group-members").HandlerFunc(adminMiddleware(adminAPI.UpdateGroupMembers))
 
@@ -320,6 +341,66 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// PutBucketQuotaConfig
 		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-quota").HandlerFunc(
 			adminMiddleware(adminAPI.PutBucketQuotaConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// GetBucketQuotaUsage
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/bucket-quota-usage").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketQuotaUsageHandler)).Queries("bucket", "{bucket:.*}")
+
+		// IssueDeleteBucketToken
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/issue-delete-bucket-token").HandlerFunc(
+			adminMiddleware(adminAPI.IssueDeleteBucketTokenHandler)).Queries("bucket", "{bucket:.*}")
+		// GetBucketResponseHeadersConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-response-headers").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketResponseHeadersConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// SetBucketResponseHeadersConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-response-headers").HandlerFunc(
+			adminMiddleware(adminAPI.SetBucketResponseHeadersConfigHandler)).Queries("bucket", "{bucket:.*}")
+
+		// ProvisionBucket
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/provision-bucket").HandlerFunc(
+			adminMiddleware(adminAPI.ProvisionBucketHandler)).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketStorageClassConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-storage-class").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketStorageClassConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// SetBucketStorageClassConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-storage-class").HandlerFunc(
+			adminMiddleware(adminAPI.SetBucketStorageClassConfigHandler)).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketPlacementConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-placement").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketPlacementConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// SetBucketPlacementConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-placement").HandlerFunc(
+			adminMiddleware(adminAPI.SetBucketPlacementConfigHandler)).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketSoftPurgeConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-soft-purge").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketSoftPurgeConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// SetBucketSoftPurgeConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-soft-purge").HandlerFunc(
+			adminMiddleware(adminAPI.SetBucketSoftPurgeConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// UndeleteObject
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/undelete-object").HandlerFunc(
+			adminMiddleware(adminAPI.UndeleteObjectHandler)).Queries("bucket", "{bucket:.*}",
+			"object", "{object:.*}", "versionId", "{versionId:.*}")
+
+		// GetBucketReplicationPause
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-replication-pause").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketReplicationPauseHandler)).Queries("bucket", "{bucket:.*}")
+		// SetBucketReplicationPause
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-replication-pause").HandlerFunc(
+			adminMiddleware(adminAPI.SetBucketReplicationPauseHandler)).Queries("bucket", "{bucket:.*}", "pause", "{pause:.*}")
+
+		// SetBucketBandwidthLimit
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-bandwidth-limit").HandlerFunc(
+			adminMiddleware(adminAPI.SetBucketBandwidthLimitHandler)).Queries("bucket", "{bucket:.*}", "limit", "{limit:.*}")
+		// BandwidthMonitor
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/bandwidth").HandlerFunc(
+			adminMiddleware(adminAPI.BandwidthMonitorHandler))
+
+		// ValidateErasureDist
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/validate-erasure-dist").HandlerFunc(
+			adminMiddleware(adminAPI.ValidateErasureDistHandler)).Queries("bucket", "{bucket:.*}")
 
 		// Bucket replication operations
 		// GetBucketTargetHandler
@@ -337,6 +418,14 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// ReplicationMRFHandler - MinIO extension API
 		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/replication/mrf").HandlerFunc(
 			adminMiddleware(adminAPI.ReplicationMRFHandler)).Queries("bucket", "{bucket:.*}")
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/replication/mrf/requeue").HandlerFunc(
+			adminMiddleware(adminAPI.ReplicationMRFRequeueHandler)).Queries("bucket", "{bucket:.*}")
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/replication/mrf").HandlerFunc(
+			adminMiddleware(adminAPI.ReplicationMRFDropHandler)).Queries("bucket", "{bucket:.*}")
+
+		// ReplayEventsHandler - MinIO extension API
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/replay-events").HandlerFunc(
+			adminMiddleware(adminAPI.ReplayEventsHandler)).Queries("arn", "{arn:.*}")
 
 		// Batch job operations
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/start-job").HandlerFunc(
@@ -353,6 +442,14 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion + "/cancel-job").HandlerFunc(
 			adminMiddleware(adminAPI.CancelBatchJob))
 
+		// Batch job schedule operations
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/schedule-job").HandlerFunc(
+			adminMiddleware(adminAPI.ScheduleBatchJob))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-job-schedules").HandlerFunc(
+			adminMiddleware(adminAPI.ListBatchJobSchedules))
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion + "/delete-job-schedule").HandlerFunc(
+			adminMiddleware(adminAPI.DeleteBatchJobSchedule))
+
 		// Bucket migration operations
 		// ExportBucketMetaHandler
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/export-bucket-metadata").HandlerFunc(
@@ -376,6 +473,7 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/site-replication/info").HandlerFunc(adminMiddleware(adminAPI.SiteReplicationInfo))
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/site-replication/metainfo").HandlerFunc(adminMiddleware(adminAPI.SiteReplicationMetaInfo))
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/site-replication/status").HandlerFunc(adminMiddleware(adminAPI.SiteReplicationStatus))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/site-replication/status/bucket-diff").HandlerFunc(adminMiddleware(adminAPI.SiteReplicationBucketDiff))
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + adminAPISiteReplicationDevNull).HandlerFunc(adminMiddleware(adminAPI.SiteReplicationDevNull, noObjLayerFlag))
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + adminAPISiteReplicationNetPerf).HandlerFunc(adminMiddleware(adminAPI.SiteReplicationNetPerf, noObjLayerFlag))
 
@@ -393,6 +491,9 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		if globalIsDistErasure {
 			// Top locks
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/top/locks").HandlerFunc(adminMiddleware(adminAPI.TopLocksHandler))
+
+			// Top accesses (MinIO extension API)
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/top/accesses").HandlerFunc(adminMiddleware(adminAPI.TopAccessesHandler))
 			// Force unlocks paths
 			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/force-unlock").
 				Queries("paths", "{paths:.*}").HandlerFunc(adminMiddleware(adminAPI.ForceUnlockHandler))
@@ -157,6 +157,11 @@ func getLocalServerProperty(endpointServerPools EndpointServerPools, r *http.Req
 		props.MinioEnvVars[key] = value
 	}
 
+	// Report the reedsolomon code path active on this node, so clusters
+	// with heterogeneous hardware can spot nodes running the slow
+	// fallback. Carried in the env var map for madmin compatibility.
+	props.MinioEnvVars["MINIO_ERASURE_CODE_PATH"] = erasureCodePath()
+
 	objLayer := newObjectLayerFn()
 	if objLayer != nil {
 		storageInfo := objLayer.LocalStorageInfo(GlobalContext, metrics)
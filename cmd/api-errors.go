@@ -226,6 +226,7 @@ const (
 	ErrSSEEncryptedObject
 	ErrInvalidEncryptionParameters
 	ErrInvalidEncryptionParametersSSEC
+	ErrInvalidClientSideEncryptionParameters
 
 	ErrInvalidSSECustomerAlgorithm
 	ErrInvalidSSECustomerKey
@@ -269,6 +270,8 @@ const (
 	ErrInvalidLifecycleQueryParameter
 	ErrServerNotInitialized
 	ErrBucketMetadataNotInitialized
+	ErrReadOnlyMaintenance
+	ErrNodeDraining
 	ErrRequestTimedout
 	ErrClientDisconnected
 	ErrTooManyRequests
@@ -1209,6 +1212,11 @@ var errorCodes = errorCodeMap{
 		Description:    "SSE-C encryption parameters are not supported on replicated bucket.",
 		HTTPStatusCode: http.StatusBadRequest,
 	},
+	ErrInvalidClientSideEncryptionParameters: {
+		Code:           "InvalidArgument",
+		Description:    "The client-side encryption envelope metadata is incomplete or malformed.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
 	ErrInvalidSSECustomerAlgorithm: {
 		Code:           "InvalidArgument",
 		Description:    "Requests specifying Server Side Encryption with Customer provided keys must provide a valid encryption algorithm.",
@@ -1323,6 +1331,16 @@ var errorCodes = errorCodeMap{
 		Description:    "Bucket metadata not initialized yet, please try again.",
 		HTTPStatusCode: http.StatusServiceUnavailable,
 	},
+	ErrReadOnlyMaintenance: {
+		Code:           "XMinioReadOnlyMaintenance",
+		Description:    "Server is in read-only maintenance mode, write requests are rejected. Please try again later.",
+		HTTPStatusCode: http.StatusServiceUnavailable,
+	},
+	ErrNodeDraining: {
+		Code:           "XMinioNodeDraining",
+		Description:    "This node is being drained for maintenance, please retry against another node.",
+		HTTPStatusCode: http.StatusServiceUnavailable,
+	},
 	ErrMalformedJSON: {
 		Code:           "XMinioMalformedJSON",
 		Description:    "The JSON you provided was not well-formed or did not validate against our published format.",
@@ -2204,6 +2222,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrInvalidEncryptionParameters
 	case errInvalidEncryptionParametersSSEC:
 		apiErr = ErrInvalidEncryptionParametersSSEC
+	case errInvalidCSEMetadata:
+		apiErr = ErrInvalidClientSideEncryptionParameters
 	case crypto.ErrInvalidEncryptionMethod:
 		apiErr = ErrInvalidEncryptionMethod
 	case crypto.ErrInvalidEncryptionKeyID:
@@ -2254,6 +2274,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrServerNotInitialized
 	case errBucketMetadataNotInitialized:
 		apiErr = ErrBucketMetadataNotInitialized
+	case errReadOnlyMode:
+		apiErr = ErrReadOnlyMaintenance
 	case hash.ErrInvalidChecksum:
 		apiErr = ErrInvalidChecksum
 	}
@@ -178,6 +178,10 @@ func setObjectHeaders(ctx context.Context, w http.ResponseWriter, objInfo Object
 		}
 	}
 
+	// Apply bucket level default response headers for anything the
+	// object metadata has not already set.
+	setBucketResponseHeaders(w, objInfo.Bucket, objInfo.Name)
+
 	var start, rangeLen int64
 	totalObjectSize, err := objInfo.GetActualSize()
 	if err != nil {
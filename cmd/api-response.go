@@ -25,11 +25,13 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/minio/minio/internal/amztime"
+	"github.com/minio/minio/internal/auth"
 	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/handlers"
 	"github.com/minio/minio/internal/hash"
@@ -683,16 +685,73 @@ func generateListObjectsV1Response(ctx context.Context, bucket, prefix, marker,
 	return data
 }
 
-// generates an ListObjectsV2 response for the said bucket with other enumerated options.
-func generateListObjectsV2Response(ctx context.Context, bucket, prefix, token, nextToken, startAfter, delimiter, encodingType string, fetchOwner, isTruncated bool, maxKeys int, objects []ObjectInfo, prefixes []string, metadata metaCheckFn) ListObjectsV2Response {
-	contents := make([]Object, 0, len(objects))
-	var owner *Owner
-	if fetchOwner {
-		owner = &Owner{
+// ownerFromCred maps the authenticated IAM identity to the owner reported
+// in listing responses. Service accounts and STS credentials report their
+// parent user so that all credentials of an account map to the same owner,
+// the root account maps to the default owner.
+func ownerFromCred(cred auth.Credentials) *Owner {
+	name := cred.ParentUser
+	if name == "" {
+		name = cred.AccessKey
+	}
+	if name == "" || name == globalActiveCred.AccessKey {
+		return &Owner{
 			ID:          globalMinioDefaultOwnerID,
 			DisplayName: "minio",
 		}
 	}
+	return &Owner{
+		ID:          getSHA256Hash([]byte(name)),
+		DisplayName: name,
+	}
+}
+
+// ObjectVersionHistoryResponse - format of the object version history
+// response, a MinIO specific extension.
+type ObjectVersionHistoryResponse struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ VersionHistoryResult" json:"-"`
+
+	Name string
+	Key  string
+
+	Versions []ObjectVersion `xml:"Version>Entry"`
+}
+
+// generateObjectVersionHistoryResponse generates the version history of a
+// single object, oldest version first.
+func generateObjectVersionHistoryResponse(bucket, object string, objects []ObjectInfo) ObjectVersionHistoryResponse {
+	versions := make([]ObjectVersion, 0, len(objects))
+	for _, oi := range objects {
+		content := ObjectVersion{}
+		content.Key = oi.Name
+		content.LastModified = amztime.ISO8601Format(oi.ModTime.UTC())
+		if oi.ETag != "" {
+			content.ETag = "\"" + oi.ETag + "\""
+		}
+		content.Size = oi.Size
+		content.StorageClass = oi.StorageClass
+		content.VersionID = oi.VersionID
+		if content.VersionID == "" {
+			content.VersionID = nullVersionID
+		}
+		content.IsLatest = oi.IsLatest
+		content.isDeleteMarker = oi.DeleteMarker
+		versions = append(versions, content)
+	}
+	// Version history is reported oldest first.
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].LastModified < versions[j].LastModified
+	})
+	return ObjectVersionHistoryResponse{
+		Name:     bucket,
+		Key:      object,
+		Versions: versions,
+	}
+}
+
+// generates an ListObjectsV2 response for the said bucket with other enumerated options.
+func generateListObjectsV2Response(ctx context.Context, bucket, prefix, token, nextToken, startAfter, delimiter, encodingType string, owner *Owner, isTruncated bool, maxKeys int, objects []ObjectInfo, prefixes []string, metadata metaCheckFn) ListObjectsV2Response {
+	contents := make([]Object, 0, len(objects))
 
 	data := ListObjectsV2Response{}
 
@@ -908,6 +967,42 @@ func writeResponse(w http.ResponseWriter, statusCode int, response []byte, mType
 	}
 }
 
+// strictCompatWriter removes MinIO specific extension headers from the
+// response just before it is written. It is applied on the S3 router
+// only - admin API clients rely on x-minio-* headers.
+type strictCompatWriter struct {
+	http.ResponseWriter
+	stripped bool
+}
+
+func (w *strictCompatWriter) strip() {
+	if w.stripped {
+		return
+	}
+	w.stripped = true
+	for k := range w.Header() {
+		if stringsHasPrefixFold(k, "x-minio-") {
+			w.ResponseWriter.Header().Del(k)
+		}
+	}
+}
+
+func (w *strictCompatWriter) WriteHeader(statusCode int) {
+	w.strip()
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *strictCompatWriter) Write(p []byte) (int, error) {
+	w.strip()
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *strictCompatWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 // mimeType represents various MIME type used API responses.
 type mimeType string
 
@@ -218,6 +218,27 @@ func s3APIMiddleware(f http.HandlerFunc, flags ...s3HFlag) http.HandlerFunc {
 	handlerName := getHandlerName(f, "objectAPIHandlers")
 
 	var handler http.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+		// Strict S3 compatibility does not return any MinIO specific
+		// extension headers. Scoped to the S3 router - admin API
+		// responses keep theirs.
+		if globalAPIConfig.isStrictS3Compat() {
+			w = &strictCompatWriter{ResponseWriter: w}
+		}
+
+		// A draining node takes no new S3 traffic, in-flight requests
+		// continue to completion and clients are pointed at a peer.
+		if nodeDrainBlocked(w, r) {
+			writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrNodeDraining), r.URL)
+			return
+		}
+
+		// Reject mutating calls upfront while the cluster is in read-only
+		// maintenance mode, reads continue to be served.
+		if readOnlyModeBlocked(r) {
+			writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrReadOnlyMaintenance), r.URL)
+			return
+		}
+
 		// Wrap the actual handler with the appropriate tracing middleware.
 		var tracedHandler http.HandlerFunc
 		if handlerFlags.has(traceHdrsS3HFlag) {
@@ -305,6 +326,15 @@ func registerAPIRouter(router *mux.Router) {
 			HandlerFunc(s3APIMiddleware(api.GetObjectAttributesHandler, traceHdrsS3HFlag)).
 			Queries("attributes", "")
 
+		// DiffObjectVersions - MinIO extension API
+		router.Methods(http.MethodGet).Path("/{object:.+}").
+			HandlerFunc(s3APIMiddleware(api.DiffObjectVersionsHandler)).
+			Queries("diff", "")
+		// GetObjectVersionHistory - MinIO extension API
+		router.Methods(http.MethodGet).Path("/{object:.+}").
+			HandlerFunc(s3APIMiddleware(api.GetObjectVersionHistoryHandler)).
+			Queries("versionHistory", "")
+
 		// CopyObjectPart
 		router.Methods(http.MethodPut).Path("/{object:.+}").
 			HeadersRegexp(xhttp.AmzCopySource, ".*?(\\/|%2F).*?").
@@ -369,6 +399,18 @@ func registerAPIRouter(router *mux.Router) {
 		// GetObject
 		router.Methods(http.MethodGet).Path("/{object:.+}").
 			HandlerFunc(s3APIMiddleware(api.GetObjectHandler, traceHdrsS3HFlag))
+		// ResumablePutObject - MinIO extension API
+		router.Methods(http.MethodPut).Path("/{object:.+}").
+			HandlerFunc(s3APIMiddleware(api.ResumablePutObjectHandler, traceHdrsS3HFlag)).
+			Queries("resumable", "", "resume-id", "{resume-id:.*}")
+		// ResumablePutStatus - MinIO extension API
+		router.Methods(http.MethodGet).Path("/{object:.+}").
+			HandlerFunc(s3APIMiddleware(api.ResumablePutStatusHandler)).
+			Queries("resumable", "", "resume-id", "{resume-id:.*}")
+		// RenameObject - MinIO extension API
+		router.Methods(http.MethodPut).Path("/{object:.+}").
+			HeadersRegexp(xhttp.MinIORenameSource, ".*?(\\/|%2F).*?").
+			HandlerFunc(s3APIMiddleware(api.RenameObjectHandler))
 		// CopyObject
 		router.Methods(http.MethodPut).Path("/{object:.+}").
 			HeadersRegexp(xhttp.AmzCopySource, ".*?(\\/|%2F).*?").
@@ -147,205 +147,209 @@ func _() {
 	_ = x[ErrSSEEncryptedObject-136]
 	_ = x[ErrInvalidEncryptionParameters-137]
 	_ = x[ErrInvalidEncryptionParametersSSEC-138]
-	_ = x[ErrInvalidSSECustomerAlgorithm-139]
-	_ = x[ErrInvalidSSECustomerKey-140]
-	_ = x[ErrMissingSSECustomerKey-141]
-	_ = x[ErrMissingSSECustomerKeyMD5-142]
-	_ = x[ErrSSECustomerKeyMD5Mismatch-143]
-	_ = x[ErrInvalidSSECustomerParameters-144]
-	_ = x[ErrIncompatibleEncryptionMethod-145]
-	_ = x[ErrKMSNotConfigured-146]
-	_ = x[ErrKMSKeyNotFoundException-147]
-	_ = x[ErrKMSDefaultKeyAlreadyConfigured-148]
-	_ = x[ErrNoAccessKey-149]
-	_ = x[ErrInvalidToken-150]
-	_ = x[ErrEventNotification-151]
-	_ = x[ErrARNNotification-152]
-	_ = x[ErrRegionNotification-153]
-	_ = x[ErrOverlappingFilterNotification-154]
-	_ = x[ErrFilterNameInvalid-155]
-	_ = x[ErrFilterNamePrefix-156]
-	_ = x[ErrFilterNameSuffix-157]
-	_ = x[ErrFilterValueInvalid-158]
-	_ = x[ErrOverlappingConfigs-159]
-	_ = x[ErrUnsupportedNotification-160]
-	_ = x[ErrContentSHA256Mismatch-161]
-	_ = x[ErrContentChecksumMismatch-162]
-	_ = x[ErrStorageFull-163]
-	_ = x[ErrRequestBodyParse-164]
-	_ = x[ErrObjectExistsAsDirectory-165]
-	_ = x[ErrInvalidObjectName-166]
-	_ = x[ErrInvalidObjectNamePrefixSlash-167]
-	_ = x[ErrInvalidResourceName-168]
-	_ = x[ErrInvalidLifecycleQueryParameter-169]
-	_ = x[ErrServerNotInitialized-170]
-	_ = x[ErrBucketMetadataNotInitialized-171]
-	_ = x[ErrRequestTimedout-172]
-	_ = x[ErrClientDisconnected-173]
-	_ = x[ErrTooManyRequests-174]
-	_ = x[ErrInvalidRequest-175]
-	_ = x[ErrTransitionStorageClassNotFoundError-176]
-	_ = x[ErrInvalidStorageClass-177]
-	_ = x[ErrBackendDown-178]
-	_ = x[ErrMalformedJSON-179]
-	_ = x[ErrAdminNoSuchUser-180]
-	_ = x[ErrAdminNoSuchUserLDAPWarn-181]
-	_ = x[ErrAdminLDAPExpectedLoginName-182]
-	_ = x[ErrAdminNoSuchGroup-183]
-	_ = x[ErrAdminGroupNotEmpty-184]
-	_ = x[ErrAdminGroupDisabled-185]
-	_ = x[ErrAdminInvalidGroupName-186]
-	_ = x[ErrAdminNoSuchJob-187]
-	_ = x[ErrAdminNoSuchPolicy-188]
-	_ = x[ErrAdminPolicyChangeAlreadyApplied-189]
-	_ = x[ErrAdminInvalidArgument-190]
-	_ = x[ErrAdminInvalidAccessKey-191]
-	_ = x[ErrAdminInvalidSecretKey-192]
-	_ = x[ErrAdminConfigNoQuorum-193]
-	_ = x[ErrAdminConfigTooLarge-194]
-	_ = x[ErrAdminConfigBadJSON-195]
-	_ = x[ErrAdminNoSuchConfigTarget-196]
-	_ = x[ErrAdminConfigEnvOverridden-197]
-	_ = x[ErrAdminConfigDuplicateKeys-198]
-	_ = x[ErrAdminConfigInvalidIDPType-199]
-	_ = x[ErrAdminConfigLDAPNonDefaultConfigName-200]
-	_ = x[ErrAdminConfigLDAPValidation-201]
-	_ = x[ErrAdminConfigIDPCfgNameAlreadyExists-202]
-	_ = x[ErrAdminConfigIDPCfgNameDoesNotExist-203]
-	_ = x[ErrInsecureClientRequest-204]
-	_ = x[ErrObjectTampered-205]
-	_ = x[ErrAdminLDAPNotEnabled-206]
-	_ = x[ErrSiteReplicationInvalidRequest-207]
-	_ = x[ErrSiteReplicationPeerResp-208]
-	_ = x[ErrSiteReplicationBackendIssue-209]
-	_ = x[ErrSiteReplicationServiceAccountError-210]
-	_ = x[ErrSiteReplicationBucketConfigError-211]
-	_ = x[ErrSiteReplicationBucketMetaError-212]
-	_ = x[ErrSiteReplicationIAMError-213]
-	_ = x[ErrSiteReplicationConfigMissing-214]
-	_ = x[ErrSiteReplicationIAMConfigMismatch-215]
-	_ = x[ErrAdminRebalanceAlreadyStarted-216]
-	_ = x[ErrAdminRebalanceNotStarted-217]
-	_ = x[ErrAdminBucketQuotaExceeded-218]
-	_ = x[ErrAdminNoSuchQuotaConfiguration-219]
-	_ = x[ErrHealNotImplemented-220]
-	_ = x[ErrHealNoSuchProcess-221]
-	_ = x[ErrHealInvalidClientToken-222]
-	_ = x[ErrHealMissingBucket-223]
-	_ = x[ErrHealAlreadyRunning-224]
-	_ = x[ErrHealOverlappingPaths-225]
-	_ = x[ErrIncorrectContinuationToken-226]
-	_ = x[ErrEmptyRequestBody-227]
-	_ = x[ErrUnsupportedFunction-228]
-	_ = x[ErrInvalidExpressionType-229]
-	_ = x[ErrBusy-230]
-	_ = x[ErrUnauthorizedAccess-231]
-	_ = x[ErrExpressionTooLong-232]
-	_ = x[ErrIllegalSQLFunctionArgument-233]
-	_ = x[ErrInvalidKeyPath-234]
-	_ = x[ErrInvalidCompressionFormat-235]
-	_ = x[ErrInvalidFileHeaderInfo-236]
-	_ = x[ErrInvalidJSONType-237]
-	_ = x[ErrInvalidQuoteFields-238]
-	_ = x[ErrInvalidRequestParameter-239]
-	_ = x[ErrInvalidDataType-240]
-	_ = x[ErrInvalidTextEncoding-241]
-	_ = x[ErrInvalidDataSource-242]
-	_ = x[ErrInvalidTableAlias-243]
-	_ = x[ErrMissingRequiredParameter-244]
-	_ = x[ErrObjectSerializationConflict-245]
-	_ = x[ErrUnsupportedSQLOperation-246]
-	_ = x[ErrUnsupportedSQLStructure-247]
-	_ = x[ErrUnsupportedSyntax-248]
-	_ = x[ErrUnsupportedRangeHeader-249]
-	_ = x[ErrLexerInvalidChar-250]
-	_ = x[ErrLexerInvalidOperator-251]
-	_ = x[ErrLexerInvalidLiteral-252]
-	_ = x[ErrLexerInvalidIONLiteral-253]
-	_ = x[ErrParseExpectedDatePart-254]
-	_ = x[ErrParseExpectedKeyword-255]
-	_ = x[ErrParseExpectedTokenType-256]
-	_ = x[ErrParseExpected2TokenTypes-257]
-	_ = x[ErrParseExpectedNumber-258]
-	_ = x[ErrParseExpectedRightParenBuiltinFunctionCall-259]
-	_ = x[ErrParseExpectedTypeName-260]
-	_ = x[ErrParseExpectedWhenClause-261]
-	_ = x[ErrParseUnsupportedToken-262]
-	_ = x[ErrParseUnsupportedLiteralsGroupBy-263]
-	_ = x[ErrParseExpectedMember-264]
-	_ = x[ErrParseUnsupportedSelect-265]
-	_ = x[ErrParseUnsupportedCase-266]
-	_ = x[ErrParseUnsupportedCaseClause-267]
-	_ = x[ErrParseUnsupportedAlias-268]
-	_ = x[ErrParseUnsupportedSyntax-269]
-	_ = x[ErrParseUnknownOperator-270]
-	_ = x[ErrParseMissingIdentAfterAt-271]
-	_ = x[ErrParseUnexpectedOperator-272]
-	_ = x[ErrParseUnexpectedTerm-273]
-	_ = x[ErrParseUnexpectedToken-274]
-	_ = x[ErrParseUnexpectedKeyword-275]
-	_ = x[ErrParseExpectedExpression-276]
-	_ = x[ErrParseExpectedLeftParenAfterCast-277]
-	_ = x[ErrParseExpectedLeftParenValueConstructor-278]
-	_ = x[ErrParseExpectedLeftParenBuiltinFunctionCall-279]
-	_ = x[ErrParseExpectedArgumentDelimiter-280]
-	_ = x[ErrParseCastArity-281]
-	_ = x[ErrParseInvalidTypeParam-282]
-	_ = x[ErrParseEmptySelect-283]
-	_ = x[ErrParseSelectMissingFrom-284]
-	_ = x[ErrParseExpectedIdentForGroupName-285]
-	_ = x[ErrParseExpectedIdentForAlias-286]
-	_ = x[ErrParseUnsupportedCallWithStar-287]
-	_ = x[ErrParseNonUnaryAggregateFunctionCall-288]
-	_ = x[ErrParseMalformedJoin-289]
-	_ = x[ErrParseExpectedIdentForAt-290]
-	_ = x[ErrParseAsteriskIsNotAloneInSelectList-291]
-	_ = x[ErrParseCannotMixSqbAndWildcardInSelectList-292]
-	_ = x[ErrParseInvalidContextForWildcardInSelectList-293]
-	_ = x[ErrIncorrectSQLFunctionArgumentType-294]
-	_ = x[ErrValueParseFailure-295]
-	_ = x[ErrEvaluatorInvalidArguments-296]
-	_ = x[ErrIntegerOverflow-297]
-	_ = x[ErrLikeInvalidInputs-298]
-	_ = x[ErrCastFailed-299]
-	_ = x[ErrInvalidCast-300]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPattern-301]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbolForParsing-302]
-	_ = x[ErrEvaluatorTimestampFormatPatternDuplicateFields-303]
-	_ = x[ErrEvaluatorTimestampFormatPatternHourClockAmPmMismatch-304]
-	_ = x[ErrEvaluatorUnterminatedTimestampFormatPatternToken-305]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternToken-306]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbol-307]
-	_ = x[ErrEvaluatorBindingDoesNotExist-308]
-	_ = x[ErrMissingHeaders-309]
-	_ = x[ErrInvalidColumnIndex-310]
-	_ = x[ErrAdminConfigNotificationTargetsFailed-311]
-	_ = x[ErrAdminProfilerNotEnabled-312]
-	_ = x[ErrInvalidDecompressedSize-313]
-	_ = x[ErrAddUserInvalidArgument-314]
-	_ = x[ErrAddUserValidUTF-315]
-	_ = x[ErrAdminResourceInvalidArgument-316]
-	_ = x[ErrAdminAccountNotEligible-317]
-	_ = x[ErrAccountNotEligible-318]
-	_ = x[ErrAdminServiceAccountNotFound-319]
-	_ = x[ErrPostPolicyConditionInvalidFormat-320]
-	_ = x[ErrInvalidChecksum-321]
-	_ = x[ErrLambdaARNInvalid-322]
-	_ = x[ErrLambdaARNNotFound-323]
-	_ = x[ErrInvalidAttributeName-324]
-	_ = x[ErrAdminNoAccessKey-325]
-	_ = x[ErrAdminNoSecretKey-326]
-	_ = x[ErrIAMNotInitialized-327]
-	_ = x[apiErrCodeEnd-328]
+	_ = x[ErrInvalidClientSideEncryptionParameters-139]
+	_ = x[ErrInvalidSSECustomerAlgorithm-140]
+	_ = x[ErrInvalidSSECustomerKey-141]
+	_ = x[ErrMissingSSECustomerKey-142]
+	_ = x[ErrMissingSSECustomerKeyMD5-143]
+	_ = x[ErrSSECustomerKeyMD5Mismatch-144]
+	_ = x[ErrInvalidSSECustomerParameters-145]
+	_ = x[ErrIncompatibleEncryptionMethod-146]
+	_ = x[ErrKMSNotConfigured-147]
+	_ = x[ErrKMSKeyNotFoundException-148]
+	_ = x[ErrKMSDefaultKeyAlreadyConfigured-149]
+	_ = x[ErrNoAccessKey-150]
+	_ = x[ErrInvalidToken-151]
+	_ = x[ErrEventNotification-152]
+	_ = x[ErrARNNotification-153]
+	_ = x[ErrRegionNotification-154]
+	_ = x[ErrOverlappingFilterNotification-155]
+	_ = x[ErrFilterNameInvalid-156]
+	_ = x[ErrFilterNamePrefix-157]
+	_ = x[ErrFilterNameSuffix-158]
+	_ = x[ErrFilterValueInvalid-159]
+	_ = x[ErrOverlappingConfigs-160]
+	_ = x[ErrUnsupportedNotification-161]
+	_ = x[ErrContentSHA256Mismatch-162]
+	_ = x[ErrContentChecksumMismatch-163]
+	_ = x[ErrStorageFull-164]
+	_ = x[ErrRequestBodyParse-165]
+	_ = x[ErrObjectExistsAsDirectory-166]
+	_ = x[ErrInvalidObjectName-167]
+	_ = x[ErrInvalidObjectNamePrefixSlash-168]
+	_ = x[ErrInvalidResourceName-169]
+	_ = x[ErrInvalidLifecycleQueryParameter-170]
+	_ = x[ErrServerNotInitialized-171]
+	_ = x[ErrBucketMetadataNotInitialized-172]
+	_ = x[ErrReadOnlyMaintenance-173]
+	_ = x[ErrNodeDraining-174]
+	_ = x[ErrRequestTimedout-175]
+	_ = x[ErrClientDisconnected-176]
+	_ = x[ErrTooManyRequests-177]
+	_ = x[ErrInvalidRequest-178]
+	_ = x[ErrTransitionStorageClassNotFoundError-179]
+	_ = x[ErrInvalidStorageClass-180]
+	_ = x[ErrBackendDown-181]
+	_ = x[ErrMalformedJSON-182]
+	_ = x[ErrAdminNoSuchUser-183]
+	_ = x[ErrAdminNoSuchUserLDAPWarn-184]
+	_ = x[ErrAdminLDAPExpectedLoginName-185]
+	_ = x[ErrAdminNoSuchGroup-186]
+	_ = x[ErrAdminGroupNotEmpty-187]
+	_ = x[ErrAdminGroupDisabled-188]
+	_ = x[ErrAdminInvalidGroupName-189]
+	_ = x[ErrAdminNoSuchJob-190]
+	_ = x[ErrAdminNoSuchPolicy-191]
+	_ = x[ErrAdminPolicyChangeAlreadyApplied-192]
+	_ = x[ErrAdminInvalidArgument-193]
+	_ = x[ErrAdminInvalidAccessKey-194]
+	_ = x[ErrAdminInvalidSecretKey-195]
+	_ = x[ErrAdminConfigNoQuorum-196]
+	_ = x[ErrAdminConfigTooLarge-197]
+	_ = x[ErrAdminConfigBadJSON-198]
+	_ = x[ErrAdminNoSuchConfigTarget-199]
+	_ = x[ErrAdminConfigEnvOverridden-200]
+	_ = x[ErrAdminConfigDuplicateKeys-201]
+	_ = x[ErrAdminConfigInvalidIDPType-202]
+	_ = x[ErrAdminConfigLDAPNonDefaultConfigName-203]
+	_ = x[ErrAdminConfigLDAPValidation-204]
+	_ = x[ErrAdminConfigIDPCfgNameAlreadyExists-205]
+	_ = x[ErrAdminConfigIDPCfgNameDoesNotExist-206]
+	_ = x[ErrInsecureClientRequest-207]
+	_ = x[ErrObjectTampered-208]
+	_ = x[ErrAdminLDAPNotEnabled-209]
+	_ = x[ErrSiteReplicationInvalidRequest-210]
+	_ = x[ErrSiteReplicationPeerResp-211]
+	_ = x[ErrSiteReplicationBackendIssue-212]
+	_ = x[ErrSiteReplicationServiceAccountError-213]
+	_ = x[ErrSiteReplicationBucketConfigError-214]
+	_ = x[ErrSiteReplicationBucketMetaError-215]
+	_ = x[ErrSiteReplicationIAMError-216]
+	_ = x[ErrSiteReplicationConfigMissing-217]
+	_ = x[ErrSiteReplicationIAMConfigMismatch-218]
+	_ = x[ErrAdminRebalanceAlreadyStarted-219]
+	_ = x[ErrAdminRebalanceNotStarted-220]
+	_ = x[ErrAdminBucketQuotaExceeded-221]
+	_ = x[ErrAdminNoSuchQuotaConfiguration-222]
+	_ = x[ErrHealNotImplemented-223]
+	_ = x[ErrHealNoSuchProcess-224]
+	_ = x[ErrHealInvalidClientToken-225]
+	_ = x[ErrHealMissingBucket-226]
+	_ = x[ErrHealAlreadyRunning-227]
+	_ = x[ErrHealOverlappingPaths-228]
+	_ = x[ErrIncorrectContinuationToken-229]
+	_ = x[ErrEmptyRequestBody-230]
+	_ = x[ErrUnsupportedFunction-231]
+	_ = x[ErrInvalidExpressionType-232]
+	_ = x[ErrBusy-233]
+	_ = x[ErrUnauthorizedAccess-234]
+	_ = x[ErrExpressionTooLong-235]
+	_ = x[ErrIllegalSQLFunctionArgument-236]
+	_ = x[ErrInvalidKeyPath-237]
+	_ = x[ErrInvalidCompressionFormat-238]
+	_ = x[ErrInvalidFileHeaderInfo-239]
+	_ = x[ErrInvalidJSONType-240]
+	_ = x[ErrInvalidQuoteFields-241]
+	_ = x[ErrInvalidRequestParameter-242]
+	_ = x[ErrInvalidDataType-243]
+	_ = x[ErrInvalidTextEncoding-244]
+	_ = x[ErrInvalidDataSource-245]
+	_ = x[ErrInvalidTableAlias-246]
+	_ = x[ErrMissingRequiredParameter-247]
+	_ = x[ErrObjectSerializationConflict-248]
+	_ = x[ErrUnsupportedSQLOperation-249]
+	_ = x[ErrUnsupportedSQLStructure-250]
+	_ = x[ErrUnsupportedSyntax-251]
+	_ = x[ErrUnsupportedRangeHeader-252]
+	_ = x[ErrLexerInvalidChar-253]
+	_ = x[ErrLexerInvalidOperator-254]
+	_ = x[ErrLexerInvalidLiteral-255]
+	_ = x[ErrLexerInvalidIONLiteral-256]
+	_ = x[ErrParseExpectedDatePart-257]
+	_ = x[ErrParseExpectedKeyword-258]
+	_ = x[ErrParseExpectedTokenType-259]
+	_ = x[ErrParseExpected2TokenTypes-260]
+	_ = x[ErrParseExpectedNumber-261]
+	_ = x[ErrParseExpectedRightParenBuiltinFunctionCall-262]
+	_ = x[ErrParseExpectedTypeName-263]
+	_ = x[ErrParseExpectedWhenClause-264]
+	_ = x[ErrParseUnsupportedToken-265]
+	_ = x[ErrParseUnsupportedLiteralsGroupBy-266]
+	_ = x[ErrParseExpectedMember-267]
+	_ = x[ErrParseUnsupportedSelect-268]
+	_ = x[ErrParseUnsupportedCase-269]
+	_ = x[ErrParseUnsupportedCaseClause-270]
+	_ = x[ErrParseUnsupportedAlias-271]
+	_ = x[ErrParseUnsupportedSyntax-272]
+	_ = x[ErrParseUnknownOperator-273]
+	_ = x[ErrParseMissingIdentAfterAt-274]
+	_ = x[ErrParseUnexpectedOperator-275]
+	_ = x[ErrParseUnexpectedTerm-276]
+	_ = x[ErrParseUnexpectedToken-277]
+	_ = x[ErrParseUnexpectedKeyword-278]
+	_ = x[ErrParseExpectedExpression-279]
+	_ = x[ErrParseExpectedLeftParenAfterCast-280]
+	_ = x[ErrParseExpectedLeftParenValueConstructor-281]
+	_ = x[ErrParseExpectedLeftParenBuiltinFunctionCall-282]
+	_ = x[ErrParseExpectedArgumentDelimiter-283]
+	_ = x[ErrParseCastArity-284]
+	_ = x[ErrParseInvalidTypeParam-285]
+	_ = x[ErrParseEmptySelect-286]
+	_ = x[ErrParseSelectMissingFrom-287]
+	_ = x[ErrParseExpectedIdentForGroupName-288]
+	_ = x[ErrParseExpectedIdentForAlias-289]
+	_ = x[ErrParseUnsupportedCallWithStar-290]
+	_ = x[ErrParseNonUnaryAggregateFunctionCall-291]
+	_ = x[ErrParseMalformedJoin-292]
+	_ = x[ErrParseExpectedIdentForAt-293]
+	_ = x[ErrParseAsteriskIsNotAloneInSelectList-294]
+	_ = x[ErrParseCannotMixSqbAndWildcardInSelectList-295]
+	_ = x[ErrParseInvalidContextForWildcardInSelectList-296]
+	_ = x[ErrIncorrectSQLFunctionArgumentType-297]
+	_ = x[ErrValueParseFailure-298]
+	_ = x[ErrEvaluatorInvalidArguments-299]
+	_ = x[ErrIntegerOverflow-300]
+	_ = x[ErrLikeInvalidInputs-301]
+	_ = x[ErrCastFailed-302]
+	_ = x[ErrInvalidCast-303]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPattern-304]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbolForParsing-305]
+	_ = x[ErrEvaluatorTimestampFormatPatternDuplicateFields-306]
+	_ = x[ErrEvaluatorTimestampFormatPatternHourClockAmPmMismatch-307]
+	_ = x[ErrEvaluatorUnterminatedTimestampFormatPatternToken-308]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternToken-309]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbol-310]
+	_ = x[ErrEvaluatorBindingDoesNotExist-311]
+	_ = x[ErrMissingHeaders-312]
+	_ = x[ErrInvalidColumnIndex-313]
+	_ = x[ErrAdminConfigNotificationTargetsFailed-314]
+	_ = x[ErrAdminProfilerNotEnabled-315]
+	_ = x[ErrInvalidDecompressedSize-316]
+	_ = x[ErrAddUserInvalidArgument-317]
+	_ = x[ErrAddUserValidUTF-318]
+	_ = x[ErrAdminResourceInvalidArgument-319]
+	_ = x[ErrAdminAccountNotEligible-320]
+	_ = x[ErrAccountNotEligible-321]
+	_ = x[ErrAdminServiceAccountNotFound-322]
+	_ = x[ErrPostPolicyConditionInvalidFormat-323]
+	_ = x[ErrInvalidChecksum-324]
+	_ = x[ErrLambdaARNInvalid-325]
+	_ = x[ErrLambdaARNNotFound-326]
+	_ = x[ErrInvalidAttributeName-327]
+	_ = x[ErrAdminNoAccessKey-328]
+	_ = x[ErrAdminNoSecretKey-329]
+	_ = x[ErrIAMNotInitialized-330]
+	_ = x[apiErrCodeEnd-331]
 }
 
-const _APIErrorCode_name = "NoneAccessDeniedBadDigestEntityTooSmallEntityTooLargePolicyTooLargeIncompleteBodyInternalErrorInvalidAccessKeyIDAccessKeyDisabledInvalidArgumentInvalidBucketNameInvalidDigestInvalidRangeInvalidRangePartNumberInvalidCopyPartRangeInvalidCopyPartRangeSourceInvalidMaxKeysInvalidEncodingMethodInvalidMaxUploadsInvalidMaxPartsInvalidPartNumberMarkerInvalidPartNumberInvalidRequestBodyInvalidCopySourceInvalidMetadataDirectiveInvalidCopyDestInvalidPolicyDocumentInvalidObjectStateMalformedXMLMissingContentLengthMissingContentMD5MissingRequestBodyErrorMissingSecurityHeaderNoSuchBucketNoSuchBucketPolicyNoSuchBucketLifecycleNoSuchLifecycleConfigurationInvalidLifecycleWithObjectLockNoSuchBucketSSEConfigNoSuchCORSConfigurationNoSuchWebsiteConfigurationReplicationConfigurationNotFoundErrorRemoteDestinationNotFoundErrorReplicationDestinationMissingLockRemoteTargetNotFoundErrorReplicationRemoteConnectionErrorReplicationBandwidthLimitErrorBucketRemoteIdenticalToSourceBucketRemoteAlreadyExistsBucketRemoteLabelInUseBucketRemoteArnTypeInvalidBucketRemoteArnInvalidBucketRemoteRemoveDisallowedRemoteTargetNotVersionedErrorReplicationSourceNotVersionedErrorReplicationNeedsVersioningErrorReplicationBucketNeedsVersioningErrorReplicationDenyEditErrorRemoteTargetDenyAddErrorReplicationNoExistingObjectsReplicationValidationErrorReplicationPermissionCheckErrorObjectRestoreAlreadyInProgressNoSuchKeyNoSuchUploadInvalidVersionIDNoSuchVersionNotImplementedPreconditionFailedRequestTimeTooSkewedSignatureDoesNotMatchMethodNotAllowedInvalidPartInvalidPartOrderMissingPartAuthorizationHeaderMalformedMalformedPOSTRequestPOSTFileRequiredSignatureVersionNotSupportedBucketNotEmptyAllAccessDisabledPolicyInvalidVersionMissingFieldsMissingCredTagCredMalformedInvalidRegionInvalidServiceS3InvalidServiceSTSInvalidRequestVersionMissingSignTagMissingSignHeadersTagMalformedDateMalformedPresignedDateMalformedCredentialDateMalformedExpiresNegativeExpiresAuthHeaderEmptyExpiredPresignRequestRequestNotReadyYetUnsignedHeadersMissingDateHeaderInvalidQuerySignatureAlgoInvalidQueryParamsBucketAlreadyOwnedByYouInvalidDurationBucketAlreadyExistsMetadataTooLargeUnsupportedMetadataUnsupportedHostHeaderMaximumExpiresSlowDownReadSlowDownWriteMaxVersionsExceededInvalidPrefixMarkerBadRequestKeyTooLongErrorInvalidBucketObjectLockConfigurationObjectLockConfigurationNotFoundObjectLockConfigurationNotAllowedNoSuchObjectLockConfigurationObjectLockedInvalidRetentionDatePastObjectLockRetainDateUnknownWORMModeDirectiveBucketTaggingNotFoundObjectLockInvalidHeadersInvalidTagDirectivePolicyAlreadyAttachedPolicyNotAttachedExcessDataPolicyInvalidNameInvalidEncryptionMethodInvalidEncryptionKeyIDInsecureSSECustomerRequestSSEMultipartEncryptedSSEEncryptedObjectInvalidEncryptionParametersInvalidEncryptionParametersSSECInvalidSSECustomerAlgorithmInvalidSSECustomerKeyMissingSSECustomerKeyMissingSSECustomerKeyMD5SSECustomerKeyMD5MismatchInvalidSSECustomerParametersIncompatibleEncryptionMethodKMSNotConfiguredKMSKeyNotFoundExceptionKMSDefaultKeyAlreadyConfiguredNoAccessKeyInvalidTokenEventNotificationARNNotificationRegionNotificationOverlappingFilterNotificationFilterNameInvalidFilterNamePrefixFilterNameSuffixFilterValueInvalidOverlappingConfigsUnsupportedNotificationContentSHA256MismatchContentChecksumMismatchStorageFullRequestBodyParseObjectExistsAsDirectoryInvalidObjectNameInvalidObjectNamePrefixSlashInvalidResourceNameInvalidLifecycleQueryParameterServerNotInitializedBucketMetadataNotInitializedRequestTimedoutClientDisconnectedTooManyRequestsInvalidRequestTransitionStorageClassNotFoundErrorInvalidStorageClassBackendDownMalformedJSONAdminNoSuchUserAdminNoSuchUserLDAPWarnAdminLDAPExpectedLoginNameAdminNoSuchGroupAdminGroupNotEmptyAdminGroupDisabledAdminInvalidGroupNameAdminNoSuchJobAdminNoSuchPolicyAdminPolicyChangeAlreadyAppliedAdminInvalidArgumentAdminInvalidAccessKeyAdminInvalidSecretKeyAdminConfigNoQuorumAdminConfigTooLargeAdminConfigBadJSONAdminNoSuchConfigTargetAdminConfigEnvOverriddenAdminConfigDuplicateKeysAdminConfigInvalidIDPTypeAdminConfigLDAPNonDefaultConfigNameAdminConfigLDAPValidationAdminConfigIDPCfgNameAlreadyExistsAdminConfigIDPCfgNameDoesNotExistInsecureClientRequestObjectTamperedAdminLDAPNotEnabledSiteReplicationInvalidRequestSiteReplicationPeerRespSiteReplicationBackendIssueSiteReplicationServiceAccountErrorSiteReplicationBucketConfigErrorSiteReplicationBucketMetaErrorSiteReplicationIAMErrorSiteReplicationConfigMissingSiteReplicationIAMConfigMismatchAdminRebalanceAlreadyStartedAdminRebalanceNotStartedAdminBucketQuotaExceededAdminNoSuchQuotaConfigurationHealNotImplementedHealNoSuchProcessHealInvalidClientTokenHealMissingBucketHealAlreadyRunningHealOverlappingPathsIncorrectContinuationTokenEmptyRequestBodyUnsupportedFunctionInvalidExpressionTypeBusyUnauthorizedAccessExpressionTooLongIllegalSQLFunctionArgumentInvalidKeyPathInvalidCompressionFormatInvalidFileHeaderInfoInvalidJSONTypeInvalidQuoteFieldsInvalidRequestParameterInvalidDataTypeInvalidTextEncodingInvalidDataSourceInvalidTableAliasMissingRequiredParameterObjectSerializationConflictUnsupportedSQLOperationUnsupportedSQLStructureUnsupportedSyntaxUnsupportedRangeHeaderLexerInvalidCharLexerInvalidOperatorLexerInvalidLiteralLexerInvalidIONLiteralParseExpectedDatePartParseExpectedKeywordParseExpectedTokenTypeParseExpected2TokenTypesParseExpectedNumberParseExpectedRightParenBuiltinFunctionCallParseExpectedTypeNameParseExpectedWhenClauseParseUnsupportedTokenParseUnsupportedLiteralsGroupByParseExpectedMemberParseUnsupportedSelectParseUnsupportedCaseParseUnsupportedCaseClauseParseUnsupportedAliasParseUnsupportedSyntaxParseUnknownOperatorParseMissingIdentAfterAtParseUnexpectedOperatorParseUnexpectedTermParseUnexpectedTokenParseUnexpectedKeywordParseExpectedExpressionParseExpectedLeftParenAfterCastParseExpectedLeftParenValueConstructorParseExpectedLeftParenBuiltinFunctionCallParseExpectedArgumentDelimiterParseCastArityParseInvalidTypeParamParseEmptySelectParseSelectMissingFromParseExpectedIdentForGroupNameParseExpectedIdentForAliasParseUnsupportedCallWithStarParseNonUnaryAggregateFunctionCallParseMalformedJoinParseExpectedIdentForAtParseAsteriskIsNotAloneInSelectListParseCannotMixSqbAndWildcardInSelectListParseInvalidContextForWildcardInSelectListIncorrectSQLFunctionArgumentTypeValueParseFailureEvaluatorInvalidArgumentsIntegerOverflowLikeInvalidInputsCastFailedInvalidCastEvaluatorInvalidTimestampFormatPatternEvaluatorInvalidTimestampFormatPatternSymbolForParsingEvaluatorTimestampFormatPatternDuplicateFieldsEvaluatorTimestampFormatPatternHourClockAmPmMismatchEvaluatorUnterminatedTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternSymbolEvaluatorBindingDoesNotExistMissingHeadersInvalidColumnIndexAdminConfigNotificationTargetsFailedAdminProfilerNotEnabledInvalidDecompressedSizeAddUserInvalidArgumentAddUserValidUTFAdminResourceInvalidArgumentAdminAccountNotEligibleAccountNotEligibleAdminServiceAccountNotFoundPostPolicyConditionInvalidFormatInvalidChecksumLambdaARNInvalidLambdaARNNotFoundInvalidAttributeNameAdminNoAccessKeyAdminNoSecretKeyIAMNotInitializedapiErrCodeEnd"
+const _APIErrorCode_name = "NoneAccessDeniedBadDigestEntityTooSmallEntityTooLargePolicyTooLargeIncompleteBodyInternalErrorInvalidAccessKeyIDAccessKeyDisabledInvalidArgumentInvalidBucketNameInvalidDigestInvalidRangeInvalidRangePartNumberInvalidCopyPartRangeInvalidCopyPartRangeSourceInvalidMaxKeysInvalidEncodingMethodInvalidMaxUploadsInvalidMaxPartsInvalidPartNumberMarkerInvalidPartNumberInvalidRequestBodyInvalidCopySourceInvalidMetadataDirectiveInvalidCopyDestInvalidPolicyDocumentInvalidObjectStateMalformedXMLMissingContentLengthMissingContentMD5MissingRequestBodyErrorMissingSecurityHeaderNoSuchBucketNoSuchBucketPolicyNoSuchBucketLifecycleNoSuchLifecycleConfigurationInvalidLifecycleWithObjectLockNoSuchBucketSSEConfigNoSuchCORSConfigurationNoSuchWebsiteConfigurationReplicationConfigurationNotFoundErrorRemoteDestinationNotFoundErrorReplicationDestinationMissingLockRemoteTargetNotFoundErrorReplicationRemoteConnectionErrorReplicationBandwidthLimitErrorBucketRemoteIdenticalToSourceBucketRemoteAlreadyExistsBucketRemoteLabelInUseBucketRemoteArnTypeInvalidBucketRemoteArnInvalidBucketRemoteRemoveDisallowedRemoteTargetNotVersionedErrorReplicationSourceNotVersionedErrorReplicationNeedsVersioningErrorReplicationBucketNeedsVersioningErrorReplicationDenyEditErrorRemoteTargetDenyAddErrorReplicationNoExistingObjectsReplicationValidationErrorReplicationPermissionCheckErrorObjectRestoreAlreadyInProgressNoSuchKeyNoSuchUploadInvalidVersionIDNoSuchVersionNotImplementedPreconditionFailedRequestTimeTooSkewedSignatureDoesNotMatchMethodNotAllowedInvalidPartInvalidPartOrderMissingPartAuthorizationHeaderMalformedMalformedPOSTRequestPOSTFileRequiredSignatureVersionNotSupportedBucketNotEmptyAllAccessDisabledPolicyInvalidVersionMissingFieldsMissingCredTagCredMalformedInvalidRegionInvalidServiceS3InvalidServiceSTSInvalidRequestVersionMissingSignTagMissingSignHeadersTagMalformedDateMalformedPresignedDateMalformedCredentialDateMalformedExpiresNegativeExpiresAuthHeaderEmptyExpiredPresignRequestRequestNotReadyYetUnsignedHeadersMissingDateHeaderInvalidQuerySignatureAlgoInvalidQueryParamsBucketAlreadyOwnedByYouInvalidDurationBucketAlreadyExistsMetadataTooLargeUnsupportedMetadataUnsupportedHostHeaderMaximumExpiresSlowDownReadSlowDownWriteMaxVersionsExceededInvalidPrefixMarkerBadRequestKeyTooLongErrorInvalidBucketObjectLockConfigurationObjectLockConfigurationNotFoundObjectLockConfigurationNotAllowedNoSuchObjectLockConfigurationObjectLockedInvalidRetentionDatePastObjectLockRetainDateUnknownWORMModeDirectiveBucketTaggingNotFoundObjectLockInvalidHeadersInvalidTagDirectivePolicyAlreadyAttachedPolicyNotAttachedExcessDataPolicyInvalidNameInvalidEncryptionMethodInvalidEncryptionKeyIDInsecureSSECustomerRequestSSEMultipartEncryptedSSEEncryptedObjectInvalidEncryptionParametersInvalidEncryptionParametersSSECInvalidClientSideEncryptionParametersInvalidSSECustomerAlgorithmInvalidSSECustomerKeyMissingSSECustomerKeyMissingSSECustomerKeyMD5SSECustomerKeyMD5MismatchInvalidSSECustomerParametersIncompatibleEncryptionMethodKMSNotConfiguredKMSKeyNotFoundExceptionKMSDefaultKeyAlreadyConfiguredNoAccessKeyInvalidTokenEventNotificationARNNotificationRegionNotificationOverlappingFilterNotificationFilterNameInvalidFilterNamePrefixFilterNameSuffixFilterValueInvalidOverlappingConfigsUnsupportedNotificationContentSHA256MismatchContentChecksumMismatchStorageFullRequestBodyParseObjectExistsAsDirectoryInvalidObjectNameInvalidObjectNamePrefixSlashInvalidResourceNameInvalidLifecycleQueryParameterServerNotInitializedBucketMetadataNotInitializedReadOnlyMaintenanceNodeDrainingRequestTimedoutClientDisconnectedTooManyRequestsInvalidRequestTransitionStorageClassNotFoundErrorInvalidStorageClassBackendDownMalformedJSONAdminNoSuchUserAdminNoSuchUserLDAPWarnAdminLDAPExpectedLoginNameAdminNoSuchGroupAdminGroupNotEmptyAdminGroupDisabledAdminInvalidGroupNameAdminNoSuchJobAdminNoSuchPolicyAdminPolicyChangeAlreadyAppliedAdminInvalidArgumentAdminInvalidAccessKeyAdminInvalidSecretKeyAdminConfigNoQuorumAdminConfigTooLargeAdminConfigBadJSONAdminNoSuchConfigTargetAdminConfigEnvOverriddenAdminConfigDuplicateKeysAdminConfigInvalidIDPTypeAdminConfigLDAPNonDefaultConfigNameAdminConfigLDAPValidationAdminConfigIDPCfgNameAlreadyExistsAdminConfigIDPCfgNameDoesNotExistInsecureClientRequestObjectTamperedAdminLDAPNotEnabledSiteReplicationInvalidRequestSiteReplicationPeerRespSiteReplicationBackendIssueSiteReplicationServiceAccountErrorSiteReplicationBucketConfigErrorSiteReplicationBucketMetaErrorSiteReplicationIAMErrorSiteReplicationConfigMissingSiteReplicationIAMConfigMismatchAdminRebalanceAlreadyStartedAdminRebalanceNotStartedAdminBucketQuotaExceededAdminNoSuchQuotaConfigurationHealNotImplementedHealNoSuchProcessHealInvalidClientTokenHealMissingBucketHealAlreadyRunningHealOverlappingPathsIncorrectContinuationTokenEmptyRequestBodyUnsupportedFunctionInvalidExpressionTypeBusyUnauthorizedAccessExpressionTooLongIllegalSQLFunctionArgumentInvalidKeyPathInvalidCompressionFormatInvalidFileHeaderInfoInvalidJSONTypeInvalidQuoteFieldsInvalidRequestParameterInvalidDataTypeInvalidTextEncodingInvalidDataSourceInvalidTableAliasMissingRequiredParameterObjectSerializationConflictUnsupportedSQLOperationUnsupportedSQLStructureUnsupportedSyntaxUnsupportedRangeHeaderLexerInvalidCharLexerInvalidOperatorLexerInvalidLiteralLexerInvalidIONLiteralParseExpectedDatePartParseExpectedKeywordParseExpectedTokenTypeParseExpected2TokenTypesParseExpectedNumberParseExpectedRightParenBuiltinFunctionCallParseExpectedTypeNameParseExpectedWhenClauseParseUnsupportedTokenParseUnsupportedLiteralsGroupByParseExpectedMemberParseUnsupportedSelectParseUnsupportedCaseParseUnsupportedCaseClauseParseUnsupportedAliasParseUnsupportedSyntaxParseUnknownOperatorParseMissingIdentAfterAtParseUnexpectedOperatorParseUnexpectedTermParseUnexpectedTokenParseUnexpectedKeywordParseExpectedExpressionParseExpectedLeftParenAfterCastParseExpectedLeftParenValueConstructorParseExpectedLeftParenBuiltinFunctionCallParseExpectedArgumentDelimiterParseCastArityParseInvalidTypeParamParseEmptySelectParseSelectMissingFromParseExpectedIdentForGroupNameParseExpectedIdentForAliasParseUnsupportedCallWithStarParseNonUnaryAggregateFunctionCallParseMalformedJoinParseExpectedIdentForAtParseAsteriskIsNotAloneInSelectListParseCannotMixSqbAndWildcardInSelectListParseInvalidContextForWildcardInSelectListIncorrectSQLFunctionArgumentTypeValueParseFailureEvaluatorInvalidArgumentsIntegerOverflowLikeInvalidInputsCastFailedInvalidCastEvaluatorInvalidTimestampFormatPatternEvaluatorInvalidTimestampFormatPatternSymbolForParsingEvaluatorTimestampFormatPatternDuplicateFieldsEvaluatorTimestampFormatPatternHourClockAmPmMismatchEvaluatorUnterminatedTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternSymbolEvaluatorBindingDoesNotExistMissingHeadersInvalidColumnIndexAdminConfigNotificationTargetsFailedAdminProfilerNotEnabledInvalidDecompressedSizeAddUserInvalidArgumentAddUserValidUTFAdminResourceInvalidArgumentAdminAccountNotEligibleAccountNotEligibleAdminServiceAccountNotFoundPostPolicyConditionInvalidFormatInvalidChecksumLambdaARNInvalidLambdaARNNotFoundInvalidAttributeNameAdminNoAccessKeyAdminNoSecretKeyIAMNotInitializedapiErrCodeEnd"
 
-var _APIErrorCode_index = [...]uint16{0, 4, 16, 25, 39, 53, 67, 81, 94, 112, 129, 144, 161, 174, 186, 208, 228, 254, 268, 289, 306, 321, 344, 361, 379, 396, 420, 435, 456, 474, 486, 506, 523, 546, 567, 579, 597, 618, 646, 676, 697, 720, 746, 783, 813, 846, 871, 903, 933, 962, 987, 1009, 1035, 1057, 1085, 1114, 1148, 1179, 1216, 1240, 1264, 1292, 1318, 1349, 1379, 1388, 1400, 1416, 1429, 1443, 1461, 1481, 1502, 1518, 1529, 1545, 1556, 1584, 1604, 1620, 1648, 1662, 1679, 1699, 1712, 1726, 1739, 1752, 1768, 1785, 1806, 1820, 1841, 1854, 1876, 1899, 1915, 1930, 1945, 1966, 1984, 1999, 2016, 2041, 2059, 2082, 2097, 2116, 2132, 2151, 2172, 2186, 2198, 2211, 2230, 2249, 2259, 2274, 2310, 2341, 2374, 2403, 2415, 2435, 2459, 2483, 2504, 2528, 2547, 2568, 2585, 2595, 2612, 2635, 2657, 2683, 2704, 2722, 2749, 2780, 2807, 2828, 2849, 2873, 2898, 2926, 2954, 2970, 2993, 3023, 3034, 3046, 3063, 3078, 3096, 3125, 3142, 3158, 3174, 3192, 3210, 3233, 3254, 3277, 3288, 3304, 3327, 3344, 3372, 3391, 3421, 3441, 3469, 3484, 3502, 3517, 3531, 3566, 3585, 3596, 3609, 3624, 3647, 3673, 3689, 3707, 3725, 3746, 3760, 3777, 3808, 3828, 3849, 3870, 3889, 3908, 3926, 3949, 3973, 3997, 4022, 4057, 4082, 4116, 4149, 4170, 4184, 4203, 4232, 4255, 4282, 4316, 4348, 4378, 4401, 4429, 4461, 4489, 4513, 4537, 4566, 4584, 4601, 4623, 4640, 4658, 4678, 4704, 4720, 4739, 4760, 4764, 4782, 4799, 4825, 4839, 4863, 4884, 4899, 4917, 4940, 4955, 4974, 4991, 5008, 5032, 5059, 5082, 5105, 5122, 5144, 5160, 5180, 5199, 5221, 5242, 5262, 5284, 5308, 5327, 5369, 5390, 5413, 5434, 5465, 5484, 5506, 5526, 5552, 5573, 5595, 5615, 5639, 5662, 5681, 5701, 5723, 5746, 5777, 5815, 5856, 5886, 5900, 5921, 5937, 5959, 5989, 6015, 6043, 6077, 6095, 6118, 6153, 6193, 6235, 6267, 6284, 6309, 6324, 6341, 6351, 6362, 6400, 6454, 6500, 6552, 6600, 6643, 6687, 6715, 6729, 6747, 6783, 6806, 6829, 6851, 6866, 6894, 6917, 6935, 6962, 6994, 7009, 7025, 7042, 7062, 7078, 7094, 7111, 7124}
+var _APIErrorCode_index = [...]uint16{0, 4, 16, 25, 39, 53, 67, 81, 94, 112, 129, 144, 161, 174, 186, 208, 228, 254, 268, 289, 306, 321, 344, 361, 379, 396, 420, 435, 456, 474, 486, 506, 523, 546, 567, 579, 597, 618, 646, 676, 697, 720, 746, 783, 813, 846, 871, 903, 933, 962, 987, 1009, 1035, 1057, 1085, 1114, 1148, 1179, 1216, 1240, 1264, 1292, 1318, 1349, 1379, 1388, 1400, 1416, 1429, 1443, 1461, 1481, 1502, 1518, 1529, 1545, 1556, 1584, 1604, 1620, 1648, 1662, 1679, 1699, 1712, 1726, 1739, 1752, 1768, 1785, 1806, 1820, 1841, 1854, 1876, 1899, 1915, 1930, 1945, 1966, 1984, 1999, 2016, 2041, 2059, 2082, 2097, 2116, 2132, 2151, 2172, 2186, 2198, 2211, 2230, 2249, 2259, 2274, 2310, 2341, 2374, 2403, 2415, 2435, 2459, 2483, 2504, 2528, 2547, 2568, 2585, 2595, 2612, 2635, 2657, 2683, 2704, 2722, 2749, 2780, 2817, 2844, 2865, 2886, 2910, 2935, 2963, 2991, 3007, 3030, 3060, 3071, 3083, 3100, 3115, 3133, 3162, 3179, 3195, 3211, 3229, 3247, 3270, 3291, 3314, 3325, 3341, 3364, 3381, 3409, 3428, 3458, 3478, 3506, 3525, 3537, 3552, 3570, 3585, 3599, 3634, 3653, 3664, 3677, 3692, 3715, 3741, 3757, 3775, 3793, 3814, 3828, 3845, 3876, 3896, 3917, 3938, 3957, 3976, 3994, 4017, 4041, 4065, 4090, 4125, 4150, 4184, 4217, 4238, 4252, 4271, 4300, 4323, 4350, 4384, 4416, 4446, 4469, 4497, 4529, 4557, 4581, 4605, 4634, 4652, 4669, 4691, 4708, 4726, 4746, 4772, 4788, 4807, 4828, 4832, 4850, 4867, 4893, 4907, 4931, 4952, 4967, 4985, 5008, 5023, 5042, 5059, 5076, 5100, 5127, 5150, 5173, 5190, 5212, 5228, 5248, 5267, 5289, 5310, 5330, 5352, 5376, 5395, 5437, 5458, 5481, 5502, 5533, 5552, 5574, 5594, 5620, 5641, 5663, 5683, 5707, 5730, 5749, 5769, 5791, 5814, 5845, 5883, 5924, 5954, 5968, 5989, 6005, 6027, 6057, 6083, 6111, 6145, 6163, 6186, 6221, 6261, 6303, 6335, 6352, 6377, 6392, 6409, 6419, 6430, 6468, 6522, 6568, 6620, 6668, 6711, 6755, 6783, 6797, 6815, 6851, 6874, 6897, 6919, 6934, 6962, 6985, 7003, 7030, 7062, 7077, 7093, 7110, 7130, 7146, 7162, 7179, 7192}
 
 func (i APIErrorCode) String() string {
-	if i < 0 || i >= APIErrorCode(len(_APIErrorCode_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_APIErrorCode_index)-1 {
 		return "APIErrorCode(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _APIErrorCode_name[_APIErrorCode_index[i]:_APIErrorCode_index[i+1]]
+	return _APIErrorCode_name[_APIErrorCode_index[idx]:_APIErrorCode_index[idx+1]]
 }
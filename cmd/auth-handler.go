@@ -297,8 +297,9 @@ func checkClaimsFromToken(r *http.Request, cred auth.Credentials) (map[string]in
 		return nil, ErrInvalidToken
 	}
 
-	// Expired credentials must return error right away.
-	if cred.IsTemp() && cred.IsExpired() {
+	// Expired credentials must return error right away, this covers both
+	// temporary STS credentials and service accounts with an expiration.
+	if cred.IsExpired() {
 		return nil, toAPIErrorCode(r.Context(), errInvalidAccessKeyID)
 	}
 	secret := globalActiveCred.SecretKey
@@ -532,7 +533,22 @@ func checkRequestAuthTypeCredential(ctx context.Context, r *http.Request, action
 		return cred, owner, s3Err
 	}
 
-	return cred, owner, authorizeRequest(ctx, r, action)
+	if s3Err = authorizeRequest(ctx, r, action); s3Err != ErrNone {
+		return cred, owner, s3Err
+	}
+
+	// Honor x-amz-expected-bucket-owner when the client provides it,
+	// only after the caller has been authenticated and authorized so a
+	// mismatch response cannot be used as a pre-auth probe. All buckets
+	// on this deployment are owned by the root account whose canonical
+	// ID is globalMinioDefaultOwnerID.
+	if expectedOwner := r.Header.Get(xhttp.AmzExpectedBucketOwner); expectedOwner != "" {
+		if reqInfo.BucketName != "" && expectedOwner != globalMinioDefaultOwnerID {
+			return cred, owner, ErrAccessDenied
+		}
+	}
+
+	return cred, owner, ErrNone
 }
 
 // Verify if request has valid AWS Signature Version '2'.
@@ -67,13 +67,16 @@ const (
 
 // BatchJobRequest this is an internal data structure not for external consumption.
 type BatchJobRequest struct {
-	ID        string               `yaml:"-" json:"name"`
-	User      string               `yaml:"-" json:"user"`
-	Started   time.Time            `yaml:"-" json:"started"`
-	Replicate *BatchJobReplicateV1 `yaml:"replicate" json:"replicate"`
-	KeyRotate *BatchJobKeyRotateV1 `yaml:"keyrotate" json:"keyrotate"`
-	Expire    *BatchJobExpire      `yaml:"expire" json:"expire"`
-	ctx       context.Context      `msg:"-"`
+	ID         string                `yaml:"-" json:"name"`
+	User       string                `yaml:"-" json:"user"`
+	Started    time.Time             `yaml:"-" json:"started"`
+	Replicate  *BatchJobReplicateV1  `yaml:"replicate" json:"replicate"`
+	KeyRotate  *BatchJobKeyRotateV1  `yaml:"keyrotate" json:"keyrotate"`
+	Expire     *BatchJobExpire       `yaml:"expire" json:"expire"`
+	ListExport *BatchJobListExportV1 `yaml:"listexport" json:"listexport"`
+	Inventory  *BatchJobInventoryV1  `yaml:"inventory" json:"inventory"`
+	Repack     *BatchJobRepackV1     `yaml:"repack" json:"repack"`
+	ctx        context.Context       `msg:"-"`
 }
 
 // RedactSensitive will redact any sensitive information in b.
@@ -81,6 +84,9 @@ func (j *BatchJobRequest) RedactSensitive() {
 	j.Replicate.RedactSensitive()
 	j.Expire.RedactSensitive()
 	j.KeyRotate.RedactSensitive()
+	j.ListExport.RedactSensitive()
+	j.Inventory.RedactSensitive()
+	j.Repack.RedactSensitive()
 }
 
 // RedactSensitive will redact any sensitive information in b.
@@ -788,6 +794,12 @@ func (ri *batchJobInfo) getJobReportPath() (string, error) {
 		fileName = batchKeyRotationName
 	case madmin.BatchJobExpire:
 		fileName = batchExpireName
+	case batchJobListExport:
+		fileName = batchListExportName
+	case batchJobInventory:
+		fileName = batchInventoryName
+	case batchJobRepack:
+		fileName = batchRepackName
 	default:
 		return "", fmt.Errorf("unknown job type: %v", ri.JobType)
 	}
@@ -804,6 +816,12 @@ func (ri *batchJobInfo) loadOrInit(ctx context.Context, api ObjectLayer, job Bat
 			ri.Version = batchKeyRotateVersionV1
 		case job.Expire != nil:
 			ri.Version = batchExpireVersionV1
+		case job.ListExport != nil:
+			ri.Version = batchListExportVersionV1
+		case job.Inventory != nil:
+			ri.Version = batchInventoryVersionV1
+		case job.Repack != nil:
+			ri.Version = batchRepackVersionV1
 		}
 		return nil
 	}
@@ -831,6 +849,15 @@ func (ri *batchJobInfo) loadByPath(ctx context.Context, api ObjectLayer, path st
 	case batchExpireName:
 		version = batchExpireVersionV1
 		format = batchExpireFormat
+	case batchListExportName:
+		version = batchListExportVersionV1
+		format = batchListExportFormat
+	case batchInventoryName:
+		version = batchInventoryVersionV1
+		format = batchInventoryFormat
+	case batchRepackName:
+		version = batchRepackVersionV1
+		format = batchRepackFormat
 	default:
 		return errors.New("no supported batch job request specified")
 	}
@@ -963,6 +990,21 @@ func (ri *batchJobInfo) updateAfter(ctx context.Context, api ObjectLayer, durati
 			version = batchExpireVersion
 			jobTyp = string(job.Type())
 			ri.Version = batchExpireVersionV1
+		case batchJobListExport:
+			format = batchListExportFormat
+			version = batchListExportVersion
+			jobTyp = string(job.Type())
+			ri.Version = batchListExportVersionV1
+		case batchJobInventory:
+			format = batchInventoryFormat
+			version = batchInventoryVersion
+			jobTyp = string(job.Type())
+			ri.Version = batchInventoryVersionV1
+		case batchJobRepack:
+			format = batchRepackFormat
+			version = batchRepackVersion
+			jobTyp = string(job.Type())
+			ri.Version = batchRepackVersionV1
 		default:
 			return errInvalidArgument
 		}
@@ -1504,6 +1546,12 @@ func (j BatchJobRequest) Type() madmin.BatchJobType {
 		return madmin.BatchJobKeyRotate
 	case j.Expire != nil:
 		return madmin.BatchJobExpire
+	case j.ListExport != nil:
+		return batchJobListExport
+	case j.Inventory != nil:
+		return batchJobInventory
+	case j.Repack != nil:
+		return batchJobRepack
 	}
 	return madmin.BatchJobType("unknown")
 }
@@ -1518,6 +1566,12 @@ func (j BatchJobRequest) Validate(ctx context.Context, o ObjectLayer) error {
 		return j.KeyRotate.Validate(ctx, j, o)
 	case j.Expire != nil:
 		return j.Expire.Validate(ctx, j, o)
+	case j.ListExport != nil:
+		return j.ListExport.Validate(ctx, j, o)
+	case j.Inventory != nil:
+		return j.Inventory.Validate(ctx, j, o)
+	case j.Repack != nil:
+		return j.Repack.Validate(ctx, j, o)
 	}
 	return errInvalidArgument
 }
@@ -1535,6 +1589,12 @@ func (j BatchJobRequest) getJobReportPath() (string, error) {
 		fileName = batchKeyRotationName
 	case j.Expire != nil:
 		fileName = batchExpireName
+	case j.ListExport != nil:
+		fileName = batchListExportName
+	case j.Inventory != nil:
+		fileName = batchInventoryName
+	case j.Repack != nil:
+		fileName = batchRepackName
 	default:
 		return "", errors.New("unknown job type")
 	}
@@ -1542,7 +1602,7 @@ func (j BatchJobRequest) getJobReportPath() (string, error) {
 }
 
 func (j *BatchJobRequest) save(ctx context.Context, api ObjectLayer) error {
-	if j.Replicate == nil && j.KeyRotate == nil && j.Expire == nil {
+	if j.Replicate == nil && j.KeyRotate == nil && j.Expire == nil && j.ListExport == nil && j.Inventory == nil && j.Repack == nil {
 		return errInvalidArgument
 	}
 
@@ -1618,7 +1678,8 @@ func (a adminAPIHandlers) ListBatchJobs(w http.ResponseWriter, r *http.Request)
 			writeErrorResponseJSON(ctx, w, toAPIError(ctx, result.Err), r.URL)
 			return
 		}
-		if strings.HasPrefix(result.Item.Name, batchJobReportsPrefix+slashSeparator) {
+		if strings.HasPrefix(result.Item.Name, batchJobReportsPrefix+slashSeparator) ||
+			strings.HasPrefix(result.Item.Name, batchJobSchedulesPrefix+slashSeparator) {
 			continue
 		}
 		req := &BatchJobRequest{}
@@ -1667,6 +1728,12 @@ func (a adminAPIHandlers) BatchJobStatus(w http.ResponseWriter, r *http.Request)
 			req.KeyRotate = &BatchJobKeyRotateV1{}
 		case madmin.BatchJobExpire:
 			req.Expire = &BatchJobExpire{}
+		case batchJobListExport:
+			req.ListExport = &BatchJobListExportV1{}
+		case batchJobInventory:
+			req.Inventory = &BatchJobInventoryV1{}
+		case batchJobRepack:
+			req.Repack = &BatchJobRepackV1{}
 		default:
 			writeErrorResponseJSON(ctx, w, toAPIError(ctx, errors.New("job ID format unrecognized")), r.URL)
 			return
@@ -1731,6 +1798,30 @@ func (a adminAPIHandlers) DescribeBatchJob(w http.ResponseWriter, r *http.Reques
 	w.Write(buf)
 }
 
+// applyDefaults fills in default values left unset in the job definition.
+func (j *BatchJobRequest) applyDefaults() {
+	if j.Replicate != nil {
+		if j.Replicate.Source.Snowball.Disable == nil {
+			j.Replicate.Source.Snowball.Disable = ptr(false)
+		}
+		if j.Replicate.Source.Snowball.Batch == nil {
+			j.Replicate.Source.Snowball.Batch = ptr(100)
+		}
+		if j.Replicate.Source.Snowball.InMemory == nil {
+			j.Replicate.Source.Snowball.InMemory = ptr(true)
+		}
+		if j.Replicate.Source.Snowball.Compress == nil {
+			j.Replicate.Source.Snowball.Compress = ptr(false)
+		}
+		if j.Replicate.Source.Snowball.SmallerThan == nil {
+			j.Replicate.Source.Snowball.SmallerThan = ptr("5MiB")
+		}
+		if j.Replicate.Source.Snowball.SkipErrs == nil {
+			j.Replicate.Source.Snowball.SkipErrs = ptr(true)
+		}
+	}
+}
+
 // StartBatchJob queue a new job for execution
 func (a adminAPIHandlers) StartBatchJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1758,26 +1849,7 @@ func (a adminAPIHandlers) StartBatchJob(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Fill with default values
-	if job.Replicate != nil {
-		if job.Replicate.Source.Snowball.Disable == nil {
-			job.Replicate.Source.Snowball.Disable = ptr(false)
-		}
-		if job.Replicate.Source.Snowball.Batch == nil {
-			job.Replicate.Source.Snowball.Batch = ptr(100)
-		}
-		if job.Replicate.Source.Snowball.InMemory == nil {
-			job.Replicate.Source.Snowball.InMemory = ptr(true)
-		}
-		if job.Replicate.Source.Snowball.Compress == nil {
-			job.Replicate.Source.Snowball.Compress = ptr(false)
-		}
-		if job.Replicate.Source.Snowball.SmallerThan == nil {
-			job.Replicate.Source.Snowball.SmallerThan = ptr("5MiB")
-		}
-		if job.Replicate.Source.Snowball.SkipErrs == nil {
-			job.Replicate.Source.Snowball.SkipErrs = ptr(true)
-		}
-	}
+	job.applyDefaults()
 
 	//  Validate the incoming job request
 	if err := job.Validate(ctx, objectAPI); err != nil {
@@ -1883,6 +1955,7 @@ func newBatchJobPool(ctx context.Context, o ObjectLayer, workers int) *BatchJobP
 		jpool.resume(randomWait)
 		jpool.cleanupReports(randomWait)
 	}()
+	go jpool.runScheduler(ctx)
 
 	return jpool
 }
@@ -1939,7 +2012,8 @@ func (j *BatchJobPool) resume(randomWait func() time.Duration) {
 			batchLogIf(j.ctx, result.Err)
 			continue
 		}
-		if strings.HasPrefix(result.Item.Name, batchJobReportsPrefix+slashSeparator) {
+		if strings.HasPrefix(result.Item.Name, batchJobReportsPrefix+slashSeparator) ||
+			strings.HasPrefix(result.Item.Name, batchJobSchedulesPrefix+slashSeparator) {
 			continue
 		}
 		// ignore batch-replicate.bin and batch-rotate.bin entries
@@ -2011,6 +2085,27 @@ func (j *BatchJobPool) AddWorker() {
 						continue
 					}
 				}
+			case job.ListExport != nil:
+				if err := job.ListExport.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						batchLogIf(j.ctx, err)
+						continue
+					}
+				}
+			case job.Inventory != nil:
+				if err := job.Inventory.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						batchLogIf(j.ctx, err)
+						continue
+					}
+				}
+			case job.Repack != nil:
+				if err := job.Repack.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						batchLogIf(j.ctx, err)
+						continue
+					}
+				}
 			}
 			j.canceler(job.ID, false)
 		case <-j.workerKillCh:
@@ -179,6 +179,60 @@ func (z *BatchJobRequest) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "ListExport":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "ListExport")
+					return
+				}
+				z.ListExport = nil
+			} else {
+				if z.ListExport == nil {
+					z.ListExport = new(BatchJobListExportV1)
+				}
+				err = z.ListExport.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "ListExport")
+					return
+				}
+			}
+		case "Inventory":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Inventory")
+					return
+				}
+				z.Inventory = nil
+			} else {
+				if z.Inventory == nil {
+					z.Inventory = new(BatchJobInventoryV1)
+				}
+				err = z.Inventory.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Inventory")
+					return
+				}
+			}
+		case "Repack":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Repack")
+					return
+				}
+				z.Repack = nil
+			} else {
+				if z.Repack == nil {
+					z.Repack = new(BatchJobRepackV1)
+				}
+				err = z.Repack.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Repack")
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -192,9 +246,9 @@ func (z *BatchJobRequest) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *BatchJobRequest) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 6
+	// map header, size 9
 	// write "ID"
-	err = en.Append(0x86, 0xa2, 0x49, 0x44)
+	err = en.Append(0x89, 0xa2, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -274,15 +328,66 @@ func (z *BatchJobRequest) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "ListExport"
+	err = en.Append(0xaa, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74)
+	if err != nil {
+		return
+	}
+	if z.ListExport == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.ListExport.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "ListExport")
+			return
+		}
+	}
+	// write "Inventory"
+	err = en.Append(0xa9, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79)
+	if err != nil {
+		return
+	}
+	if z.Inventory == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Inventory.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Inventory")
+			return
+		}
+	}
+	// write "Repack"
+	err = en.Append(0xa6, 0x52, 0x65, 0x70, 0x61, 0x63, 0x6b)
+	if err != nil {
+		return
+	}
+	if z.Repack == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Repack.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Repack")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *BatchJobRequest) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 9
 	// string "ID"
-	o = append(o, 0x86, 0xa2, 0x49, 0x44)
+	o = append(o, 0x89, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "User"
 	o = append(o, 0xa4, 0x55, 0x73, 0x65, 0x72)
@@ -323,6 +428,39 @@ func (z *BatchJobRequest) MarshalMsg(b []byte) (o []byte, err error) {
 			return
 		}
 	}
+	// string "ListExport"
+	o = append(o, 0xaa, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74)
+	if z.ListExport == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.ListExport.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "ListExport")
+			return
+		}
+	}
+	// string "Inventory"
+	o = append(o, 0xa9, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79)
+	if z.Inventory == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Inventory.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Inventory")
+			return
+		}
+	}
+	// string "Repack"
+	o = append(o, 0xa6, 0x52, 0x65, 0x70, 0x61, 0x63, 0x6b)
+	if z.Repack == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Repack.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Repack")
+			return
+		}
+	}
 	return
 }
 
@@ -413,6 +551,57 @@ func (z *BatchJobRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "ListExport":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.ListExport = nil
+			} else {
+				if z.ListExport == nil {
+					z.ListExport = new(BatchJobListExportV1)
+				}
+				bts, err = z.ListExport.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ListExport")
+					return
+				}
+			}
+		case "Inventory":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Inventory = nil
+			} else {
+				if z.Inventory == nil {
+					z.Inventory = new(BatchJobInventoryV1)
+				}
+				bts, err = z.Inventory.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Inventory")
+					return
+				}
+			}
+		case "Repack":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Repack = nil
+			} else {
+				if z.Repack == nil {
+					z.Repack = new(BatchJobRepackV1)
+				}
+				bts, err = z.Repack.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Repack")
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -445,6 +634,24 @@ func (z *BatchJobRequest) Msgsize() (s int) {
 	} else {
 		s += z.Expire.Msgsize()
 	}
+	s += 11
+	if z.ListExport == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.ListExport.Msgsize()
+	}
+	s += 10
+	if z.Inventory == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Inventory.Msgsize()
+	}
+	s += 7
+	if z.Repack == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Repack.Msgsize()
+	}
 	return
 }
 
@@ -0,0 +1,603 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	xioutil "github.com/minio/minio/internal/ioutil"
+	"gopkg.in/yaml.v3"
+)
+
+// inventory: # Generate an S3-Inventory-compatible manifest of a bucket
+//   apiVersion: v1
+//   bucket: mybucket # Bucket whose namespace will be inventoried
+//   prefix: myprefix # (Optional) Inventory only objects under this prefix
+//   format: csv # (Optional) data file format "csv" (default) or "parquet"
+//   versions: false # (Optional) include all object versions, not only the latest
+//   schedule: 24h # (Optional) regenerate the inventory at this interval until the job is canceled
+//   target:
+//     bucket: reports # Bucket where the inventory is written
+//     prefix: inventory # (Optional) prefix under which manifest and data files are placed
+//
+//   notify:
+//     endpoint: https://notify.endpoint # notification endpoint to receive job completion status
+//     token: Bearer xxxxx # optional authentication token for the notification endpoint
+
+//go:generate msgp -file $GOFILE
+//msgp:ignore batchInventoryEntry batchInventoryWriter batchInventoryCSVWriter batchInventoryParquetWriter batchInventoryManifest batchInventoryManifestFile
+
+const (
+	batchInventoryName       = "batch-inventory.bin"
+	batchInventoryFormat     = 1
+	batchInventoryVersionV1  = 1
+	batchInventoryVersion    = batchInventoryVersionV1
+	batchInventoryAPIVersion = "v1"
+
+	batchInventoryFormatCSV     = "csv"
+	batchInventoryFormatParquet = "parquet"
+
+	// manifest version mirrors the S3 Inventory manifest format
+	batchInventoryManifestVersion = "2016-11-16"
+)
+
+// batchJobInventory job type for generating inventory manifests of a bucket.
+const batchJobInventory madmin.BatchJobType = "inventory"
+
+// BatchJobInventoryTarget describes the destination of the generated
+// inventory, manifest and data files are placed under
+// <prefix>/<source-bucket>/<timestamp>/.
+type BatchJobInventoryTarget struct {
+	Bucket string `yaml:"bucket" json:"bucket"`
+	Prefix string `yaml:"prefix" json:"prefix"`
+}
+
+// BatchJobInventoryV1 describes a batch job that walks a bucket and writes
+// an S3-Inventory-compatible manifest with per-version size, checksum,
+// storage class and encryption status to a destination bucket, optionally
+// regenerating it on a schedule.
+type BatchJobInventoryV1 struct {
+	line, col       int
+	APIVersion      string                  `yaml:"apiVersion" json:"apiVersion"`
+	Bucket          string                  `yaml:"bucket" json:"bucket"`
+	Prefix          BatchJobPrefix          `yaml:"prefix" json:"prefix"`
+	Format          string                  `yaml:"format" json:"format"`
+	Versions        bool                    `yaml:"versions" json:"versions"`
+	Schedule        time.Duration           `yaml:"schedule" json:"schedule"`
+	Target          BatchJobInventoryTarget `yaml:"target" json:"target"`
+	NotificationCfg BatchJobNotification    `yaml:"notify" json:"notify"`
+}
+
+var _ yaml.Unmarshaler = &BatchJobInventoryV1{}
+
+// UnmarshalYAML - BatchJobInventoryV1 extends default unmarshal to extract line, col information.
+func (r *BatchJobInventoryV1) UnmarshalYAML(val *yaml.Node) error {
+	type inventoryJob BatchJobInventoryV1
+	var tmp inventoryJob
+	err := val.Decode(&tmp)
+	if err != nil {
+		return err
+	}
+
+	*r = BatchJobInventoryV1(tmp)
+	r.line, r.col = val.Line, val.Column
+	return nil
+}
+
+// RedactSensitive will redact any sensitive information in r.
+func (r *BatchJobInventoryV1) RedactSensitive() {
+	if r == nil {
+		return
+	}
+	if r.NotificationCfg.Token != "" {
+		r.NotificationCfg.Token = redactedText
+	}
+}
+
+// batchInventoryFileSchema is advertised in the manifest and defines the
+// column order of the data files, which carry no header of their own.
+var batchInventoryFileSchema = []string{
+	"Bucket", "Key", "VersionId", "IsLatest", "IsDeleteMarker", "Size",
+	"LastModifiedDate", "ETag", "StorageClass", "EncryptionStatus",
+	"ChecksumAlgorithm", "Checksum",
+}
+
+// batchInventoryEntry is one row of the inventory data file.
+type batchInventoryEntry struct {
+	Bucket            string
+	Key               string
+	VersionID         string
+	IsLatest          bool
+	DeleteMarker      bool
+	Size              int64
+	ModTime           time.Time
+	ETag              string
+	StorageClass      string
+	EncryptionStatus  string
+	ChecksumAlgorithm string
+	Checksum          string
+}
+
+func batchInventoryEntryFor(oi ObjectInfo) batchInventoryEntry {
+	e := batchInventoryEntry{
+		Bucket:           oi.Bucket,
+		Key:              oi.Name,
+		VersionID:        oi.VersionID,
+		IsLatest:         oi.IsLatest,
+		DeleteMarker:     oi.DeleteMarker,
+		Size:             oi.Size,
+		ModTime:          oi.ModTime,
+		ETag:             oi.ETag,
+		StorageClass:     oi.StorageClass,
+		EncryptionStatus: "NOT-SSE",
+	}
+	if kind, encrypted := crypto.IsEncrypted(oi.UserDefined); encrypted {
+		switch kind {
+		case crypto.S3:
+			e.EncryptionStatus = "SSE-S3"
+		case crypto.S3KMS:
+			e.EncryptionStatus = "SSE-KMS"
+		case crypto.SSEC:
+			e.EncryptionStatus = "SSE-C"
+		default:
+			e.EncryptionStatus = "SSE"
+		}
+	}
+	if len(oi.Checksum) > 0 {
+		cs, _ := oi.decryptChecksums(0, http.Header{})
+		for k, v := range cs {
+			cksum := hash.NewChecksumString(k, v)
+			if cksum == nil || !cksum.Valid() {
+				continue
+			}
+			e.ChecksumAlgorithm = cksum.Type.String()
+			e.Checksum = v
+			break
+		}
+	}
+	return e
+}
+
+// batchInventoryWriter serializes inventory rows in the configured format.
+type batchInventoryWriter interface {
+	Write(e batchInventoryEntry) error
+	Close() error
+}
+
+type batchInventoryCSVWriter struct {
+	w *csv.Writer
+}
+
+func (c batchInventoryCSVWriter) Write(e batchInventoryEntry) error {
+	return c.w.Write([]string{
+		e.Bucket,
+		e.Key,
+		e.VersionID,
+		strconv.FormatBool(e.IsLatest),
+		strconv.FormatBool(e.DeleteMarker),
+		strconv.FormatInt(e.Size, 10),
+		e.ModTime.UTC().Format(time.RFC3339Nano),
+		e.ETag,
+		e.StorageClass,
+		e.EncryptionStatus,
+		e.ChecksumAlgorithm,
+		e.Checksum,
+	})
+}
+
+func (c batchInventoryCSVWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+const batchInventoryParquetSchema = `message inventory {
+	required binary bucket (STRING);
+	required binary key (STRING);
+	optional binary version_id (STRING);
+	required boolean is_latest;
+	required boolean is_delete_marker;
+	required int64 size;
+	required int64 last_modified_date (TIMESTAMP(MILLIS, true));
+	optional binary e_tag (STRING);
+	optional binary storage_class (STRING);
+	optional binary encryption_status (STRING);
+	optional binary checksum_algorithm (STRING);
+	optional binary checksum (STRING);
+}`
+
+type batchInventoryParquetWriter struct {
+	fw *goparquet.FileWriter
+}
+
+func newBatchInventoryParquetWriter(w io.Writer) (*batchInventoryParquetWriter, error) {
+	sd, err := parquetschema.ParseSchemaDefinition(batchInventoryParquetSchema)
+	if err != nil {
+		return nil, err
+	}
+	return &batchInventoryParquetWriter{
+		fw: goparquet.NewFileWriter(w,
+			goparquet.WithSchemaDefinition(sd),
+			goparquet.WithCompressionCodec(parquet.CompressionCodec_SNAPPY),
+		),
+	}, nil
+}
+
+func (p *batchInventoryParquetWriter) Write(e batchInventoryEntry) error {
+	row := map[string]interface{}{
+		"bucket":             []byte(e.Bucket),
+		"key":                []byte(e.Key),
+		"is_latest":          e.IsLatest,
+		"is_delete_marker":   e.DeleteMarker,
+		"size":               e.Size,
+		"last_modified_date": e.ModTime.UTC().UnixMilli(),
+	}
+	for k, v := range map[string]string{
+		"version_id":         e.VersionID,
+		"e_tag":              e.ETag,
+		"storage_class":      e.StorageClass,
+		"encryption_status":  e.EncryptionStatus,
+		"checksum_algorithm": e.ChecksumAlgorithm,
+		"checksum":           e.Checksum,
+	} {
+		if v != "" {
+			row[k] = []byte(v)
+		}
+	}
+	return p.fw.AddData(row)
+}
+
+func (p *batchInventoryParquetWriter) Close() error {
+	return p.fw.Close()
+}
+
+// batchInventoryManifestFile describes one data file referenced by the
+// manifest.
+type batchInventoryManifestFile struct {
+	Key         string `json:"key"`
+	Size        int64  `json:"size"`
+	MD5Checksum string `json:"MD5checksum,omitempty"`
+}
+
+// batchInventoryManifest mirrors the S3 Inventory manifest.json layout.
+type batchInventoryManifest struct {
+	SourceBucket      string                       `json:"sourceBucket"`
+	DestinationBucket string                       `json:"destinationBucket"`
+	Version           string                       `json:"version"`
+	CreationTimestamp string                       `json:"creationTimestamp"`
+	FileFormat        string                       `json:"fileFormat"`
+	FileSchema        string                       `json:"fileSchema"`
+	Files             []batchInventoryManifestFile `json:"files"`
+}
+
+// generate walks the source bucket once and writes a timestamped data file
+// plus manifest to the destination bucket.
+func (r *BatchJobInventoryV1) generate(ctx context.Context, api ObjectLayer, ri *batchJobInfo) error {
+	runTime := UTCNow()
+	base := pathJoin(r.Target.Prefix, r.Bucket, runTime.Format("2006-01-02T15-04Z"))
+
+	ext, fileFormat, contentType := ".csv", "CSV", "text/csv"
+	if r.Format == batchInventoryFormatParquet {
+		ext, fileFormat, contentType = ".parquet", "Parquet", "application/octet-stream"
+	}
+	dataKey := pathJoin(base, "data", "inventory"+ext)
+
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	defer cancelCause(nil)
+
+	results := make(chan itemOrErr[ObjectInfo], 100)
+	go func() {
+		prefixes := r.Prefix.F()
+		if len(prefixes) == 0 {
+			prefixes = []string{""}
+		}
+		for _, prefix := range prefixes {
+			prefixResultCh := make(chan itemOrErr[ObjectInfo], 100)
+			err := api.Walk(ctx, r.Bucket, prefix, prefixResultCh, WalkOptions{
+				LatestOnly:   !r.Versions,
+				VersionsSort: WalkVersionsSortDesc,
+			})
+			if err != nil {
+				cancelCause(err)
+				xioutil.SafeClose(results)
+				return
+			}
+			for result := range prefixResultCh {
+				results <- result
+			}
+		}
+		xioutil.SafeClose(results)
+	}()
+
+	// The data file is written streaming while the namespace is walked.
+	pr, pw := io.Pipe()
+	type putResult struct {
+		oi  ObjectInfo
+		err error
+	}
+	putDoneCh := make(chan putResult, 1)
+	go func() {
+		hr, err := hash.NewReader(ctx, pr, -1, "", "", -1)
+		if err != nil {
+			pr.CloseWithError(err)
+			putDoneCh <- putResult{err: err}
+			return
+		}
+		oi, err := api.PutObject(ctx, r.Target.Bucket, dataKey, NewPutObjReader(hr), ObjectOptions{
+			UserDefined: map[string]string{
+				xhttp.ContentType: contentType,
+			},
+		})
+		pr.CloseWithError(err)
+		putDoneCh <- putResult{oi: oi, err: err}
+	}()
+
+	var iw batchInventoryWriter
+	var err error
+	if r.Format == batchInventoryFormatParquet {
+		iw, err = newBatchInventoryParquetWriter(pw)
+	} else {
+		iw = batchInventoryCSVWriter{w: csv.NewWriter(pw)}
+	}
+
+	if err == nil {
+		for result := range results {
+			if result.Err != nil {
+				err = result.Err
+				break
+			}
+			if err = iw.Write(batchInventoryEntryFor(result.Item)); err != nil {
+				break
+			}
+			ri.trackCurrentBucketObject(r.Bucket, result.Item, true, 1)
+		}
+	}
+	if err == nil && iw != nil {
+		err = iw.Close()
+	}
+	if err == nil {
+		err = context.Cause(ctx)
+	}
+	pw.CloseWithError(err)
+
+	// Drain remaining walk results in case serialization failed early.
+	for range results {
+	}
+
+	res := <-putDoneCh
+	if err == nil {
+		err = res.err
+	}
+	if err != nil {
+		return err
+	}
+
+	manifest := batchInventoryManifest{
+		SourceBucket:      r.Bucket,
+		DestinationBucket: r.Target.Bucket,
+		Version:           batchInventoryManifestVersion,
+		CreationTimestamp: strconv.FormatInt(runTime.UnixMilli(), 10),
+		FileFormat:        fileFormat,
+		FileSchema:        strings.Join(batchInventoryFileSchema, ", "),
+		Files: []batchInventoryManifestFile{{
+			Key:         dataKey,
+			Size:        res.oi.Size,
+			MD5Checksum: res.oi.ETag,
+		}},
+	}
+	mfData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = r.putJSON(ctx, api, pathJoin(base, "manifest.json"), mfData); err != nil {
+		return err
+	}
+	// the manifest checksum object allows readers to verify manifest.json
+	// before trusting its file list, same as S3 Inventory.
+	return r.putJSON(ctx, api, pathJoin(base, "manifest.checksum"), []byte(getMD5Hash(mfData)))
+}
+
+func (r *BatchJobInventoryV1) putJSON(ctx context.Context, api ObjectLayer, object string, data []byte) error {
+	hr, err := hash.NewReader(ctx, bytes.NewReader(data), int64(len(data)), "", "", int64(len(data)))
+	if err != nil {
+		return err
+	}
+	_, err = api.PutObject(ctx, r.Target.Bucket, object, NewPutObjReader(hr), ObjectOptions{
+		UserDefined: map[string]string{
+			xhttp.ContentType: "application/json",
+		},
+	})
+	return err
+}
+
+// Start the batch inventory job. Without a schedule the job generates the
+// inventory once and completes; with a schedule it regenerates the
+// inventory at every interval until the job is canceled.
+func (r *BatchJobInventoryV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.loadOrInit(ctx, api, job); err != nil {
+		return err
+	}
+	if ri.Complete {
+		return nil
+	}
+	globalBatchJobsMetrics.save(job.ID, ri)
+
+	// Goroutine to periodically save the job's in-memory state.
+	saverQuitCh := make(chan struct{})
+	go func() {
+		saveTicker := time.NewTicker(10 * time.Second)
+		defer saveTicker.Stop()
+		quit := false
+		after := time.Minute
+		for !quit {
+			select {
+			case <-saveTicker.C:
+			case <-ctx.Done():
+				quit = true
+			case <-saverQuitCh:
+				quit = true
+			}
+
+			if quit {
+				// save immediately if we are quitting
+				after = 0
+			}
+
+			ctx, cancel := context.WithTimeout(GlobalContext, 30*time.Second) // independent context
+			batchLogIf(ctx, ri.updateAfter(ctx, api, after, job))
+			cancel()
+		}
+	}()
+
+	var err error
+	for {
+		if err = r.generate(ctx, api, ri); err != nil {
+			batchLogIf(ctx, fmt.Errorf("unable to generate inventory of %s to %s/%s: %w", r.Bucket, r.Target.Bucket, r.Target.Prefix, err))
+			break
+		}
+		if r.Schedule <= 0 {
+			break
+		}
+		// Notify each completed run when regenerating on a schedule.
+		batchLogIf(ctx, notifyEndpoint(context.Background(), ri, r.NotificationCfg.Endpoint, r.NotificationCfg.Token))
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-time.After(r.Schedule):
+			continue
+		}
+		break
+	}
+
+	ri.Complete = err == nil
+	ri.Failed = err != nil
+	globalBatchJobsMetrics.save(job.ID, ri)
+
+	// Close the saverQuitCh - this also triggers saving in-memory state
+	// immediately one last time before we exit this method.
+	xioutil.SafeClose(saverQuitCh)
+
+	// Notify the final status to the configured endpoint.
+	batchLogIf(ctx, notifyEndpoint(context.Background(), ri, r.NotificationCfg.Endpoint, r.NotificationCfg.Token))
+
+	return nil
+}
+
+//msgp:ignore batchInventoryJobError
+type batchInventoryJobError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+func (e batchInventoryJobError) Error() string {
+	return e.Description
+}
+
+// Validate validates the job definition input
+func (r *BatchJobInventoryV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchInventoryAPIVersion {
+		return batchInventoryJobError{
+			Code:           "InvalidArgument",
+			Description:    "Unsupported batch inventory API version",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.Bucket == "" {
+		return batchInventoryJobError{
+			Code:           "InvalidArgument",
+			Description:    "Bucket argument missing",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	switch r.Format {
+	case "", batchInventoryFormatCSV, batchInventoryFormatParquet:
+	default:
+		return batchInventoryJobError{
+			Code:           "InvalidArgument",
+			Description:    fmt.Sprintf("Unsupported inventory format '%s', expected '%s' or '%s'", r.Format, batchInventoryFormatCSV, batchInventoryFormatParquet),
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.Schedule < 0 || (r.Schedule > 0 && r.Schedule < time.Minute) {
+		return batchInventoryJobError{
+			Code:           "InvalidArgument",
+			Description:    "Inventory schedule must be at least one minute",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.Target.Bucket == "" {
+		return batchInventoryJobError{
+			Code:           "InvalidArgument",
+			Description:    "Target bucket argument missing",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchInventoryJobError{
+				Code:           "NoSuchSourceBucket",
+				Description:    "The specified source bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Target.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchInventoryJobError{
+				Code:           "NoSuchTargetBucket",
+				Description:    "The specified target bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,431 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	xioutil "github.com/minio/minio/internal/ioutil"
+	"gopkg.in/yaml.v3"
+)
+
+// listexport: # Export a recursive listing of a prefix to an object
+//   apiVersion: v1
+//   bucket: mybucket # Bucket whose namespace will be listed
+//   prefix: myprefix # (Optional) List only objects under this prefix
+//   format: csv # (Optional) output format "csv" (default) or "jsonl"
+//   versions: false # (Optional) include all object versions, not only the latest
+//   metadata: false # (Optional) include user metadata and tags columns
+//   target:
+//     bucket: reports # Bucket where the listing object will be written
+//     object: exports/mybucket.csv # Object name for the listing
+//
+//   notify:
+//     endpoint: https://notify.endpoint # notification endpoint to receive job completion status
+//     token: Bearer xxxxx # optional authentication token for the notification endpoint
+
+//go:generate msgp -file $GOFILE
+//msgp:ignore batchListExportEntry batchListExportWriter batchListExportCSVWriter batchListExportJSONLWriter
+
+const (
+	batchListExportName       = "batch-list-export.bin"
+	batchListExportFormat     = 1
+	batchListExportVersionV1  = 1
+	batchListExportVersion    = batchListExportVersionV1
+	batchListExportAPIVersion = "v1"
+
+	batchListExportFormatCSV   = "csv"
+	batchListExportFormatJSONL = "jsonl"
+)
+
+// batchJobListExport job type for exporting a recursive listing to an object.
+const batchJobListExport madmin.BatchJobType = "listexport"
+
+// BatchJobListExportTarget describes the destination object for the exported
+// listing.
+type BatchJobListExportTarget struct {
+	Bucket string `yaml:"bucket" json:"bucket"`
+	Object string `yaml:"object" json:"object"`
+}
+
+// BatchJobListExportV1 describes a batch job that executes a full recursive
+// listing of a bucket prefix server-side and writes the result to a
+// destination object, so clients never need to paginate very large
+// namespaces over the API.
+type BatchJobListExportV1 struct {
+	line, col       int
+	APIVersion      string                   `yaml:"apiVersion" json:"apiVersion"`
+	Bucket          string                   `yaml:"bucket" json:"bucket"`
+	Prefix          BatchJobPrefix           `yaml:"prefix" json:"prefix"`
+	Format          string                   `yaml:"format" json:"format"`
+	Versions        bool                     `yaml:"versions" json:"versions"`
+	Metadata        bool                     `yaml:"metadata" json:"metadata"`
+	Target          BatchJobListExportTarget `yaml:"target" json:"target"`
+	NotificationCfg BatchJobNotification     `yaml:"notify" json:"notify"`
+}
+
+var _ yaml.Unmarshaler = &BatchJobListExportV1{}
+
+// UnmarshalYAML - BatchJobListExportV1 extends default unmarshal to extract line, col information.
+func (r *BatchJobListExportV1) UnmarshalYAML(val *yaml.Node) error {
+	type listExportJob BatchJobListExportV1
+	var tmp listExportJob
+	err := val.Decode(&tmp)
+	if err != nil {
+		return err
+	}
+
+	*r = BatchJobListExportV1(tmp)
+	r.line, r.col = val.Line, val.Column
+	return nil
+}
+
+// RedactSensitive will redact any sensitive information in r.
+func (r *BatchJobListExportV1) RedactSensitive() {
+	if r == nil {
+		return
+	}
+	if r.NotificationCfg.Token != "" {
+		r.NotificationCfg.Token = redactedText
+	}
+}
+
+// batchListExportEntry is a single line of the exported listing, serialized
+// as-is for the "jsonl" format and flattened into columns for "csv".
+type batchListExportEntry struct {
+	Name         string            `json:"name"`
+	VersionID    string            `json:"versionId,omitempty"`
+	IsLatest     bool              `json:"isLatest"`
+	DeleteMarker bool              `json:"deleteMarker,omitempty"`
+	Size         int64             `json:"size"`
+	ETag         string            `json:"etag,omitempty"`
+	StorageClass string            `json:"storageClass,omitempty"`
+	ModTime      time.Time         `json:"modTime"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	Tags         string            `json:"tags,omitempty"`
+}
+
+func batchListExportEntryFor(oi ObjectInfo, metadata bool) batchListExportEntry {
+	e := batchListExportEntry{
+		Name:         oi.Name,
+		VersionID:    oi.VersionID,
+		IsLatest:     oi.IsLatest,
+		DeleteMarker: oi.DeleteMarker,
+		Size:         oi.Size,
+		ETag:         oi.ETag,
+		StorageClass: oi.StorageClass,
+		ModTime:      oi.ModTime,
+	}
+	if metadata {
+		e.Metadata = cleanReservedKeys(oi.UserDefined)
+		e.Tags = oi.UserTags
+	}
+	return e
+}
+
+// batchListExportWriter serializes listing entries in the configured format.
+type batchListExportWriter interface {
+	Write(e batchListExportEntry) error
+	Flush() error
+}
+
+type batchListExportCSVWriter struct {
+	w        *csv.Writer
+	metadata bool
+}
+
+func newBatchListExportCSVWriter(w io.Writer, metadata bool) (*batchListExportCSVWriter, error) {
+	cw := &batchListExportCSVWriter{w: csv.NewWriter(w), metadata: metadata}
+	header := []string{"name", "version_id", "is_latest", "delete_marker", "size", "etag", "storage_class", "mod_time"}
+	if metadata {
+		header = append(header, "metadata", "tags")
+	}
+	return cw, cw.w.Write(header)
+}
+
+func (c *batchListExportCSVWriter) Write(e batchListExportEntry) error {
+	record := []string{
+		e.Name,
+		e.VersionID,
+		strconv.FormatBool(e.IsLatest),
+		strconv.FormatBool(e.DeleteMarker),
+		strconv.FormatInt(e.Size, 10),
+		e.ETag,
+		e.StorageClass,
+		e.ModTime.UTC().Format(time.RFC3339Nano),
+	}
+	if c.metadata {
+		var md []byte
+		if len(e.Metadata) > 0 {
+			md, _ = json.Marshal(e.Metadata)
+		}
+		record = append(record, string(md), e.Tags)
+	}
+	return c.w.Write(record)
+}
+
+func (c *batchListExportCSVWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type batchListExportJSONLWriter struct {
+	enc *json.Encoder
+}
+
+func (j batchListExportJSONLWriter) Write(e batchListExportEntry) error {
+	return j.enc.Encode(e)
+}
+
+func (j batchListExportJSONLWriter) Flush() error {
+	return nil
+}
+
+// Start the batch listing export job, a restarted job always regenerates
+// the destination object from scratch.
+func (r *BatchJobListExportV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.loadOrInit(ctx, api, job); err != nil {
+		return err
+	}
+	if ri.Complete {
+		return nil
+	}
+	globalBatchJobsMetrics.save(job.ID, ri)
+
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	defer cancelCause(nil)
+
+	results := make(chan itemOrErr[ObjectInfo], 100)
+	go func() {
+		prefixes := r.Prefix.F()
+		if len(prefixes) == 0 {
+			prefixes = []string{""}
+		}
+		for _, prefix := range prefixes {
+			prefixResultCh := make(chan itemOrErr[ObjectInfo], 100)
+			err := api.Walk(ctx, r.Bucket, prefix, prefixResultCh, WalkOptions{
+				LatestOnly:   !r.Versions,
+				VersionsSort: WalkVersionsSortDesc,
+			})
+			if err != nil {
+				cancelCause(err)
+				xioutil.SafeClose(results)
+				return
+			}
+			for result := range prefixResultCh {
+				results <- result
+			}
+		}
+		xioutil.SafeClose(results)
+	}()
+
+	// Goroutine to periodically save the job's in-memory state.
+	saverQuitCh := make(chan struct{})
+	go func() {
+		saveTicker := time.NewTicker(10 * time.Second)
+		defer saveTicker.Stop()
+		quit := false
+		after := time.Minute
+		for !quit {
+			select {
+			case <-saveTicker.C:
+			case <-ctx.Done():
+				quit = true
+			case <-saverQuitCh:
+				quit = true
+			}
+
+			if quit {
+				// save immediately if we are quitting
+				after = 0
+			}
+
+			ctx, cancel := context.WithTimeout(GlobalContext, 30*time.Second) // independent context
+			batchLogIf(ctx, ri.updateAfter(ctx, api, after, job))
+			cancel()
+		}
+	}()
+
+	contentType := "text/csv"
+	if r.Format == batchListExportFormatJSONL {
+		contentType = "application/x-ndjson"
+	}
+
+	// The destination object is written streaming while the namespace is
+	// being walked, the listing never needs to be staged in memory.
+	pr, pw := io.Pipe()
+	putDoneCh := make(chan error, 1)
+	go func() {
+		hr, err := hash.NewReader(ctx, pr, -1, "", "", -1)
+		if err != nil {
+			pr.CloseWithError(err)
+			putDoneCh <- err
+			return
+		}
+		_, err = api.PutObject(ctx, r.Target.Bucket, r.Target.Object, NewPutObjReader(hr), ObjectOptions{
+			UserDefined: map[string]string{
+				xhttp.ContentType: contentType,
+			},
+		})
+		pr.CloseWithError(err)
+		putDoneCh <- err
+	}()
+
+	var ew batchListExportWriter
+	var err error
+	if r.Format == batchListExportFormatJSONL {
+		ew = batchListExportJSONLWriter{enc: json.NewEncoder(pw)}
+	} else {
+		ew, err = newBatchListExportCSVWriter(pw, r.Metadata)
+	}
+
+	if err == nil {
+		for result := range results {
+			if result.Err != nil {
+				err = result.Err
+				break
+			}
+			if err = ew.Write(batchListExportEntryFor(result.Item, r.Metadata)); err != nil {
+				break
+			}
+			ri.trackCurrentBucketObject(r.Bucket, result.Item, true, 1)
+		}
+	}
+	if err == nil {
+		err = ew.Flush()
+	}
+	if err == nil {
+		err = context.Cause(ctx)
+	}
+	pw.CloseWithError(err)
+
+	// Drain remaining walk results in case serialization failed early.
+	for range results {
+	}
+
+	if perr := <-putDoneCh; err == nil {
+		err = perr
+	}
+	if err != nil {
+		batchLogIf(ctx, fmt.Errorf("unable to export listing of %s to %s/%s: %w", r.Bucket, r.Target.Bucket, r.Target.Object, err))
+	}
+
+	ri.Complete = err == nil
+	ri.Failed = err != nil
+	globalBatchJobsMetrics.save(job.ID, ri)
+
+	// Close the saverQuitCh - this also triggers saving in-memory state
+	// immediately one last time before we exit this method.
+	xioutil.SafeClose(saverQuitCh)
+
+	// Notify the final status to the configured endpoint.
+	batchLogIf(ctx, notifyEndpoint(context.Background(), ri, r.NotificationCfg.Endpoint, r.NotificationCfg.Token))
+
+	return nil
+}
+
+//msgp:ignore batchListExportJobError
+type batchListExportJobError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+func (e batchListExportJobError) Error() string {
+	return e.Description
+}
+
+// Validate validates the job definition input
+func (r *BatchJobListExportV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchListExportAPIVersion {
+		return batchListExportJobError{
+			Code:           "InvalidArgument",
+			Description:    "Unsupported batch listexport API version",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.Bucket == "" {
+		return batchListExportJobError{
+			Code:           "InvalidArgument",
+			Description:    "Bucket argument missing",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	switch r.Format {
+	case "", batchListExportFormatCSV, batchListExportFormatJSONL:
+	default:
+		return batchListExportJobError{
+			Code:           "InvalidArgument",
+			Description:    fmt.Sprintf("Unsupported listing format '%s', expected '%s' or '%s'", r.Format, batchListExportFormatCSV, batchListExportFormatJSONL),
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.Target.Bucket == "" || r.Target.Object == "" {
+		return batchListExportJobError{
+			Code:           "InvalidArgument",
+			Description:    "Target bucket or object argument missing",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchListExportJobError{
+				Code:           "NoSuchSourceBucket",
+				Description:    "The specified source bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Target.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchListExportJobError{
+				Code:           "NoSuchTargetBucket",
+				Description:    "The specified target bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	return nil
+}
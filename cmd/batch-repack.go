@@ -0,0 +1,632 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	xioutil "github.com/minio/minio/internal/ioutil"
+	"gopkg.in/yaml.v3"
+)
+
+// repack: # Pack many tiny objects into larger container objects
+//   apiVersion: v1
+//   bucket: mybucket # Bucket whose objects will be repacked
+//   prefix: myprefix # (Optional) Repack only objects under this prefix
+//   smallerThan: 4KiB # (Optional) Pack only objects smaller than this size
+//   packSize: 64MiB # (Optional) Approximate size of the container objects
+//   keepSource: false # (Optional) Keep the original objects after packing
+//
+//   notify:
+//     endpoint: https://notify.endpoint # notification endpoint to receive job completion status
+//     token: Bearer xxxxx # optional authentication token for the notification endpoint
+
+//go:generate msgp -file $GOFILE
+//msgp:ignore repackManifest repackManifestEntry repackPacker
+
+const (
+	batchRepackName       = "batch-repack.bin"
+	batchRepackFormat     = 1
+	batchRepackVersionV1  = 1
+	batchRepackVersion    = batchRepackVersionV1
+	batchRepackAPIVersion = "v1"
+
+	// reserved namespace inside the bucket holding container objects and
+	// their manifests, the repack job never packs objects under it.
+	batchRepackMetaPrefix      = ".minio-repack"
+	batchRepackPacksPrefix     = batchRepackMetaPrefix + "/packs"
+	batchRepackManifestsPrefix = batchRepackMetaPrefix + "/manifests"
+	batchRepackManifestObject  = "manifest.json"
+	batchRepackManifestVersion = "1"
+
+	batchRepackDefaultSmallerThan = 4 * humanize.KiByte
+	batchRepackDefaultPackSize    = 64 * humanize.MiByte
+)
+
+// batchJobRepack job type for packing small objects into container objects.
+const batchJobRepack madmin.BatchJobType = "repack"
+
+// BatchJobRepackV1 describes a batch job that concatenates many small
+// objects under a prefix into larger container objects plus a per-directory
+// manifest, reducing the metadata and inode overhead of keeping billions of
+// tiny objects while GET/HEAD keep resolving the original names through the
+// manifest.
+type BatchJobRepackV1 struct {
+	line, col       int
+	APIVersion      string               `yaml:"apiVersion" json:"apiVersion"`
+	Bucket          string               `yaml:"bucket" json:"bucket"`
+	Prefix          BatchJobPrefix       `yaml:"prefix" json:"prefix"`
+	SmallerThan     BatchJobSize         `yaml:"smallerThan" json:"smallerThan"`
+	PackSize        BatchJobSize         `yaml:"packSize" json:"packSize"`
+	KeepSource      bool                 `yaml:"keepSource" json:"keepSource"`
+	NotificationCfg BatchJobNotification `yaml:"notify" json:"notify"`
+}
+
+var _ yaml.Unmarshaler = &BatchJobRepackV1{}
+
+// UnmarshalYAML - BatchJobRepackV1 extends default unmarshal to extract line, col information.
+func (r *BatchJobRepackV1) UnmarshalYAML(val *yaml.Node) error {
+	type repackJob BatchJobRepackV1
+	var tmp repackJob
+	err := val.Decode(&tmp)
+	if err != nil {
+		return err
+	}
+
+	*r = BatchJobRepackV1(tmp)
+	r.line, r.col = val.Line, val.Column
+	return nil
+}
+
+// RedactSensitive will redact any sensitive information in r.
+func (r *BatchJobRepackV1) RedactSensitive() {
+	if r == nil {
+		return
+	}
+	if r.NotificationCfg.Token != "" {
+		r.NotificationCfg.Token = redactedText
+	}
+}
+
+// repackManifestEntry locates one packed object inside a container object.
+type repackManifestEntry struct {
+	Pack        string    `json:"pack"`
+	Offset      int64     `json:"offset"`
+	Size        int64     `json:"size"`
+	ETag        string    `json:"etag,omitempty"`
+	ModTime     time.Time `json:"modTime"`
+	ContentType string    `json:"contentType,omitempty"`
+}
+
+// objectInfo synthesizes the ObjectInfo served for the packed object.
+func (e repackManifestEntry) objectInfo(bucket, object string) ObjectInfo {
+	return ObjectInfo{
+		Bucket:      bucket,
+		Name:        object,
+		Size:        e.Size,
+		ETag:        e.ETag,
+		ModTime:     e.ModTime,
+		ContentType: e.ContentType,
+		IsLatest:    true,
+	}
+}
+
+// repackManifest maps object base names within one directory to their
+// location inside container objects.
+type repackManifest struct {
+	Version string                         `json:"version"`
+	Entries map[string]repackManifestEntry `json:"entries"`
+}
+
+// repackManifestPath returns the manifest object name covering objects whose
+// parent directory is dir ("" for the bucket root).
+func repackManifestPath(dir string) string {
+	if dir == "" {
+		return pathJoin(batchRepackManifestsPrefix, batchRepackManifestObject)
+	}
+	return pathJoin(batchRepackManifestsPrefix, dir, batchRepackManifestObject)
+}
+
+// loadRepackManifest reads the manifest for dir, returning nil without error
+// when no manifest exists.
+func loadRepackManifest(ctx context.Context, api ObjectLayer, bucket, dir string) (*repackManifest, error) {
+	gr, err := api.GetObjectNInfo(ctx, bucket, repackManifestPath(dir), nil, http.Header{}, ObjectOptions{})
+	if err != nil {
+		if isErrObjectNotFound(err) || isErrBucketNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer gr.Close()
+
+	data, err := io.ReadAll(io.LimitReader(gr, 1<<30))
+	if err != nil {
+		return nil, err
+	}
+	m := &repackManifest{}
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// saveRepackManifest writes the manifest for dir back to the bucket.
+func saveRepackManifest(ctx context.Context, api ObjectLayer, bucket, dir string, m *repackManifest) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	mr, err := hash.NewReader(ctx, bytes.NewReader(data), int64(len(data)), "", "", int64(len(data)))
+	if err != nil {
+		return err
+	}
+	_, err = api.PutObject(ctx, bucket, repackManifestPath(dir), NewPutObjReader(mr), ObjectOptions{
+		UserDefined: map[string]string{
+			xhttp.ContentType: "application/json",
+		},
+	})
+	return err
+}
+
+// repackGateEntry caches whether a bucket holds any repack manifests.
+type repackGateEntry struct {
+	exists  bool
+	updated time.Time
+}
+
+// repackGate spares buckets that were never repacked the extra manifest
+// read on every GET/HEAD/DELETE of a missing object. Entries expire so
+// a repack job run elsewhere in the cluster is picked up eventually.
+var repackGate sync.Map // bucket -> repackGateEntry
+
+const repackGateTTL = time.Minute
+
+// bucketHasRepackedObjects returns whether the bucket holds any repack
+// manifests, caching the answer for repackGateTTL.
+func bucketHasRepackedObjects(ctx context.Context, api ObjectLayer, bucket string) bool {
+	if v, ok := repackGate.Load(bucket); ok {
+		if e := v.(repackGateEntry); time.Since(e.updated) < repackGateTTL {
+			return e.exists
+		}
+	}
+	res, err := api.ListObjects(ctx, bucket, batchRepackManifestsPrefix+slashSeparator, "", "", 1)
+	if err != nil {
+		// Do not hide packed objects on a transient listing error.
+		return true
+	}
+	exists := len(res.Objects) > 0
+	repackGate.Store(bucket, repackGateEntry{exists: exists, updated: UTCNow()})
+	return exists
+}
+
+// lookupRepackedObject resolves an object name through the manifest of its
+// parent directory, any lookup failure is treated as a miss so that the
+// caller's original error is surfaced.
+func lookupRepackedObject(ctx context.Context, api ObjectLayer, bucket, object string) (repackManifestEntry, bool) {
+	if strings.HasPrefix(object, batchRepackMetaPrefix+slashSeparator) {
+		return repackManifestEntry{}, false
+	}
+	if !bucketHasRepackedObjects(ctx, api, bucket) {
+		return repackManifestEntry{}, false
+	}
+	dir, name := "", object
+	if i := strings.LastIndex(object, slashSeparator); i >= 0 {
+		dir, name = object[:i], object[i+1:]
+	}
+	m, err := loadRepackManifest(ctx, api, bucket, dir)
+	if err != nil || m == nil {
+		return repackManifestEntry{}, false
+	}
+	e, ok := m.Entries[name]
+	return e, ok
+}
+
+// deleteRepackedObject removes the manifest entry for a packed object so
+// that a DELETE of its name is honored even though the original object is
+// already gone. The packed bytes stay inside the container object, only
+// the name stops resolving. Returns whether an entry was removed.
+func deleteRepackedObject(ctx context.Context, api ObjectLayer, bucket, object string) bool {
+	if strings.HasPrefix(object, batchRepackMetaPrefix+slashSeparator) {
+		return false
+	}
+	if !bucketHasRepackedObjects(ctx, api, bucket) {
+		return false
+	}
+	dir, name := "", object
+	if i := strings.LastIndex(object, slashSeparator); i >= 0 {
+		dir, name = object[:i], object[i+1:]
+	}
+	m, err := loadRepackManifest(ctx, api, bucket, dir)
+	if err != nil || m == nil {
+		return false
+	}
+	if _, ok := m.Entries[name]; !ok {
+		return false
+	}
+	delete(m.Entries, name)
+	if len(m.Entries) == 0 {
+		_, err = api.DeleteObject(ctx, bucket, repackManifestPath(dir), ObjectOptions{})
+	} else {
+		err = saveRepackManifest(ctx, api, bucket, dir, m)
+	}
+	if err != nil {
+		batchLogIf(ctx, fmt.Errorf("unable to update repack manifest for %s/%s: %w", bucket, object, err))
+		return false
+	}
+	return true
+}
+
+// getRepackedObjectNInfo serves a packed object by reading its byte range
+// from the container object, honoring any range requested by the client.
+func getRepackedObjectNInfo(ctx context.Context, api ObjectLayer, bucket, object string, rs *HTTPRangeSpec, opts ObjectOptions) (*GetObjectReader, error) {
+	e, ok := lookupRepackedObject(ctx, api, bucket, object)
+	if !ok {
+		return nil, ObjectNotFound{Bucket: bucket, Object: object}
+	}
+
+	oi := e.objectInfo(bucket, object)
+	off, length := int64(0), e.Size
+	if rs != nil {
+		var err error
+		off, length, err = rs.GetOffsetLength(e.Size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var prs *HTTPRangeSpec
+	if length > 0 {
+		prs = &HTTPRangeSpec{Start: e.Offset + off, End: e.Offset + off + length - 1}
+	}
+	gr, err := api.GetObjectNInfo(ctx, bucket, e.Pack, prs, http.Header{}, ObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var reader io.Reader = gr
+	if length == 0 {
+		gr.Close()
+		reader = bytes.NewReader(nil)
+	}
+	return NewGetObjectReaderFromReader(reader, oi, opts, func() {
+		if length > 0 {
+			gr.Close()
+		}
+	})
+}
+
+// repackPacker accumulates small objects into an in-memory container and
+// flushes it together with the covering manifests once it reaches the
+// configured pack size. Originals are only deleted after both the container
+// object and the manifests have been committed.
+type repackPacker struct {
+	api        ObjectLayer
+	bucket     string
+	packSize   int64
+	keepSource bool
+
+	buf     bytes.Buffer
+	packed  []ObjectInfo
+	entries map[string]repackManifestEntry // keyed by full object name
+}
+
+func (p *repackPacker) add(ctx context.Context, oi ObjectInfo) error {
+	gr, err := p.api.GetObjectNInfo(ctx, p.bucket, oi.Name, nil, http.Header{}, ObjectOptions{})
+	if err != nil {
+		return err
+	}
+	offset := int64(p.buf.Len())
+	_, err = io.Copy(&p.buf, gr)
+	gr.Close()
+	if err != nil {
+		return err
+	}
+	if p.entries == nil {
+		p.entries = make(map[string]repackManifestEntry)
+	}
+	p.entries[oi.Name] = repackManifestEntry{
+		Offset:      offset,
+		Size:        int64(p.buf.Len()) - offset,
+		ETag:        oi.ETag,
+		ModTime:     oi.ModTime,
+		ContentType: gr.ObjInfo.ContentType,
+	}
+	p.packed = append(p.packed, oi)
+	if int64(p.buf.Len()) >= p.packSize {
+		return p.flush(ctx)
+	}
+	return nil
+}
+
+func (p *repackPacker) flush(ctx context.Context) error {
+	if len(p.entries) == 0 {
+		return nil
+	}
+
+	pack := pathJoin(batchRepackPacksPrefix, mustGetUUID()+".pack")
+	hr, err := hash.NewReader(ctx, bytes.NewReader(p.buf.Bytes()), int64(p.buf.Len()), "", "", int64(p.buf.Len()))
+	if err != nil {
+		return err
+	}
+	if _, err = p.api.PutObject(ctx, p.bucket, pack, NewPutObjReader(hr), ObjectOptions{}); err != nil {
+		return err
+	}
+
+	// Merge the new entries into one manifest per parent directory.
+	byDir := make(map[string]map[string]repackManifestEntry)
+	for name, e := range p.entries {
+		e.Pack = pack
+		dir, base := "", name
+		if i := strings.LastIndex(name, slashSeparator); i >= 0 {
+			dir, base = name[:i], name[i+1:]
+		}
+		if byDir[dir] == nil {
+			byDir[dir] = make(map[string]repackManifestEntry)
+		}
+		byDir[dir][base] = e
+	}
+	for dir, entries := range byDir {
+		m, err := loadRepackManifest(ctx, p.api, p.bucket, dir)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			m = &repackManifest{Version: batchRepackManifestVersion, Entries: make(map[string]repackManifestEntry)}
+		}
+		for base, e := range entries {
+			m.Entries[base] = e
+		}
+		if err = saveRepackManifest(ctx, p.api, p.bucket, dir, m); err != nil {
+			return err
+		}
+	}
+
+	// Manifests exist now, let lookups on this bucket through.
+	repackGate.Store(p.bucket, repackGateEntry{exists: true, updated: UTCNow()})
+
+	if !p.keepSource {
+		for _, oi := range p.packed {
+			if _, err := p.api.DeleteObject(ctx, p.bucket, oi.Name, ObjectOptions{}); err != nil {
+				batchLogIf(ctx, fmt.Errorf("unable to delete packed object %s/%s: %w", p.bucket, oi.Name, err))
+			}
+		}
+	}
+
+	p.buf.Reset()
+	p.packed = p.packed[:0]
+	p.entries = nil
+	return nil
+}
+
+// Start the batch repack job, walking the configured prefix and packing all
+// matching small objects.
+func (r *BatchJobRepackV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.loadOrInit(ctx, api, job); err != nil {
+		return err
+	}
+	if ri.Complete {
+		return nil
+	}
+	globalBatchJobsMetrics.save(job.ID, ri)
+
+	smallerThan := int64(r.SmallerThan)
+	if smallerThan <= 0 {
+		smallerThan = batchRepackDefaultSmallerThan
+	}
+	packSize := int64(r.PackSize)
+	if packSize <= 0 {
+		packSize = batchRepackDefaultPackSize
+	}
+
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	defer cancelCause(nil)
+
+	results := make(chan itemOrErr[ObjectInfo], 100)
+	go func() {
+		prefixes := r.Prefix.F()
+		if len(prefixes) == 0 {
+			prefixes = []string{""}
+		}
+		for _, prefix := range prefixes {
+			prefixResultCh := make(chan itemOrErr[ObjectInfo], 100)
+			err := api.Walk(ctx, r.Bucket, prefix, prefixResultCh, WalkOptions{
+				LatestOnly: true,
+			})
+			if err != nil {
+				cancelCause(err)
+				xioutil.SafeClose(results)
+				return
+			}
+			for result := range prefixResultCh {
+				results <- result
+			}
+		}
+		xioutil.SafeClose(results)
+	}()
+
+	// Goroutine to periodically save the job's in-memory state.
+	saverQuitCh := make(chan struct{})
+	go func() {
+		saveTicker := time.NewTicker(10 * time.Second)
+		defer saveTicker.Stop()
+		quit := false
+		after := time.Minute
+		for !quit {
+			select {
+			case <-saveTicker.C:
+			case <-ctx.Done():
+				quit = true
+			case <-saverQuitCh:
+				quit = true
+			}
+
+			if quit {
+				// save immediately if we are quitting
+				after = 0
+			}
+
+			ctx, cancel := context.WithTimeout(GlobalContext, 30*time.Second) // independent context
+			batchLogIf(ctx, ri.updateAfter(ctx, api, after, job))
+			cancel()
+		}
+	}()
+
+	packer := &repackPacker{
+		api:        api,
+		bucket:     r.Bucket,
+		packSize:   packSize,
+		keepSource: r.KeepSource,
+	}
+
+	var err error
+	for result := range results {
+		if result.Err != nil {
+			err = result.Err
+			break
+		}
+		oi := result.Item
+		if oi.DeleteMarker || strings.HasSuffix(oi.Name, slashSeparator) {
+			continue
+		}
+		if oi.Size >= smallerThan {
+			continue
+		}
+		if strings.HasPrefix(oi.Name, batchRepackMetaPrefix+slashSeparator) {
+			continue
+		}
+		if _, ok := crypto.IsEncrypted(oi.UserDefined); ok {
+			// Packing would store the decrypted bytes in a plaintext
+			// container object, leave encrypted objects in place.
+			ri.trackCurrentBucketObject(r.Bucket, oi, false, 1)
+			continue
+		}
+		if err = packer.add(ctx, oi); err != nil {
+			break
+		}
+		ri.trackCurrentBucketObject(r.Bucket, oi, true, 1)
+	}
+	if err == nil {
+		err = packer.flush(ctx)
+	}
+	if err == nil {
+		err = context.Cause(ctx)
+	}
+
+	// Drain remaining walk results in case packing failed early.
+	for range results {
+	}
+
+	if err != nil {
+		batchLogIf(ctx, fmt.Errorf("unable to repack %s: %w", r.Bucket, err))
+	}
+
+	ri.Complete = err == nil
+	ri.Failed = err != nil
+	globalBatchJobsMetrics.save(job.ID, ri)
+
+	// Close the saverQuitCh - this also triggers saving in-memory state
+	// immediately one last time before we exit this method.
+	xioutil.SafeClose(saverQuitCh)
+
+	// Notify the final status to the configured endpoint.
+	batchLogIf(ctx, notifyEndpoint(context.Background(), ri, r.NotificationCfg.Endpoint, r.NotificationCfg.Token))
+
+	return nil
+}
+
+//msgp:ignore batchRepackJobError
+type batchRepackJobError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+func (e batchRepackJobError) Error() string {
+	return e.Description
+}
+
+// Validate validates the job definition input
+func (r *BatchJobRepackV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchRepackAPIVersion {
+		return batchRepackJobError{
+			Code:           "InvalidArgument",
+			Description:    "Unsupported batch repack API version",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.Bucket == "" {
+		return batchRepackJobError{
+			Code:           "InvalidArgument",
+			Description:    "Bucket argument missing",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.PackSize > 0 && r.SmallerThan > 0 && r.PackSize < r.SmallerThan {
+		return batchRepackJobError{
+			Code:           "InvalidArgument",
+			Description:    "packSize must be larger than smallerThan",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchRepackJobError{
+				Code:           "NoSuchSourceBucket",
+				Description:    "The specified source bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	// Removing packed originals from a versioned bucket would only stack
+	// delete markers on top of the retained versions.
+	if globalBucketVersioningSys.Enabled(r.Bucket) && !r.KeepSource {
+		return batchRepackJobError{
+			Code:           "InvalidRequest",
+			Description:    "Repacking a versioned bucket requires keepSource: true",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	return nil
+}
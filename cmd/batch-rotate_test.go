@@ -0,0 +1,80 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseBatchJobKeyRotate(t *testing.T) {
+	keyRotateYaml := `
+keyrotate: # Re-encrypt objects with a new KMS key
+  apiVersion: v1
+  bucket: mybucket # Bucket whose objects will be re-encrypted
+  prefix: myprefix # (Optional) Re-encrypt only objects under this prefix
+  encryption:
+    type: sse-kms # valid values are sse-s3 and sse-kms
+    key: arn:aws:kms:my-minio-key # new KMS key used to re-wrap object encryption keys
+
+  flags:
+    filter:
+      newerThan: 168h
+      tags:
+        - key: name
+          value: pick*
+    notify:
+      endpoint: https://notify.endpoint
+      token: Bearer xxxxx
+    retry:
+      attempts: 10
+      delay: 500ms
+`
+	var job BatchJobRequest
+	if err := yaml.Unmarshal([]byte(keyRotateYaml), &job); err != nil {
+		t.Fatal("Failed to parse batch-job-keyrotate yaml", err)
+	}
+	if job.KeyRotate == nil {
+		t.Fatal("Failed to parse batch-job-keyrotate yaml")
+	}
+	if job.KeyRotate.Bucket != "mybucket" || job.KeyRotate.Prefix != "myprefix" {
+		t.Fatalf("unexpected bucket/prefix: %s/%s", job.KeyRotate.Bucket, job.KeyRotate.Prefix)
+	}
+	if job.KeyRotate.Encryption.Type != ssekms {
+		t.Fatalf("unexpected encryption type: %s", job.KeyRotate.Encryption.Type)
+	}
+	if job.KeyRotate.Flags.Retry.Attempts != 10 {
+		t.Fatalf("unexpected retry attempts: %d", job.KeyRotate.Flags.Retry.Attempts)
+	}
+
+	invalidTypeYaml := `
+keyrotate:
+  apiVersion: v1
+  bucket: mybucket
+  encryption:
+    type: sse-c # only sse-s3 and sse-kms are supported
+`
+	job = BatchJobRequest{}
+	if err := yaml.Unmarshal([]byte(invalidTypeYaml), &job); err != nil {
+		t.Fatal("Failed to parse batch-job-keyrotate yaml", err)
+	}
+	if err := job.KeyRotate.Encryption.Validate(); err == nil {
+		t.Fatal("expected validation error for unsupported encryption type")
+	}
+}
@@ -0,0 +1,450 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/minio/minio/internal/ioutil"
+	"github.com/minio/pkg/v3/policy"
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate msgp -file $GOFILE
+//msgp:ignore batchJobCronSpec batchJobScheduleInfo
+
+const (
+	// schedules live next to the job requests but are never job requests
+	// themselves, listing and resume skip this prefix.
+	batchJobSchedulesPrefix = batchJobPrefix + "/schedules"
+
+	// granularity of the scheduler, also bounds how far past a due time a
+	// run still counts as on-time rather than missed.
+	batchJobSchedulerInterval = time.Minute
+)
+
+// batchJobCronSpec is a parsed five-field cron expression
+// (minute hour day-of-month month day-of-week), each field a set of
+// permitted values.
+type batchJobCronSpec struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+var batchJobCronAliases = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// parseCronField parses a single cron field ("*", "*/n", "a", "a-b",
+// "a-b/n" and comma separated lists thereof) into a bitmask of permitted
+// values between lo and hi.
+func parseCronField(field string, lo, hi int) (uint64, error) {
+	var mask uint64
+	for _, expr := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(expr, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(expr[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid cron step value '%s'", expr)
+			}
+			expr = expr[:i]
+		}
+		start, end := lo, hi
+		switch {
+		case expr == "*":
+		case strings.Contains(expr, "-"):
+			before, after, _ := strings.Cut(expr, "-")
+			var err1, err2 error
+			start, err1 = strconv.Atoi(before)
+			end, err2 = strconv.Atoi(after)
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("invalid cron range '%s'", expr)
+			}
+		default:
+			v, err := strconv.Atoi(expr)
+			if err != nil {
+				return 0, fmt.Errorf("invalid cron value '%s'", expr)
+			}
+			start, end = v, v
+		}
+		if start < lo || end > hi || start > end {
+			return 0, fmt.Errorf("cron value '%s' out of range %d-%d", expr, lo, hi)
+		}
+		for v := start; v <= end; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+	return mask, nil
+}
+
+// parseBatchJobCron parses a standard five-field cron expression or one of
+// the @hourly/@daily/@weekly/@monthly aliases.
+func parseBatchJobCron(spec string) (*batchJobCronSpec, error) {
+	if alias, ok := batchJobCronAliases[strings.ToLower(strings.TrimSpace(spec))]; ok {
+		spec = alias
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression '%s', expected five fields", spec)
+	}
+	c := &batchJobCronSpec{
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 6); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *batchJobCronSpec) matchesDay(t time.Time) bool {
+	if c.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	// standard cron: if both day fields are restricted either may match,
+	// otherwise the restricted one must match.
+	switch {
+	case c.domStar:
+		return dowMatch
+	case c.dowStar:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// next returns the first time strictly after the given time that matches
+// the expression, or the zero time if none is found within five years.
+func (c *batchJobCronSpec) next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !c.matchesDay(t) {
+			// skip to midnight of the next day
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// BatchJobSchedule is a stored recurring schedule for a batch job
+// definition, the scheduler submits the job whenever the cron expression
+// fires.
+type BatchJobSchedule struct {
+	Name      string    `json:"name"`
+	Cron      string    `json:"cron"`
+	CatchUp   bool      `json:"catchUp"`
+	User      string    `json:"user"`
+	CreatedAt time.Time `json:"createdAt"`
+	LastRun   time.Time `json:"lastRun,omitempty"`
+	Job       []byte    `json:"-"`
+}
+
+// batchJobScheduleInfo decorates a schedule with its computed next run
+// time for list responses.
+type batchJobScheduleInfo struct {
+	BatchJobSchedule
+	NextRun time.Time `json:"nextRun,omitempty"`
+}
+
+// nextRun returns the next time the schedule fires after its last run, or
+// its creation when it has never run.
+func (s *BatchJobSchedule) nextRun() time.Time {
+	c, err := parseBatchJobCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	last := s.LastRun
+	if last.IsZero() {
+		last = s.CreatedAt
+	}
+	return c.next(last)
+}
+
+func getBatchJobSchedulePath(name string) string {
+	return pathJoin(batchJobSchedulesPrefix, name+".bin")
+}
+
+func (s *BatchJobSchedule) save(ctx context.Context, api ObjectLayer) error {
+	data, err := s.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, api, getBatchJobSchedulePath(s.Name), data)
+}
+
+func loadBatchJobSchedule(ctx context.Context, api ObjectLayer, name string) (*BatchJobSchedule, error) {
+	data, err := readConfig(ctx, api, getBatchJobSchedulePath(name))
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) || isErrObjectNotFound(err) {
+			err = errNoSuchJob
+		}
+		return nil, err
+	}
+	s := &BatchJobSchedule{}
+	if _, err = s.UnmarshalMsg(data); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func listBatchJobSchedules(ctx context.Context, api ObjectLayer) ([]BatchJobSchedule, error) {
+	results := make(chan itemOrErr[ObjectInfo], 100)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if err := api.Walk(ctx, minioMetaBucket, batchJobSchedulesPrefix+slashSeparator, results, WalkOptions{}); err != nil {
+		return nil, err
+	}
+	var schedules []BatchJobSchedule
+	for result := range results {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		name := strings.TrimSuffix(path.Base(result.Item.Name), ".bin")
+		s, err := loadBatchJobSchedule(ctx, api, name)
+		if err != nil {
+			if !errors.Is(err, errNoSuchJob) {
+				batchLogIf(ctx, err)
+			}
+			continue
+		}
+		schedules = append(schedules, *s)
+	}
+	return schedules, nil
+}
+
+// runScheduler runs recurring batch job schedules; the leader lock shared
+// with the scanner ensures a single scheduler across the cluster.
+func (j *BatchJobPool) runScheduler(ctx context.Context) {
+	ctx, cancel := globalLeaderLock.GetLock(ctx)
+	defer cancel()
+
+	t := time.NewTimer(batchJobSchedulerInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		j.runDueSchedules(ctx)
+		t.Reset(batchJobSchedulerInterval)
+	}
+}
+
+// runDueSchedules submits one job for every schedule whose cron expression
+// fired since its last run. Slots missed while no scheduler was running
+// are skipped unless the schedule opted into catch-up, which replays the
+// most recent missed slot exactly once.
+func (j *BatchJobPool) runDueSchedules(ctx context.Context) {
+	schedules, err := listBatchJobSchedules(ctx, j.objLayer)
+	if err != nil {
+		batchLogIf(ctx, err)
+		return
+	}
+	now := time.Now()
+	for i := range schedules {
+		s := schedules[i]
+		due := s.nextRun()
+		if due.IsZero() || due.After(now) {
+			continue
+		}
+		if !s.CatchUp && now.Sub(due) > 2*batchJobSchedulerInterval {
+			// the slot was missed, advance past it without running
+			s.LastRun = now
+			batchLogIf(ctx, s.save(ctx, j.objLayer))
+			continue
+		}
+		if err := j.startScheduledJob(ctx, &s); err != nil {
+			batchLogIf(ctx, fmt.Errorf("unable to start scheduled batch job %s: %w", s.Name, err))
+			continue
+		}
+		s.LastRun = now
+		batchLogIf(ctx, s.save(ctx, j.objLayer))
+	}
+}
+
+// startScheduledJob submits the schedule's stored job definition the same
+// way StartBatchJob would.
+func (j *BatchJobPool) startScheduledJob(ctx context.Context, s *BatchJobSchedule) error {
+	job := &BatchJobRequest{}
+	if err := yaml.Unmarshal(s.Job, job); err != nil {
+		return err
+	}
+	job.applyDefaults()
+	if err := job.Validate(ctx, j.objLayer); err != nil {
+		return err
+	}
+	job.ID = fmt.Sprintf("%s-%s%s%d", job.Type(), shortuuid.New(), getKeySeparator(), GetProxyEndpointLocalIndex(globalProxyEndpoints))
+	job.User = s.User
+	job.Started = time.Now()
+	if err := job.save(ctx, j.objLayer); err != nil {
+		return err
+	}
+	return j.queueJob(job)
+}
+
+// ScheduleBatchJob - POST /minio/admin/v3/schedule-job?name=&cron=&catchUp=
+//
+// Stores a recurring schedule for the batch job definition in the request
+// body, replacing any schedule of the same name.
+func (a adminAPIHandlers) ScheduleBatchJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, creds := validateAdminReq(ctx, w, r, policy.StartBatchJobAction)
+	if objectAPI == nil {
+		return
+	}
+
+	name := r.Form.Get("name")
+	if name == "" || strings.Contains(name, slashSeparator) {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, errInvalidArgument), r.URL)
+		return
+	}
+	cronSpec := r.Form.Get("cron")
+	if _, err := parseBatchJobCron(cronSpec); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	buf, err := io.ReadAll(ioutil.HardLimitReader(r.Body, humanize.MiByte*4))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// the job definition must be valid now even though it only runs later
+	job := &BatchJobRequest{}
+	if err = yaml.Unmarshal(buf, job); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	job.applyDefaults()
+	if err := job.Validate(ctx, objectAPI); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	user := creds.AccessKey
+	if creds.ParentUser != "" {
+		user = creds.ParentUser
+	}
+
+	s := &BatchJobSchedule{
+		Name:      name,
+		Cron:      cronSpec,
+		CatchUp:   r.Form.Get("catchUp") == "true",
+		User:      user,
+		CreatedAt: time.Now(),
+		Job:       buf,
+	}
+	if err := s.save(ctx, objectAPI); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(batchJobScheduleInfo{BatchJobSchedule: *s, NextRun: s.nextRun()})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, data)
+}
+
+// ListBatchJobSchedules - GET /minio/admin/v3/list-job-schedules
+func (a adminAPIHandlers) ListBatchJobSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ListBatchJobsAction)
+	if objectAPI == nil {
+		return
+	}
+
+	schedules, err := listBatchJobSchedules(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	infos := make([]batchJobScheduleInfo, 0, len(schedules))
+	for _, s := range schedules {
+		infos = append(infos, batchJobScheduleInfo{BatchJobSchedule: s, NextRun: s.nextRun()})
+	}
+	data, err := json.Marshal(infos)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, data)
+}
+
+// DeleteBatchJobSchedule - DELETE /minio/admin/v3/delete-job-schedule?name=
+func (a adminAPIHandlers) DeleteBatchJobSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.CancelBatchJobAction)
+	if objectAPI == nil {
+		return
+	}
+
+	name := r.Form.Get("name")
+	if _, err := loadBatchJobSchedule(ctx, objectAPI, name); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	deleteConfig(ctx, objectAPI, getBatchJobSchedulePath(name))
+	writeSuccessResponseHeadersOnly(w)
+}
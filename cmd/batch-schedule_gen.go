@@ -0,0 +1,260 @@
+package cmd
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *BatchJobSchedule) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Cron":
+			z.Cron, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Cron")
+				return
+			}
+		case "CatchUp":
+			z.CatchUp, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "CatchUp")
+				return
+			}
+		case "User":
+			z.User, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "User")
+				return
+			}
+		case "CreatedAt":
+			z.CreatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "CreatedAt")
+				return
+			}
+		case "LastRun":
+			z.LastRun, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "LastRun")
+				return
+			}
+		case "Job":
+			z.Job, err = dc.ReadBytes(z.Job)
+			if err != nil {
+				err = msgp.WrapError(err, "Job")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *BatchJobSchedule) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 7
+	// write "Name"
+	err = en.Append(0x87, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "Cron"
+	err = en.Append(0xa4, 0x43, 0x72, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Cron)
+	if err != nil {
+		err = msgp.WrapError(err, "Cron")
+		return
+	}
+	// write "CatchUp"
+	err = en.Append(0xa7, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.CatchUp)
+	if err != nil {
+		err = msgp.WrapError(err, "CatchUp")
+		return
+	}
+	// write "User"
+	err = en.Append(0xa4, 0x55, 0x73, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.User)
+	if err != nil {
+		err = msgp.WrapError(err, "User")
+		return
+	}
+	// write "CreatedAt"
+	err = en.Append(0xa9, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.CreatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "CreatedAt")
+		return
+	}
+	// write "LastRun"
+	err = en.Append(0xa7, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.LastRun)
+	if err != nil {
+		err = msgp.WrapError(err, "LastRun")
+		return
+	}
+	// write "Job"
+	err = en.Append(0xa3, 0x4a, 0x6f, 0x62)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.Job)
+	if err != nil {
+		err = msgp.WrapError(err, "Job")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *BatchJobSchedule) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "Name"
+	o = append(o, 0x87, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Cron"
+	o = append(o, 0xa4, 0x43, 0x72, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Cron)
+	// string "CatchUp"
+	o = append(o, 0xa7, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70)
+	o = msgp.AppendBool(o, z.CatchUp)
+	// string "User"
+	o = append(o, 0xa4, 0x55, 0x73, 0x65, 0x72)
+	o = msgp.AppendString(o, z.User)
+	// string "CreatedAt"
+	o = append(o, 0xa9, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.CreatedAt)
+	// string "LastRun"
+	o = append(o, 0xa7, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e)
+	o = msgp.AppendTime(o, z.LastRun)
+	// string "Job"
+	o = append(o, 0xa3, 0x4a, 0x6f, 0x62)
+	o = msgp.AppendBytes(o, z.Job)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *BatchJobSchedule) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Cron":
+			z.Cron, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Cron")
+				return
+			}
+		case "CatchUp":
+			z.CatchUp, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CatchUp")
+				return
+			}
+		case "User":
+			z.User, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "User")
+				return
+			}
+		case "CreatedAt":
+			z.CreatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CreatedAt")
+				return
+			}
+		case "LastRun":
+			z.LastRun, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LastRun")
+				return
+			}
+		case "Job":
+			z.Job, bts, err = msgp.ReadBytesBytes(bts, z.Job)
+			if err != nil {
+				err = msgp.WrapError(err, "Job")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *BatchJobSchedule) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 5 + msgp.StringPrefixSize + len(z.Cron) + 8 + msgp.BoolSize + 5 + msgp.StringPrefixSize + len(z.User) + 10 + msgp.TimeSize + 8 + msgp.TimeSize + 4 + msgp.BytesPrefixSize + len(z.Job)
+	return
+}
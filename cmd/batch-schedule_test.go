@@ -0,0 +1,104 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBatchJobCron(t *testing.T) {
+	validSpecs := []string{
+		"* * * * *",
+		"0 * * * *",
+		"*/5 * * * *",
+		"30 2 * * *",
+		"0 0 1,15 * *",
+		"0 9-17 * * 1-5",
+		"0 0 * * 0",
+		"@hourly",
+		"@daily",
+		"@weekly",
+		"@monthly",
+	}
+	for _, spec := range validSpecs {
+		if _, err := parseBatchJobCron(spec); err != nil {
+			t.Errorf("expected '%s' to parse, got: %v", spec, err)
+		}
+	}
+
+	invalidSpecs := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 7",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"foo * * * *",
+		"@yearly",
+	}
+	for _, spec := range invalidSpecs {
+		if _, err := parseBatchJobCron(spec); err == nil {
+			t.Errorf("expected '%s' to fail parsing", spec)
+		}
+	}
+}
+
+func TestBatchJobCronNext(t *testing.T) {
+	// Wednesday
+	after := time.Date(2024, time.March, 13, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.March, 13, 10, 31, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, time.March, 13, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.March, 13, 10, 45, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2024, time.March, 14, 2, 30, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		// next weekday at 09:00 is Thursday
+		{"0 9 * * 1-5", time.Date(2024, time.March, 14, 9, 0, 0, 0, time.UTC)},
+		// day-of-month and day-of-week both restricted, either matches
+		{"0 0 20 * 6", time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, testCase := range testCases {
+		c, err := parseBatchJobCron(testCase.spec)
+		if err != nil {
+			t.Fatalf("failed to parse '%s': %v", testCase.spec, err)
+		}
+		if got := c.next(after); !got.Equal(testCase.next) {
+			t.Errorf("'%s': expected next run %v, got %v", testCase.spec, testCase.next, got)
+		}
+	}
+
+	// an expression that can never fire returns the zero time
+	c, err := parseBatchJobCron("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.next(after); !got.IsZero() {
+		t.Errorf("expected zero time for impossible expression, got %v", got)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/v3/env"
+)
+
+const (
+	// envBitrotSamplePercent enables the sampling bitrot verifier when set
+	// to a value in (0, 100]. Per cycle the verifier picks roughly this
+	// percentage of objects, preferring the least-recently-verified ones,
+	// and deep-scans them through the background heal sequence. Unset or
+	// zero disables the verifier.
+	envBitrotSamplePercent = "MINIO_BITROT_SAMPLE_PERCENT"
+
+	// envBitrotSampleInterval overrides the pause between verification
+	// cycles, accepts time.Duration values such as "4h".
+	envBitrotSampleInterval = "MINIO_BITROT_SAMPLE_INTERVAL"
+
+	// bitrotVerifiedMetaKey records the last successful deep verification
+	// of an object version, persisted in xl.meta MetaSys.
+	bitrotVerifiedMetaKey = ReservedMetadataPrefixLower + "bitrot-verified"
+
+	// bitrotSampleDefaultInterval is the default pause between cycles.
+	bitrotSampleDefaultInterval = time.Hour
+
+	// bitrotSampleMaxPerCycle caps the objects verified per cycle so a
+	// single cycle cannot monopolize the heal workers on large namespaces.
+	bitrotSampleMaxPerCycle = 10000
+)
+
+// bitrotCandidate is an object version considered for verification
+// in the current cycle.
+type bitrotCandidate struct {
+	bucket    string
+	object    string
+	versionID string
+	modTime   time.Time
+	verified  time.Time // zero when never verified
+}
+
+// initBitrotSampler starts the sampling bitrot verifier when
+// MINIO_BITROT_SAMPLE_PERCENT is configured.
+func initBitrotSampler(ctx context.Context, objAPI ObjectLayer) {
+	pctStr := env.Get(envBitrotSamplePercent, "")
+	if pctStr == "" {
+		return
+	}
+	pct, err := strconv.Atoi(pctStr)
+	if err != nil || pct <= 0 || pct > 100 {
+		logger.Fatal(errInvalidArgument, "Invalid %s value '%s', expected an integer between 1 and 100", envBitrotSamplePercent, pctStr)
+	}
+
+	interval := bitrotSampleDefaultInterval
+	if intervalStr := env.Get(envBitrotSampleInterval, ""); intervalStr != "" {
+		interval, err = time.ParseDuration(intervalStr)
+		if err != nil || interval <= 0 {
+			logger.Fatal(errInvalidArgument, "Invalid %s value '%s', expected a positive duration such as '4h'", envBitrotSampleInterval, intervalStr)
+		}
+	}
+
+	go bitrotSampleLoop(ctx, objAPI, pct, interval)
+}
+
+// bitrotSampleLoop runs verification cycles separated by the configured
+// interval until the server shuts down.
+func bitrotSampleLoop(ctx context.Context, objAPI ObjectLayer, pct int, interval time.Duration) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	// Add a random delay up to one interval so all nodes do not start
+	// their cycles in lock-step after a cluster restart.
+	if err := sleepContext(ctx, time.Duration(r.Float64()*float64(interval))); err != nil {
+		return
+	}
+
+	for {
+		bitrotSampleCycle(ctx, objAPI, pct, r)
+		if err := sleepContext(ctx, interval); err != nil {
+			return
+		}
+	}
+}
+
+// bitrotSampleCycle lists all objects, samples the configured percentage
+// weighted toward the least-recently-verified versions, deep-scans them
+// via the background heal sequence and stamps the verification time.
+func bitrotSampleCycle(ctx context.Context, objAPI ObjectLayer, pct int, r *rand.Rand) {
+	buckets, err := objAPI.ListBuckets(ctx, BucketOptions{Cached: true})
+	if err != nil {
+		internalLogIf(ctx, err)
+		return
+	}
+
+	var candidates []bitrotCandidate
+	for _, bucket := range buckets {
+		if isMinioMetaBucketName(bucket.Name) {
+			continue
+		}
+		results := make(chan itemOrErr[ObjectInfo])
+		if err := objAPI.Walk(ctx, bucket.Name, "", results, WalkOptions{}); err != nil {
+			internalLogIf(ctx, err)
+			continue
+		}
+		for res := range results {
+			if res.Err != nil {
+				internalLogIf(ctx, res.Err)
+				break
+			}
+			oi := res.Item
+			if oi.DeleteMarker || oi.TransitionedObject.Status == lifecycle.TransitionComplete {
+				continue
+			}
+			if r.Intn(100) >= pct {
+				continue
+			}
+			candidate := bitrotCandidate{
+				bucket:    bucket.Name,
+				object:    oi.Name,
+				versionID: oi.VersionID,
+				modTime:   oi.ModTime,
+			}
+			if v, ok := oi.UserDefined[bitrotVerifiedMetaKey]; ok {
+				if t, err := time.Parse(time.RFC3339, v); err == nil {
+					candidate.verified = t
+				}
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	// Least-recently-verified first, never-verified objects sort to the
+	// front with their zero timestamp.
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].verified.Before(candidates[j].verified)
+	})
+	if len(candidates) > bitrotSampleMaxPerCycle {
+		candidates = candidates[:bitrotSampleMaxPerCycle]
+	}
+
+	for _, candidate := range candidates {
+		if ctx.Err() != nil {
+			return
+		}
+		// Deep scan verifies bitrot of all shards and heals any
+		// corruption it finds.
+		if err := healObject(candidate.bucket, candidate.object, candidate.versionID, madmin.HealDeepScan); err != nil {
+			internalLogIf(ctx, err)
+			continue
+		}
+		popts := ObjectOptions{
+			MTime:     candidate.modTime,
+			VersionID: candidate.versionID,
+			EvalMetadataFn: func(oi *ObjectInfo, gerr error) (dsc ReplicateDecision, err error) {
+				oi.UserDefined[bitrotVerifiedMetaKey] = UTCNow().Format(time.RFC3339)
+				return dsc, nil
+			},
+		}
+		if _, err := objAPI.PutObjectMetadata(ctx, candidate.bucket, candidate.object, popts); err != nil {
+			internalLogIf(ctx, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// bucketDeleteTokenTTL is how long an issued delete confirmation token
+// remains valid.
+const bucketDeleteTokenTTL = 5 * time.Minute
+
+var (
+	errDeleteTokenMissing = errors.New("force deleting a non-empty bucket requires a delete confirmation token")
+	errDeleteTokenInvalid = errors.New("delete confirmation token is invalid or has expired")
+)
+
+// bucketDeleteToken is the stored single-use confirmation token that
+// must accompany a force DeleteBucket of a non-empty bucket.
+type bucketDeleteToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// bucketDeleteTokenPath returns the meta bucket path of the delete
+// confirmation token for the given bucket.
+func bucketDeleteTokenPath(bucket string) string {
+	return pathJoin(bucketMetaPrefix, bucket, "delete-token.json")
+}
+
+// issueBucketDeleteToken creates and stores a new single-use delete
+// confirmation token for the bucket, replacing any previous one.
+func issueBucketDeleteToken(ctx context.Context, objectAPI ObjectLayer, bucket string) (bucketDeleteToken, error) {
+	token := bucketDeleteToken{
+		Token:     mustGetUUID(),
+		ExpiresAt: UTCNow().Add(bucketDeleteTokenTTL),
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return bucketDeleteToken{}, err
+	}
+	return token, saveConfig(ctx, objectAPI, bucketDeleteTokenPath(bucket), data)
+}
+
+// validateBucketDeleteToken verifies the supplied token against the
+// stored one and consumes it, tokens are single use.
+func validateBucketDeleteToken(ctx context.Context, objectAPI ObjectLayer, bucket, token string) error {
+	if token == "" {
+		return errDeleteTokenMissing
+	}
+	data, err := readConfig(ctx, objectAPI, bucketDeleteTokenPath(bucket))
+	if err != nil {
+		return errDeleteTokenInvalid
+	}
+	var stored bucketDeleteToken
+	if err = json.Unmarshal(data, &stored); err != nil {
+		return errDeleteTokenInvalid
+	}
+	if UTCNow().After(stored.ExpiresAt) {
+		deleteConfig(ctx, objectAPI, bucketDeleteTokenPath(bucket))
+		return errDeleteTokenInvalid
+	}
+	if subtle.ConstantTimeCompare([]byte(stored.Token), []byte(token)) != 1 {
+		return errDeleteTokenInvalid
+	}
+	// Consume the token, it must not authorize a second delete.
+	deleteConfig(ctx, objectAPI, bucketDeleteTokenPath(bucket))
+	return nil
+}
@@ -490,6 +490,9 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 		hasLockEnabled = true
 	}
 
+	softPurgeCfg, _ := globalBucketMetadataSys.GetSoftPurgeConfig(bucket)
+	softPurgeEnabled := softPurgeCfg != nil && softPurgeCfg.Enabled
+
 	type deleteResult struct {
 		delInfo DeletedObject
 		errInfo DeleteError
@@ -498,6 +501,33 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 	deleteResults := make([]deleteResult, len(deleteObjectsReq.Objects))
 
 	vc, _ := globalBucketVersioningSys.Get(bucket)
+
+	// Batch the pre-check stats (replication, object locking, tiering)
+	// into one storage call per drive when the object layer supports it,
+	// large multi-object deletes otherwise pay one RPC per object.
+	type multiObjectStatter interface {
+		GetObjectsInfo(ctx context.Context, bucket string, objects []ObjectV, opts ObjectOptions) ([]ObjectInfo, []error)
+	}
+	var (
+		statInfos []ObjectInfo
+		statErrs  []error
+	)
+	if replicateDeletes || hasLockEnabled || !globalTierConfigMgr.Empty() {
+		if statter, ok := objectAPI.(multiObjectStatter); ok {
+			statObjects := make([]ObjectV, len(deleteObjectsReq.Objects))
+			for i, object := range deleteObjectsReq.Objects {
+				statObjects[i] = ObjectV{ObjectName: object.ObjectName, VersionID: object.VersionID}
+				if !globalTierConfigMgr.Empty() && object.VersionID == "" && vc.Suspended() {
+					statObjects[i].VersionID = nullVersionID
+				}
+			}
+			statInfos, statErrs = statter.GetObjectsInfo(ctx, bucket, statObjects, ObjectOptions{
+				PrefixEnabledFn:  vc.PrefixEnabled,
+				VersionSuspended: vc.Suspended(),
+			})
+		}
+	}
+
 	oss := make([]*objSweeper, len(deleteObjectsReq.Objects))
 	for index, object := range deleteObjectsReq.Objects {
 		if apiErrCode := checkRequestAuthTypeWithVID(ctx, r, policy.DeleteObjectAction, bucket, object.ObjectName, object.VersionID); apiErrCode != ErrNone {
@@ -537,7 +567,11 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			if !globalTierConfigMgr.Empty() && object.VersionID == "" && opts.VersionSuspended {
 				opts.VersionID = nullVersionID
 			}
-			goi, gerr = getObjectInfoFn(ctx, bucket, object.ObjectName, opts)
+			if statInfos != nil {
+				goi, gerr = statInfos[index], statErrs[index]
+			} else {
+				goi, gerr = getObjectInfoFn(ctx, bucket, object.ObjectName, opts)
+			}
 		}
 
 		if !globalTierConfigMgr.Empty() {
@@ -580,6 +614,22 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			}
 		}
 
+		// Preserve a trash copy before data is removed for good, same as
+		// the single object delete path. Latest-version deletes on
+		// versioned buckets only stack a delete marker and skip this.
+		if softPurgeEnabled && !isDirObject(object.ObjectName) && (object.VersionID != "" || !opts.Versioned) {
+			if err := trashObjectVersion(ctx, objectAPI, bucket, object.ObjectName, object.VersionID); err != nil {
+				apiErr := toAPIError(ctx, err)
+				deleteResults[index].errInfo = DeleteError{
+					Code:      apiErr.Code,
+					Message:   apiErr.Description,
+					Key:       object.ObjectName,
+					VersionID: object.VersionID,
+				}
+				continue
+			}
+		}
+
 		// Avoid duplicate objects, we use map to filter them out.
 		if _, ok := objectsToDelete[object]; !ok {
 			objectsToDelete[object] = index
@@ -643,6 +693,17 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 		}
 	}
 
+	// Names reported not found may still resolve through a repack
+	// manifest, drop their entries so the delete takes effect for
+	// packed objects as well.
+	if bucketHasRepackedObjects(ctx, objectAPI, bucket) {
+		for i := range errs {
+			if isErrObjectNotFound(errs[i]) && deleteList[i].VersionID == "" {
+				deleteRepackedObject(ctx, objectAPI, bucket, deleteList[i].ObjectName)
+			}
+		}
+	}
+
 	// Generate response
 	deleteErrors := make([]DeleteError, 0, len(deleteObjectsReq.Objects))
 	deletedObjects := make([]DeletedObject, 0, len(deleteObjectsReq.Objects))
@@ -1723,6 +1784,19 @@ func (api objectAPIHandlers) DeleteBucketHandler(w http.ResponseWriter, r *http.
 				writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMethodNotAllowed), r.URL)
 				return
 			}
+
+			// Force deleting a non-empty bucket additionally requires a
+			// short-lived confirmation token issued via the admin API, to
+			// guard against catastrophic accidental deletes.
+			result, lerr := objectAPI.ListObjects(ctx, bucket, "", "", "", 1)
+			if lerr == nil && len(result.Objects) > 0 {
+				if terr := validateBucketDeleteToken(ctx, objectAPI, bucket, r.Header.Get(xhttp.MinIOForceDeleteToken)); terr != nil {
+					apiErr := errorCodes.ToAPIErr(ErrAccessDenied)
+					apiErr.Description = terr.Error()
+					writeErrorResponse(ctx, w, apiErr, r.URL)
+					return
+				}
+			}
 		}
 	}
 
@@ -744,6 +744,57 @@ func auditTierActions(ctx context.Context, tier string, bytes int64) func(err er
 	}
 }
 
+// rehydratePollInterval is the interval at which an ongoing rehydration is
+// polled, archive rehydration on remote tiers may take several hours.
+const rehydratePollInterval = time.Minute
+
+// rehydrateTransitionedObject initiates rehydration of an archived object
+// version on its remote tier when needed and waits until its contents are
+// readable again. The object's x-amz-restore header remains in
+// ongoing-request="true" state while waiting, tiers whose backends don't
+// archive are a no-op.
+func rehydrateTransitionedObject(ctx context.Context, oi ObjectInfo) error {
+	tgtClient, err := globalTierConfigMgr.getDriver(ctx, oi.TransitionedObject.Tier)
+	if err != nil {
+		return fmt.Errorf("transition storage class not configured: %w", err)
+	}
+	rehydrater, ok := tgtClient.(WarmBackendRehydrater)
+	if !ok {
+		return nil
+	}
+
+	object, rv := oi.TransitionedObject.Name, remoteVersionID(oi.TransitionedObject.VersionID)
+	status, err := rehydrater.RehydrateStatus(ctx, object, rv)
+	if err != nil {
+		return err
+	}
+	switch status {
+	case rehydrateNone:
+		return nil
+	case rehydrateRequired:
+		if err := rehydrater.Rehydrate(ctx, object, rv); err != nil {
+			return err
+		}
+	}
+
+	t := time.NewTicker(rehydratePollInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+		status, err = rehydrater.RehydrateStatus(ctx, object, rv)
+		if err != nil {
+			return err
+		}
+		if status == rehydrateNone {
+			return nil
+		}
+	}
+}
+
 // getTransitionedObjectReader returns a reader from the transitioned tier.
 func getTransitionedObjectReader(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, oi ObjectInfo, opts ObjectOptions) (gr *GetObjectReader, err error) {
 	tgtClient, err := globalTierConfigMgr.getDriver(ctx, oi.TransitionedObject.Tier)
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio/internal/mcontext"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Lock waits at or above this threshold count as contended, hot objects
+// show up as a growing contention count for their bucket.
+const bucketLockContentionThreshold = 100 * time.Millisecond
+
+var bucketLockWaitDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "bucket_lock_wait_seconds",
+		Help:    "Time spent waiting to acquire namespace locks per bucket",
+		Buckets: []float64{.001, .005, .01, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	},
+	[]string{"api", "bucket"},
+)
+
+// bucketLockCounters holds lock contention counters of a single bucket.
+type bucketLockCounters struct {
+	acquired    atomic.Int64
+	contentions atomic.Int64
+	timeouts    atomic.Int64
+}
+
+// bucketLockMetrics tracks namespace lock acquisition wait time, contention
+// and timeouts per bucket.
+type bucketLockMetrics struct {
+	mu      sync.RWMutex
+	buckets map[string]*bucketLockCounters
+}
+
+var globalBucketLockMetrics = &bucketLockMetrics{
+	buckets: make(map[string]*bucketLockCounters),
+}
+
+func (m *bucketLockMetrics) counters(bucket string) *bucketLockCounters {
+	m.mu.RLock()
+	c, ok := m.buckets[bucket]
+	m.mu.RUnlock()
+	if ok {
+		return c
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok = m.buckets[bucket]; !ok {
+		c = &bucketLockCounters{}
+		m.buckets[bucket] = c
+	}
+	return c
+}
+
+// record tracks a single namespace lock acquisition attempt on bucket.
+func (m *bucketLockMetrics) record(ctx context.Context, bucket string, wait time.Duration, timedOut bool) {
+	if bucket == "" || isMinioMetaBucketName(bucket) {
+		return
+	}
+
+	api := "internal"
+	if tc, ok := ctx.Value(mcontext.ContextTraceKey).(*mcontext.TraceCtxt); ok && tc.FuncName != "" {
+		api = tc.FuncName
+	}
+	bucketLockWaitDuration.With(prometheus.Labels{"api": api, "bucket": bucket}).Observe(wait.Seconds())
+
+	c := m.counters(bucket)
+	if timedOut {
+		c.timeouts.Add(1)
+	} else {
+		c.acquired.Add(1)
+	}
+	if wait >= bucketLockContentionThreshold {
+		c.contentions.Add(1)
+	}
+}
+
+// report returns the current counters of all buckets.
+func (m *bucketLockMetrics) report() map[string]lockContentionStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := make(map[string]lockContentionStats, len(m.buckets))
+	for bucket, c := range m.buckets {
+		stats[bucket] = lockContentionStats{
+			Acquired:    c.acquired.Load(),
+			Contentions: c.contentions.Load(),
+			Timeouts:    c.timeouts.Load(),
+		}
+	}
+	return stats
+}
+
+// lockContentionStats point-in-time lock contention counters of one bucket.
+type lockContentionStats struct {
+	Acquired    int64
+	Contentions int64
+	Timeouts    int64
+}
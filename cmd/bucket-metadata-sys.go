@@ -137,6 +137,21 @@ func (sys *BucketMetadataSys) updateAndParse(ctx context.Context, bucket string,
 	case bucketQuotaConfigFile:
 		meta.QuotaConfigJSON = configData
 		meta.QuotaConfigUpdatedAt = updatedAt
+	case bucketResponseHeadersConfig:
+		meta.ResponseHeadersConfigJSON = configData
+		meta.ResponseHeadersConfigUpdatedAt = updatedAt
+	case bucketReplicationPauseConfig:
+		meta.ReplicationPauseConfigJSON = configData
+		meta.ReplicationPauseConfigUpdatedAt = updatedAt
+	case bucketStorageClassConfigFile:
+		meta.StorageClassConfigJSON = configData
+		meta.StorageClassConfigUpdatedAt = updatedAt
+	case bucketPlacementConfigFile:
+		meta.PlacementConfigJSON = configData
+		meta.PlacementConfigUpdatedAt = updatedAt
+	case bucketSoftPurgeConfigFile:
+		meta.SoftPurgeConfigJSON = configData
+		meta.SoftPurgeConfigUpdatedAt = updatedAt
 	case objectLockConfig:
 		meta.ObjectLockConfigXML = configData
 		meta.ObjectLockConfigUpdatedAt = updatedAt
@@ -386,7 +401,7 @@ func (sys *BucketMetadataSys) GetPolicyConfig(bucket string) (*policy.BucketPoli
 
 // GetQuotaConfig returns configured bucket quota
 // The returned object may not be modified.
-func (sys *BucketMetadataSys) GetQuotaConfig(ctx context.Context, bucket string) (*madmin.BucketQuota, time.Time, error) {
+func (sys *BucketMetadataSys) GetQuotaConfig(ctx context.Context, bucket string) (*bucketQuota, time.Time, error) {
 	meta, _, err := sys.GetConfig(ctx, bucket)
 	if err != nil {
 		if errors.Is(err, errConfigNotFound) {
@@ -397,6 +412,56 @@ func (sys *BucketMetadataSys) GetQuotaConfig(ctx context.Context, bucket string)
 	return meta.quotaConfig, meta.QuotaConfigUpdatedAt, nil
 }
 
+// GetResponseHeadersConfig returns the configured default response
+// headers for a bucket, nil when the bucket has none.
+func (sys *BucketMetadataSys) GetResponseHeadersConfig(bucket string) (*responseHeadersConfig, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.responseHeadersConfig, nil
+}
+
+// GetReplicationPauseConfig returns the replication pause state of a
+// bucket, nil when replication was never paused.
+func (sys *BucketMetadataSys) GetReplicationPauseConfig(bucket string) (*replicationPauseConfig, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.replicationPauseConfig, nil
+}
+
+// GetStorageClassConfig returns the default storage class of a bucket,
+// nil when the bucket has none configured.
+func (sys *BucketMetadataSys) GetStorageClassConfig(bucket string) (*bucketStorageClassConfig, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.storageClassConfig, nil
+}
+
+// GetPlacementConfig returns the pool placement hints of a bucket, nil
+// when the bucket has none configured.
+func (sys *BucketMetadataSys) GetPlacementConfig(bucket string) (*bucketPlacementConfig, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.placementConfig, nil
+}
+
+// GetSoftPurgeConfig returns the soft purge window of a bucket, nil when
+// the bucket has none configured.
+func (sys *BucketMetadataSys) GetSoftPurgeConfig(bucket string) (*softPurgeConfig, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.softPurgeConfig, nil
+}
+
 // GetReplicationConfig returns configured bucket replication config
 // The returned object may not be modified.
 func (sys *BucketMetadataSys) GetReplicationConfig(ctx context.Context, bucket string) (*replication.Config, time.Time, error) {
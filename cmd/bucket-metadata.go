@@ -81,6 +81,11 @@ type BucketMetadata struct {
 	ReplicationConfigXML        []byte
 	BucketTargetsConfigJSON     []byte
 	BucketTargetsConfigMetaJSON []byte
+	ResponseHeadersConfigJSON   []byte
+	ReplicationPauseConfigJSON  []byte
+	StorageClassConfigJSON      []byte
+	PlacementConfigJSON         []byte
+	SoftPurgeConfigJSON         []byte
 
 	PolicyConfigUpdatedAt            time.Time
 	ObjectLockConfigUpdatedAt        time.Time
@@ -93,6 +98,11 @@ type BucketMetadata struct {
 	NotificationConfigUpdatedAt      time.Time
 	BucketTargetsConfigUpdatedAt     time.Time
 	BucketTargetsConfigMetaUpdatedAt time.Time
+	ResponseHeadersConfigUpdatedAt   time.Time
+	ReplicationPauseConfigUpdatedAt  time.Time
+	StorageClassConfigUpdatedAt      time.Time
+	PlacementConfigUpdatedAt         time.Time
+	SoftPurgeConfigUpdatedAt         time.Time
 	// Add a new UpdatedAt field and update lastUpdate function
 
 	// Unexported fields. Must be updated atomically.
@@ -103,10 +113,15 @@ type BucketMetadata struct {
 	versioningConfig       *versioning.Versioning
 	sseConfig              *bucketsse.BucketSSEConfig
 	taggingConfig          *tags.Tags
-	quotaConfig            *madmin.BucketQuota
+	quotaConfig            *bucketQuota
 	replicationConfig      *replication.Config
 	bucketTargetConfig     *madmin.BucketTargets
 	bucketTargetConfigMeta map[string]string
+	responseHeadersConfig  *responseHeadersConfig
+	replicationPauseConfig *replicationPauseConfig
+	storageClassConfig     *bucketStorageClassConfig
+	placementConfig        *bucketPlacementConfig
+	softPurgeConfig        *softPurgeConfig
 }
 
 // newBucketMetadata creates BucketMetadata with the supplied name and Created to Now.
@@ -116,7 +131,7 @@ func newBucketMetadata(name string) BucketMetadata {
 		notificationConfig: &event.Config{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
-		quotaConfig: &madmin.BucketQuota{},
+		quotaConfig: &bucketQuota{},
 		versioningConfig: &versioning.Versioning{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
@@ -161,6 +176,21 @@ func (b BucketMetadata) lastUpdate() (t time.Time) {
 	if b.BucketTargetsConfigMetaUpdatedAt.After(t) {
 		t = b.BucketTargetsConfigMetaUpdatedAt
 	}
+	if b.ResponseHeadersConfigUpdatedAt.After(t) {
+		t = b.ResponseHeadersConfigUpdatedAt
+	}
+	if b.ReplicationPauseConfigUpdatedAt.After(t) {
+		t = b.ReplicationPauseConfigUpdatedAt
+	}
+	if b.StorageClassConfigUpdatedAt.After(t) {
+		t = b.StorageClassConfigUpdatedAt
+	}
+	if b.PlacementConfigUpdatedAt.After(t) {
+		t = b.PlacementConfigUpdatedAt
+	}
+	if b.SoftPurgeConfigUpdatedAt.After(t) {
+		t = b.SoftPurgeConfigUpdatedAt
+	}
 
 	return
 }
@@ -338,6 +368,51 @@ func (b *BucketMetadata) parseAllConfigs(ctx context.Context, objectAPI ObjectLa
 		}
 	}
 
+	if len(b.ResponseHeadersConfigJSON) != 0 {
+		b.responseHeadersConfig, err = parseBucketResponseHeadersConfig(b.ResponseHeadersConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.responseHeadersConfig = nil
+	}
+
+	if len(b.ReplicationPauseConfigJSON) != 0 {
+		b.replicationPauseConfig, err = parseReplicationPauseConfig(b.ReplicationPauseConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.replicationPauseConfig = nil
+	}
+
+	if len(b.StorageClassConfigJSON) != 0 {
+		b.storageClassConfig, err = parseBucketStorageClassConfig(b.StorageClassConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.storageClassConfig = nil
+	}
+
+	if len(b.PlacementConfigJSON) != 0 {
+		b.placementConfig, err = parseBucketPlacementConfig(b.PlacementConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.placementConfig = nil
+	}
+
+	if len(b.SoftPurgeConfigJSON) != 0 {
+		b.softPurgeConfig, err = parseSoftPurgeConfig(b.SoftPurgeConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.softPurgeConfig = nil
+	}
+
 	if len(b.ReplicationConfigXML) != 0 {
 		b.replicationConfig, err = replication.ParseConfig(bytes.NewReader(b.ReplicationConfigXML))
 		if err != nil {
@@ -157,7 +157,7 @@ func (api objectAPIHandlers) PutBucketNotificationHandler(w http.ResponseWriter,
 	}
 
 	rulesMap := config.ToRulesMap()
-	globalEventNotifier.AddRulesMap(bucketName, rulesMap)
+	globalEventNotifier.AddRulesMap(bucketName, rulesMap, config.ToSyncRulesMap())
 
 	writeSuccessResponseHeadersOnly(w)
 }
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// bucketPlacementConfigFile is the bucket metadata file holding the pool
+// placement hints of a bucket.
+const bucketPlacementConfigFile = "placement.json"
+
+// bucketPlacementConfig carries the preferred pools of a bucket. New
+// objects are placed on the listed pools as long as they have space,
+// other pools are only used when none of the preferred pools can hold
+// the object. Lookups of existing objects are not affected.
+type bucketPlacementConfig struct {
+	Pools     []int     `json:"pools"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// parseBucketPlacementConfig unmarshals and validates a bucket placement
+// configuration.
+func parseBucketPlacementConfig(data []byte) (*bucketPlacementConfig, error) {
+	cfg := &bucketPlacementConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	seen := make(map[int]struct{}, len(cfg.Pools))
+	for _, pool := range cfg.Pools {
+		if pool < 0 {
+			return nil, fmt.Errorf("invalid pool index %d", pool)
+		}
+		if _, ok := seen[pool]; ok {
+			return nil, fmt.Errorf("duplicate pool index %d", pool)
+		}
+		seen[pool] = struct{}{}
+	}
+	return cfg, nil
+}
+
+// bucketPlacementPools returns the preferred pool indices of a bucket,
+// nil when the bucket has no placement hints configured.
+func bucketPlacementPools(bucket string) []int {
+	cfg, err := globalBucketMetadataSys.GetPlacementConfig(bucket)
+	if err != nil || cfg == nil {
+		return nil
+	}
+	return cfg.Pools
+}
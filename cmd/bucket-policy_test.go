@@ -0,0 +1,137 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// Tests that identity variables in policy resource ARNs resolve against the
+// condition values derived from the request credentials, enabling "home
+// directory" style policies without one policy per user.
+func TestPolicyResourceIdentityVariables(t *testing.T) {
+	awsUserPolicy := `{
+ "Version": "2012-10-17",
+ "Statement": [
+  {
+   "Effect": "Allow",
+   "Action": ["s3:GetObject", "s3:PutObject"],
+   "Resource": ["arn:aws:s3:::home/${aws:username}/*"]
+  }
+ ]
+}`
+	ldapUserPolicy := `{
+ "Version": "2012-10-17",
+ "Statement": [
+  {
+   "Effect": "Allow",
+   "Action": ["s3:GetObject"],
+   "Resource": ["arn:aws:s3:::home/${ldap:user}/*"]
+  }
+ ]
+}`
+
+	testCases := []struct {
+		name       string
+		policyJSON string
+		cred       auth.Credentials
+		objectName string
+		allowed    bool
+	}{
+		{
+			name:       "aws:username own prefix",
+			policyJSON: awsUserPolicy,
+			cred:       auth.Credentials{AccessKey: "alice"},
+			objectName: "alice/file.txt",
+			allowed:    true,
+		},
+		{
+			name:       "aws:username foreign prefix",
+			policyJSON: awsUserPolicy,
+			cred:       auth.Credentials{AccessKey: "alice"},
+			objectName: "bob/file.txt",
+			allowed:    false,
+		},
+		{
+			name:       "ldap:user own prefix",
+			policyJSON: ldapUserPolicy,
+			cred: auth.Credentials{
+				AccessKey: "tmpAccessKey",
+				Claims:    map[string]interface{}{"ldapUser": "uid=alice,dc=example"},
+			},
+			objectName: "uid=alice,dc=example/file.txt",
+			allowed:    true,
+		},
+		{
+			name:       "ldap:user foreign prefix",
+			policyJSON: ldapUserPolicy,
+			cred: auth.Credentials{
+				AccessKey: "tmpAccessKey",
+				Claims:    map[string]interface{}{"ldapUser": "uid=alice,dc=example"},
+			},
+			objectName: "uid=bob,dc=example/file.txt",
+			allowed:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p, err := policy.ParseConfig(strings.NewReader(testCase.policyJSON))
+			if err != nil {
+				t.Fatalf("unexpected policy parse failure: %v", err)
+			}
+
+			r := httptest.NewRequest("GET", "http://localhost/home/"+testCase.objectName, nil)
+			args := policy.Args{
+				AccountName:     testCase.cred.AccessKey,
+				Action:          policy.GetObjectAction,
+				BucketName:      "home",
+				ConditionValues: getConditionValues(r, "", testCase.cred),
+				ObjectName:      testCase.objectName,
+				Claims:          testCase.cred.Claims,
+			}
+			if got := p.IsAllowed(args); got != testCase.allowed {
+				t.Errorf("expected IsAllowed = %v, got %v", testCase.allowed, got)
+			}
+		})
+	}
+}
+
+// Tests that getConditionValues exposes the identity keys consumed by
+// ${aws:username} and ${ldap:user} substitution.
+func TestGetConditionValuesIdentityKeys(t *testing.T) {
+	r := httptest.NewRequest("GET", "http://localhost/testbucket/testobject", nil)
+
+	cv := getConditionValues(r, "", auth.Credentials{AccessKey: "alice"})
+	if got := cv["username"]; len(got) != 1 || got[0] != "alice" {
+		t.Errorf("expected username condition value [alice], got %v", got)
+	}
+
+	cv = getConditionValues(r, "", auth.Credentials{
+		AccessKey: "tmpAccessKey",
+		Claims:    map[string]interface{}{"ldapUser": "uid=alice,dc=example"},
+	})
+	if got := cv["user"]; len(got) != 1 || got[0] != "uid=alice,dc=example" {
+		t.Errorf("expected user condition value [uid=alice,dc=example], got %v", got)
+	}
+}
@@ -22,25 +22,139 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio/internal/cachevalue"
+	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/v3/env"
 )
 
+const (
+	// Comma separated list of usage percentages at which quota events
+	// are emitted for a bucket.
+	envQuotaAlertThresholds     = "MINIO_QUOTA_ALERT_THRESHOLDS"
+	defaultQuotaAlertThresholds = "80,90,100"
+
+	// A bucket staying above an already alerted threshold is re-alerted
+	// at this interval.
+	quotaAlertReminderInterval = time.Hour
+)
+
+// quotaAlertState remembers the last threshold alerted for a bucket.
+type quotaAlertState struct {
+	threshold uint64
+	at        time.Time
+}
+
+// bucketQuota extends madmin.BucketQuota with MinIO-only fields, both
+// are parsed from the same quota.json payload - older clients simply
+// ignore fields they do not know about.
+type bucketQuota struct {
+	madmin.BucketQuota
+	// MaxObjects indicates the maximum number of object versions
+	// allowed per bucket, zero means unlimited.
+	MaxObjects uint64 `json:"maxobjects,omitempty"`
+}
+
+// IsValid returns false if quota is invalid, a max object count
+// additionally requires a valid quota type.
+func (q bucketQuota) IsValid() bool {
+	if q.MaxObjects > 0 && !q.Type.IsValid() {
+		return false
+	}
+	return q.BucketQuota.IsValid()
+}
+
 // BucketQuotaSys - map of bucket and quota configuration.
-type BucketQuotaSys struct{}
+type BucketQuotaSys struct {
+	thresholds []uint64 // ascending usage percentages to alert at
+
+	mu      sync.Mutex
+	alerted map[string]quotaAlertState
+}
 
 // Get - Get quota configuration.
-func (sys *BucketQuotaSys) Get(ctx context.Context, bucketName string) (*madmin.BucketQuota, error) {
+func (sys *BucketQuotaSys) Get(ctx context.Context, bucketName string) (*bucketQuota, error) {
 	cfg, _, err := globalBucketMetadataSys.GetQuotaConfig(ctx, bucketName)
 	return cfg, err
 }
 
 // NewBucketQuotaSys returns initialized BucketQuotaSys
 func NewBucketQuotaSys() *BucketQuotaSys {
-	return &BucketQuotaSys{}
+	var thresholds []uint64
+	for _, s := range strings.Split(env.Get(envQuotaAlertThresholds, defaultQuotaAlertThresholds), ",") {
+		pct, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+		if err != nil || pct == 0 || pct > 100 {
+			internalLogIf(GlobalContext, fmt.Errorf("invalid %s value %q ignored", envQuotaAlertThresholds, s), logger.WarningKind)
+			continue
+		}
+		thresholds = append(thresholds, pct)
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+	return &BucketQuotaSys{
+		thresholds: thresholds,
+		alerted:    make(map[string]quotaAlertState),
+	}
+}
+
+// alertQuotaUsage emits a quota event when bucket usage crosses one of the
+// configured thresholds, so tenants get proactive warnings before writes
+// start failing with BucketQuotaExceeded. A bucket staying above a
+// threshold is re-alerted at quotaAlertReminderInterval.
+func (sys *BucketQuotaSys) alertQuotaUsage(bucket string, used, quotaSize uint64) {
+	if len(sys.thresholds) == 0 || quotaSize == 0 {
+		return
+	}
+	pct := used * 100 / quotaSize
+
+	// Highest threshold crossed, if any.
+	var crossed uint64
+	for _, t := range sys.thresholds {
+		if pct >= t {
+			crossed = t
+		}
+	}
+
+	sys.mu.Lock()
+	if crossed == 0 {
+		// Usage dropped below the lowest threshold, re-arm.
+		delete(sys.alerted, bucket)
+		sys.mu.Unlock()
+		return
+	}
+	state, ok := sys.alerted[bucket]
+	if ok && state.threshold >= crossed && time.Since(state.at) < quotaAlertReminderInterval {
+		sys.mu.Unlock()
+		return
+	}
+	sys.alerted[bucket] = quotaAlertState{threshold: crossed, at: UTCNow()}
+	sys.mu.Unlock()
+
+	eventName := event.BucketQuotaThreshold
+	if pct >= 100 {
+		eventName = event.BucketQuotaExceeded
+	}
+	sendEvent(eventArgs{
+		EventName:  eventName,
+		BucketName: bucket,
+		Object: ObjectInfo{
+			Bucket: bucket,
+		},
+		UserAgent: "Internal: [BucketQuota]",
+		Host:      globalLocalNodeName,
+		RespElements: map[string]string{
+			"x-minio-bucket-quota-size":    strconv.FormatUint(quotaSize, 10),
+			"x-minio-bucket-usage-size":    strconv.FormatUint(used, 10),
+			"x-minio-bucket-usage-percent": strconv.FormatUint(pct, 10),
+		},
+	})
+	internalLogIf(GlobalContext, fmt.Errorf("bucket %s usage %d is at %d%% of its quota %d", bucket, used, pct, quotaSize), logger.WarningKind)
 }
 
 var bucketStorageCache = cachevalue.New[DataUsageInfo]()
@@ -85,8 +199,8 @@ func (sys *BucketQuotaSys) GetBucketUsageInfo(ctx context.Context, bucket string
 }
 
 // parseBucketQuota parses BucketQuota from json
-func parseBucketQuota(bucket string, data []byte) (quotaCfg *madmin.BucketQuota, err error) {
-	quotaCfg = &madmin.BucketQuota{}
+func parseBucketQuota(bucket string, data []byte) (quotaCfg *bucketQuota, err error) {
+	quotaCfg = &bucketQuota{}
 	if err = json.Unmarshal(data, quotaCfg); err != nil {
 		return quotaCfg, err
 	}
@@ -110,25 +224,39 @@ func (sys *BucketQuotaSys) enforceQuotaHard(ctx context.Context, bucket string,
 		return err
 	}
 
-	var quotaSize uint64
+	var quotaSize, maxObjects uint64
 	if q != nil && q.Type == madmin.HardQuota {
 		if q.Size > 0 {
 			quotaSize = q.Size
 		} else if q.Quota > 0 {
 			quotaSize = q.Quota
 		}
+		maxObjects = q.MaxObjects
+	}
+	if quotaSize == 0 && maxObjects == 0 {
+		return nil
 	}
-	if quotaSize > 0 {
-		if uint64(size) >= quotaSize { // check if file size already exceeds the quota
-			return BucketQuotaExceeded{Bucket: bucket}
-		}
 
-		bui := sys.GetBucketUsageInfo(ctx, bucket)
-		if bui.Size > 0 && ((bui.Size + uint64(size)) >= quotaSize) {
+	if quotaSize > 0 && uint64(size) >= quotaSize { // check if file size already exceeds the quota
+		sys.alertQuotaUsage(bucket, uint64(size), quotaSize)
+		return BucketQuotaExceeded{Bucket: bucket}
+	}
+
+	bui := sys.GetBucketUsageInfo(ctx, bucket)
+	if quotaSize > 0 && bui.Size > 0 {
+		sys.alertQuotaUsage(bucket, bui.Size+uint64(size), quotaSize)
+		if (bui.Size + uint64(size)) >= quotaSize {
 			return BucketQuotaExceeded{Bucket: bucket}
 		}
 	}
 
+	// Object count is enforced with slack - the data usage cache lags
+	// the namespace by up to a scanner cycle, so small overshoots past
+	// the limit are expected.
+	if maxObjects > 0 && bui.ObjectsCount >= maxObjects {
+		return BucketQuotaExceeded{Bucket: bucket}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,61 @@
+// Copyright (c) 2015-2026 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import "testing"
+
+func TestParseBucketQuota(t *testing.T) {
+	testCases := []struct {
+		data       string
+		expectErr  bool
+		size       uint64
+		maxObjects uint64
+	}{
+		// Size only quota.
+		{data: `{"quotatype":"hard","size":1024}`, size: 1024},
+		// Object count only quota.
+		{data: `{"quotatype":"hard","maxobjects":100}`, maxObjects: 100},
+		// Both limits together.
+		{data: `{"quotatype":"hard","size":1024,"maxobjects":100}`, size: 1024, maxObjects: 100},
+		// Empty config is valid.
+		{data: `{}`},
+		// Object count requires a valid quota type.
+		{data: `{"maxobjects":100}`, expectErr: true},
+		// Removed 'fifo' quota type is rejected.
+		{data: `{"quotatype":"fifo","quota":1024}`, expectErr: true},
+	}
+	for i, testCase := range testCases {
+		quotaCfg, err := parseBucketQuota("bucket", []byte(testCase.data))
+		if testCase.expectErr {
+			if err == nil {
+				t.Errorf("Test %d: expected error, got none", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+			continue
+		}
+		if quotaCfg.Size != testCase.size {
+			t.Errorf("Test %d: expected size %d, got %d", i+1, testCase.size, quotaCfg.Size)
+		}
+		if quotaCfg.MaxObjects != testCase.maxObjects {
+			t.Errorf("Test %d: expected max objects %d, got %d", i+1, testCase.maxObjects, quotaCfg.MaxObjects)
+		}
+	}
+}
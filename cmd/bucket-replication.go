@@ -29,6 +29,7 @@ import (
 	"net/url"
 	"path"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -1318,15 +1319,20 @@ func (ri ReplicateObjectInfo) replicateObject(ctx context.Context, objectAPI Obj
 		headerSize += len(k) + len(v)
 	}
 
+	throttleARN := tgt.ARN
+	if replicationClass(ctx, bucket, object) == replication.PriorityClassBulk &&
+		globalBucketMonitor.IsThrottled(bucket, tgt.ARN+bulkThrottleSuffix) {
+		throttleARN = tgt.ARN + bulkThrottleSuffix
+	}
 	opts := &bandwidth.MonitorReaderOptions{
 		BucketOptions: bandwidth.BucketOptions{
 			Name:           ri.Bucket,
-			ReplicationARN: tgt.ARN,
+			ReplicationARN: throttleARN,
 		},
 		HeaderSize: headerSize,
 	}
 	newCtx := ctx
-	if globalBucketMonitor.IsThrottled(bucket, tgt.ARN) && objInfo.Size < minLargeObjSize {
+	if globalBucketMonitor.IsThrottled(bucket, throttleARN) && objInfo.Size < minLargeObjSize {
 		var cancel context.CancelFunc
 		newCtx, cancel = context.WithTimeout(ctx, throttleDeadline)
 		defer cancel()
@@ -1604,15 +1610,20 @@ applyAction:
 			headerSize += len(k) + len(v)
 		}
 
+		throttleARN := tgt.ARN
+		if replicationClass(ctx, bucket, object) == replication.PriorityClassBulk &&
+			globalBucketMonitor.IsThrottled(bucket, tgt.ARN+bulkThrottleSuffix) {
+			throttleARN = tgt.ARN + bulkThrottleSuffix
+		}
 		opts := &bandwidth.MonitorReaderOptions{
 			BucketOptions: bandwidth.BucketOptions{
 				Name:           objInfo.Bucket,
-				ReplicationARN: tgt.ARN,
+				ReplicationARN: throttleARN,
 			},
 			HeaderSize: headerSize,
 		}
 		newCtx := ctx
-		if globalBucketMonitor.IsThrottled(bucket, tgt.ARN) && objInfo.Size < minLargeObjSize {
+		if globalBucketMonitor.IsThrottled(bucket, throttleARN) && objInfo.Size < minLargeObjSize {
 			var cancel context.CancelFunc
 			newCtx, cancel = context.WithTimeout(ctx, throttleDeadline)
 			defer cancel()
@@ -1841,9 +1852,10 @@ var (
 // ReplicationPool describes replication pool
 type ReplicationPool struct {
 	// atomic ops:
-	activeWorkers    int32
-	activeLrgWorkers int32
-	activeMRFWorkers int32
+	activeWorkers     int32
+	activeLrgWorkers  int32
+	activeMRFWorkers  int32
+	activeBulkWorkers int32
 
 	objLayer    ObjectLayer
 	ctx         context.Context
@@ -1857,13 +1869,15 @@ type ReplicationPool struct {
 	resyncer *replicationResyncer
 
 	// workers:
-	workers    []chan ReplicationWorkerOperation
-	lrgworkers []chan ReplicationWorkerOperation
+	workers     []chan ReplicationWorkerOperation
+	lrgworkers  []chan ReplicationWorkerOperation
+	bulkworkers []chan ReplicationWorkerOperation
 
 	// mrf:
 	mrfWorkerKillCh chan struct{}
 	mrfReplicaCh    chan ReplicationWorkerOperation
 	mrfSaveCh       chan MRFReplicateEntry
+	mrfFlushCh      chan struct{}
 	mrfStopCh       chan struct{}
 	mrfWorkerSize   int
 }
@@ -1894,11 +1908,20 @@ const (
 
 	// LargeWorkerCount is default number of workers assigned to large uploads ( >= 128MiB)
 	LargeWorkerCount = 10
+
+	// BulkWorkerMaxLimit max number of bulk class workers per node for "fast" mode
+	BulkWorkerMaxLimit = 50
+
+	// BulkWorkerMinLimit min number of bulk class workers per node for "slow" mode
+	BulkWorkerMinLimit = 5
+
+	// BulkWorkerAutoDefault is default number of bulk class workers for "auto" mode
+	BulkWorkerAutoDefault = 10
 )
 
 // NewReplicationPool creates a pool of replication workers of specified size
 func NewReplicationPool(ctx context.Context, o ObjectLayer, opts replicationPoolOpts, stats *ReplicationStats) *ReplicationPool {
-	var workers, failedWorkers int
+	var workers, failedWorkers, bulkWorkers int
 	priority := "auto"
 	maxWorkers := WorkerMaxLimit
 	if opts.Priority != "" {
@@ -1911,12 +1934,15 @@ func NewReplicationPool(ctx context.Context, o ObjectLayer, opts replicationPool
 	case "fast":
 		workers = WorkerMaxLimit
 		failedWorkers = MRFWorkerMaxLimit
+		bulkWorkers = BulkWorkerMaxLimit
 	case "slow":
 		workers = WorkerMinLimit
 		failedWorkers = MRFWorkerMinLimit
+		bulkWorkers = BulkWorkerMinLimit
 	default:
 		workers = WorkerAutoDefault
 		failedWorkers = MRFWorkerAutoDefault
+		bulkWorkers = BulkWorkerAutoDefault
 	}
 	if maxWorkers > 0 && workers > maxWorkers {
 		workers = maxWorkers
@@ -1932,10 +1958,12 @@ func NewReplicationPool(ctx context.Context, o ObjectLayer, opts replicationPool
 	pool := &ReplicationPool{
 		workers:         make([]chan ReplicationWorkerOperation, 0, workers),
 		lrgworkers:      make([]chan ReplicationWorkerOperation, 0, maxLWorkers),
+		bulkworkers:     make([]chan ReplicationWorkerOperation, 0, bulkWorkers),
 		mrfReplicaCh:    make(chan ReplicationWorkerOperation, 100000),
 		mrfWorkerKillCh: make(chan struct{}, failedWorkers),
 		resyncer:        newresyncer(),
 		mrfSaveCh:       make(chan MRFReplicateEntry, 100000),
+		mrfFlushCh:      make(chan struct{}, 1),
 		mrfStopCh:       make(chan struct{}, 1),
 		ctx:             ctx,
 		objLayer:        o,
@@ -1947,6 +1975,7 @@ func NewReplicationPool(ctx context.Context, o ObjectLayer, opts replicationPool
 
 	pool.ResizeLrgWorkers(maxLWorkers, 0)
 	pool.ResizeWorkers(workers, 0)
+	pool.ResizeBulkWorkers(bulkWorkers, 0)
 	pool.ResizeFailedWorkers(failedWorkers)
 	go pool.resyncer.PersistToDisk(ctx, o)
 	go pool.processMRF()
@@ -2084,11 +2113,40 @@ func (p *ReplicationPool) ResizeLrgWorkers(n, checkOld int) {
 	}
 }
 
+// ResizeBulkWorkers sets the worker pool serving bulk class replication rules to a new size.
+// checkOld can be set to an expected value.
+// If the worker count changed
+func (p *ReplicationPool) ResizeBulkWorkers(n, checkOld int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if (checkOld > 0 && len(p.bulkworkers) != checkOld) || n == len(p.bulkworkers) || n < 1 {
+		// Either already satisfied or worker count changed while we waited for the lock.
+		return
+	}
+	for len(p.bulkworkers) < n {
+		input := make(chan ReplicationWorkerOperation, 10000)
+		p.bulkworkers = append(p.bulkworkers, input)
+
+		go p.AddWorker(input, &p.activeBulkWorkers)
+	}
+	for len(p.bulkworkers) > n {
+		worker := p.bulkworkers[len(p.bulkworkers)-1]
+		p.bulkworkers = p.bulkworkers[:len(p.bulkworkers)-1]
+		xioutil.SafeClose(worker)
+	}
+}
+
 // ActiveWorkers returns the number of active workers handling replication traffic.
 func (p *ReplicationPool) ActiveWorkers() int {
 	return int(atomic.LoadInt32(&p.activeWorkers))
 }
 
+// ActiveBulkWorkers returns the number of active workers handling bulk class replication traffic.
+func (p *ReplicationPool) ActiveBulkWorkers() int {
+	return int(atomic.LoadInt32(&p.activeBulkWorkers))
+}
+
 // ActiveMRFWorkers returns the number of active workers handling replication failures.
 func (p *ReplicationPool) ActiveMRFWorkers() int {
 	return int(atomic.LoadInt32(&p.activeMRFWorkers))
@@ -2125,18 +2183,21 @@ func (p *ReplicationPool) ResizeWorkers(n, checkOld int) {
 
 // ResizeWorkerPriority sets replication failed workers pool size
 func (p *ReplicationPool) ResizeWorkerPriority(pri string, maxWorkers, maxLWorkers int) {
-	var workers, mrfWorkers int
+	var workers, mrfWorkers, bulkWorkers int
 	p.mu.Lock()
 	switch pri {
 	case "fast":
 		workers = WorkerMaxLimit
 		mrfWorkers = MRFWorkerMaxLimit
+		bulkWorkers = BulkWorkerMaxLimit
 	case "slow":
 		workers = WorkerMinLimit
 		mrfWorkers = MRFWorkerMinLimit
+		bulkWorkers = BulkWorkerMinLimit
 	default:
 		workers = WorkerAutoDefault
 		mrfWorkers = MRFWorkerAutoDefault
+		bulkWorkers = BulkWorkerAutoDefault
 		if len(p.workers) < WorkerAutoDefault {
 			workers = min(len(p.workers)+1, WorkerAutoDefault)
 		}
@@ -2160,6 +2221,7 @@ func (p *ReplicationPool) ResizeWorkerPriority(pri string, maxWorkers, maxLWorke
 	p.ResizeWorkers(workers, 0)
 	p.ResizeFailedWorkers(mrfWorkers)
 	p.ResizeLrgWorkers(maxLWorkers, 0)
+	p.ResizeBulkWorkers(bulkWorkers, 0)
 }
 
 // ResizeFailedWorkers sets replication failed workers pool size
@@ -2179,8 +2241,26 @@ func (p *ReplicationPool) ResizeFailedWorkers(n int) {
 
 const (
 	minLargeObjSize = 128 * humanize.MiByte // 128MiB
+
+	// bulkThrottleSuffix is appended to the target ARN to register a
+	// separate bandwidth throttle for bulk class replication traffic.
+	bulkThrottleSuffix = "#bulk"
+
+	// bulkBandwidthShare is the fraction of a configured target bandwidth
+	// limit available to bulk class replication traffic.
+	bulkBandwidthShare = 0.5
 )
 
+// replicationClass returns the priority class of the replication rule matching
+// the object, the realtime class when no replication config is available.
+func replicationClass(ctx context.Context, bucket, object string) replication.PriorityClass {
+	cfg, err := getReplicationConfig(ctx, bucket)
+	if err != nil || cfg == nil {
+		return replication.PriorityClassRealtime
+	}
+	return cfg.RuleClass(object)
+}
+
 // getWorkerCh gets a worker channel deterministically based on bucket and object names.
 // Must be able to grab read lock from p.
 
@@ -2194,10 +2274,50 @@ func (p *ReplicationPool) getWorkerCh(bucket, object string, sz int64) chan<- Re
 	return p.workers[h%uint64(len(p.workers))]
 }
 
+// getBulkWorkerCh gets a bulk class worker channel deterministically based on bucket and object names.
+// Must be able to grab read lock from p.
+func (p *ReplicationPool) getBulkWorkerCh(bucket, object string) chan<- ReplicationWorkerOperation {
+	h := xxh3.HashString(bucket + object)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.bulkworkers) == 0 {
+		return nil
+	}
+	return p.bulkworkers[h%uint64(len(p.bulkworkers))]
+}
+
 func (p *ReplicationPool) queueReplicaTask(ri ReplicateObjectInfo) {
 	if p == nil {
 		return
 	}
+	if isReplicationPaused(ri.Bucket) {
+		// Bucket replication is administratively paused, park the
+		// operation in MRF so it replays unchanged on resume.
+		p.queueMRFSave(ri.ToMRFEntry())
+		return
+	}
+	// When every target for the bucket is down, park the operation in MRF
+	// rather than burning worker retries; it replays once a target is back.
+	if globalBucketTargetSys.allTargetsOffline(ri.Bucket) {
+		p.queueMRFSave(ri.ToMRFEntry())
+		return
+	}
+	// Route bulk class rules to the dedicated worker pool so large
+	// backfills cannot starve realtime replication of new PUTs.
+	if replicationClass(p.ctx, ri.Bucket, ri.Name) == replication.PriorityClassBulk {
+		ch := p.getBulkWorkerCh(ri.Bucket, ri.Name)
+		if ch == nil {
+			p.queueMRFSave(ri.ToMRFEntry())
+			return
+		}
+		select {
+		case <-p.ctx.Done():
+		case ch <- ri:
+		default:
+			p.queueMRFSave(ri.ToMRFEntry())
+		}
+		return
+	}
 	// if object is large, queue it to a static set of large workers
 	if ri.Size >= int64(minLargeObjSize) {
 		h := xxh3.HashString(ri.Bucket + ri.Name)
@@ -2281,13 +2401,25 @@ func (p *ReplicationPool) queueReplicaDeleteTask(doi DeletedObjectReplicationInf
 	if p == nil {
 		return
 	}
+	if isReplicationPaused(doi.Bucket) {
+		p.queueMRFSave(doi.ToMRFEntry())
+		return
+	}
+	if globalBucketTargetSys.allTargetsOffline(doi.Bucket) {
+		p.queueMRFSave(doi.ToMRFEntry())
+		return
+	}
 	var ch chan<- ReplicationWorkerOperation
-	switch doi.OpType {
-	case replication.HealReplicationType, replication.ExistingObjectReplicationType:
-		fallthrough
+	switch {
+	case replicationClass(p.ctx, doi.Bucket, doi.ObjectName) == replication.PriorityClassBulk:
+		ch = p.getBulkWorkerCh(doi.Bucket, doi.ObjectName)
 	default:
 		ch = p.getWorkerCh(doi.Bucket, doi.ObjectName, 0)
 	}
+	if ch == nil {
+		p.queueMRFSave(doi.ToMRFEntry())
+		return
+	}
 
 	select {
 	case <-p.ctx.Done():
@@ -2827,8 +2959,9 @@ func (s *replicationResyncer) PersistToDisk(ctx context.Context, objectAPI Objec
 }
 
 const (
-	resyncWorkerCnt        = 10 // limit of number of bucket resyncs is progress at any given time
-	resyncParallelRoutines = 10 // number of parallel resync ops per bucket
+	resyncWorkerCnt        = 10   // limit of number of bucket resyncs is progress at any given time
+	resyncParallelRoutines = 10   // number of parallel resync ops per bucket
+	resyncDeleteBatchSize  = 1000 // number of delete markers/versioned deletes replayed per batch
 )
 
 func newresyncer() *replicationResyncer {
@@ -2953,6 +3086,78 @@ func (s *replicationResyncer) resyncBucket(ctx context.Context, objectAPI Object
 		}
 	}()
 
+	// When the replication rule opts into batched existing-delete
+	// replication, delete markers and versioned deletes are accumulated and
+	// replayed in modification time order instead of being dispatched to
+	// the parallel workers one version at a time.
+	batchDeletes := cfg.BatchedExistingDeletes(opts.arn)
+	var pendingDeletes []ReplicateObjectInfo
+	flushDeletes := func() {
+		sort.Slice(pendingDeletes, func(i, j int) bool {
+			return pendingDeletes[i].ModTime.Before(pendingDeletes[j].ModTime)
+		})
+		for _, roi := range pendingDeletes {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.resyncCancelCh:
+				return
+			default:
+			}
+			traceFn := s.trace(tgt.ResetID, fmt.Sprintf("%s/%s (%s)", opts.bucket, roi.Name, roi.VersionID))
+			versionID := ""
+			dmVersionID := ""
+			if roi.VersionPurgeStatus.Empty() {
+				dmVersionID = roi.VersionID
+			} else {
+				versionID = roi.VersionID
+			}
+			doi := DeletedObjectReplicationInfo{
+				DeletedObject: DeletedObject{
+					ObjectName:            roi.Name,
+					DeleteMarkerVersionID: dmVersionID,
+					VersionID:             versionID,
+					ReplicationState:      roi.ReplicationState,
+					DeleteMarkerMTime:     DeleteMarkerMTime{roi.ModTime},
+					DeleteMarker:          roi.DeleteMarker,
+				},
+				Bucket:    roi.Bucket,
+				OpType:    replication.ExistingObjectReplicationType,
+				EventType: ReplicateExistingDelete,
+			}
+			replicateDelete(ctx, doi, objectAPI)
+
+			st := TargetReplicationResyncStatus{
+				Object: roi.Name,
+				Bucket: roi.Bucket,
+			}
+			_, err := tgt.StatObject(ctx, tgt.Bucket, roi.Name, minio.StatObjectOptions{
+				VersionID: roi.VersionID,
+				Internal: minio.AdvancedGetOptions{
+					ReplicationProxyRequest: "false",
+				},
+			})
+			if err != nil {
+				if roi.DeleteMarker && isErrMethodNotAllowed(ErrorRespToObjectError(err, opts.bucket, roi.Name)) {
+					st.ReplicatedCount++
+				} else {
+					st.FailedCount++
+				}
+			} else {
+				st.ReplicatedCount++
+			}
+			traceFn(0, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.resyncCancelCh:
+				return
+			case resultCh <- st:
+			}
+		}
+		pendingDeletes = pendingDeletes[:0]
+	}
+
 	var wg sync.WaitGroup
 	for i := 0; i < resyncParallelRoutines; i++ {
 		wg.Add(1)
@@ -3053,6 +3258,13 @@ func (s *replicationResyncer) resyncBucket(ctx context.Context, objectAPI Object
 		if !roi.ExistingObjResync.mustResync() {
 			continue
 		}
+		if batchDeletes && (roi.DeleteMarker || !roi.VersionPurgeStatus.Empty()) {
+			pendingDeletes = append(pendingDeletes, roi)
+			if len(pendingDeletes) >= resyncDeleteBatchSize {
+				flushDeletes()
+			}
+			continue
+		}
 		select {
 		case <-s.resyncCancelCh:
 			return
@@ -3067,6 +3279,9 @@ func (s *replicationResyncer) resyncBucket(ctx context.Context, objectAPI Object
 		xioutil.SafeClose(workers[i])
 	}
 	wg.Wait()
+	// Replay any remaining deletes after the object versions have been
+	// fully replicated.
+	flushDeletes()
 	resyncStatus = ResyncCompleted
 }
 
@@ -3468,7 +3683,12 @@ func queueReplicationHeal(ctx context.Context, bucket string, oi ObjectInfo, rcf
 		}
 		// if replication status is Complete on DeleteMarker and existing object resync required
 		if roi.ExistingObjResync.mustResync() && (roi.ReplicationStatus == replication.Completed || roi.ReplicationStatus.Empty()) {
-			queueReplicateDeletesWrapper(dv, roi.ExistingObjResync)
+			// the resync routine replays these in modtime order when
+			// batched existing-delete replication is configured, avoid
+			// queueing one heal task per version here.
+			if !rcfg.Config.BatchedExistingDeletes("") {
+				queueReplicateDeletesWrapper(dv, roi.ExistingObjResync)
+			}
 			return
 		}
 		return
@@ -3525,6 +3745,8 @@ func (p *ReplicationPool) persistMRF() {
 		case <-mTimer.C:
 			saveMRFToDisk()
 			mTimer.Reset(mrfSaveInterval)
+		case <-p.mrfFlushCh:
+			saveMRFToDisk()
 		case <-p.ctx.Done():
 			p.mrfStopCh <- struct{}{}
 			xioutil.SafeClose(p.mrfSaveCh)
@@ -3544,11 +3766,24 @@ func (p *ReplicationPool) persistMRF() {
 	}
 }
 
+// FlushMRF asks the persistMRF routine to save the accumulated MRF entries
+// to disk now instead of on the next save cycle, used when the node is
+// drained for maintenance.
+func (p *ReplicationPool) FlushMRF() {
+	if !p.initialized() {
+		return
+	}
+	select {
+	case p.mrfFlushCh <- struct{}{}:
+	default:
+	}
+}
+
 func (p *ReplicationPool) queueMRFSave(entry MRFReplicateEntry) {
 	if !p.initialized() {
 		return
 	}
-	if entry.RetryCount > mrfRetryLimit { // let scanner catch up if retry count exceeded
+	if entry.RetryCount > mrfRetryLimit && !isReplicationPaused(entry.Bucket) { // let scanner catch up if retry count exceeded
 		atomic.AddUint64(&p.stats.mrfStats.TotalDroppedCount, 1)
 		atomic.AddUint64(&p.stats.mrfStats.TotalDroppedBytes, uint64(entry.sz))
 		return
@@ -3732,6 +3967,12 @@ func (p *ReplicationPool) queueMRFHeal() error {
 	// queue replication heal in a goroutine to avoid holding up mrf save routine
 	go func() {
 		for vID, e := range mrfRec.Entries {
+			if isReplicationPaused(e.Bucket) {
+				// Keep entries for paused buckets queued without
+				// burning retries against the misbehaving target.
+				p.queueMRFSave(e)
+				continue
+			}
 			ctx, cancel := context.WithTimeout(p.ctx, time.Second) // Do not waste more than a second on this.
 
 			oi, err := p.objLayer.GetObjectInfo(ctx, e.Bucket, e.Object, ObjectOptions{
@@ -3753,6 +3994,77 @@ func (p *ReplicationPool) initialized() bool {
 	return !(p == nil || p.objLayer == nil)
 }
 
+// requeueMRF immediately queues the persisted MRF entries of this node for
+// the bucket (all buckets when empty) for healing instead of waiting for the
+// next processMRF cycle. Entries for other buckets are persisted back.
+func (p *ReplicationPool) requeueMRF(ctx context.Context, bucket string) error {
+	p.mrfMU.Lock()
+	defer p.mrfMU.Unlock()
+
+	if !p.initialized() {
+		return errServerNotInitialized
+	}
+
+	mrfRec, err := p.loadMRF()
+	if err != nil {
+		return err
+	}
+
+	var skipped map[string]MRFReplicateEntry
+	for vID, e := range mrfRec.Entries {
+		if bucket != "" && e.Bucket != bucket {
+			if skipped == nil {
+				skipped = make(map[string]MRFReplicateEntry)
+			}
+			skipped[vID] = e
+			continue
+		}
+		oi, err := p.objLayer.GetObjectInfo(ctx, e.Bucket, e.Object, ObjectOptions{
+			VersionID: vID,
+		})
+		if err != nil {
+			continue
+		}
+		// reset the retry count so a forced requeue is not dropped for
+		// exceeding the retry limit.
+		QueueReplicationHeal(p.ctx, e.Bucket, oi, 0)
+	}
+	if len(skipped) > 0 {
+		p.saveMRFEntries(ctx, skipped)
+	}
+	return nil
+}
+
+// dropMRF removes the persisted MRF entries of this node for the bucket (all
+// buckets when empty) so they are no longer retried.
+func (p *ReplicationPool) dropMRF(ctx context.Context, bucket string) error {
+	p.mrfMU.Lock()
+	defer p.mrfMU.Unlock()
+
+	if !p.initialized() {
+		return errServerNotInitialized
+	}
+
+	// loadMRF deletes the node local MRF file after reading it.
+	mrfRec, err := p.loadMRF()
+	if err != nil {
+		return err
+	}
+	if bucket == "" {
+		return nil
+	}
+	remaining := make(map[string]MRFReplicateEntry)
+	for vID, e := range mrfRec.Entries {
+		if e.Bucket != bucket {
+			remaining[vID] = e
+		}
+	}
+	if len(remaining) > 0 {
+		p.saveMRFEntries(ctx, remaining)
+	}
+	return nil
+}
+
 // getMRF returns MRF entries for this node.
 func (p *ReplicationPool) getMRF(ctx context.Context, bucket string) (ch <-chan madmin.ReplicationMRF, err error) {
 	mrfRec, err := p.loadMRF()
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+// bucketResponseHeadersConfig is the bucket metadata file holding the
+// per-bucket default response headers configuration.
+const bucketResponseHeadersConfig = "response-headers.json"
+
+// maxResponseHeaderRules caps the number of rules in a single config.
+const maxResponseHeaderRules = 100
+
+// allowedResponseHeaders are the headers a bucket config may inject into
+// responses, matching the set S3 allows overriding via query parameters.
+var allowedResponseHeaders = map[string]struct{}{
+	xhttp.CacheControl:       {},
+	xhttp.ContentDisposition: {},
+	xhttp.ContentEncoding:    {},
+	xhttp.ContentLanguage:    {},
+	xhttp.ContentType:        {},
+	xhttp.Expires:            {},
+}
+
+// responseHeaderRule injects the given headers into GET/HEAD responses
+// for objects matching the suffix, an empty suffix matches every object.
+type responseHeaderRule struct {
+	Suffix  string            `json:"suffix"`
+	Headers map[string]string `json:"headers"`
+}
+
+// responseHeadersConfig holds the default response headers of a bucket,
+// the first rule matching an object wins.
+type responseHeadersConfig struct {
+	Rules []responseHeaderRule `json:"rules"`
+}
+
+// HeadersFor returns the headers the first matching rule defines for the
+// given object, or nil when no rule matches.
+func (c *responseHeadersConfig) HeadersFor(object string) map[string]string {
+	for _, rule := range c.Rules {
+		if strings.HasSuffix(object, rule.Suffix) {
+			return rule.Headers
+		}
+	}
+	return nil
+}
+
+// parseBucketResponseHeadersConfig unmarshals and validates a response
+// headers configuration.
+func parseBucketResponseHeadersConfig(data []byte) (*responseHeadersConfig, error) {
+	cfg := &responseHeadersConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	if len(cfg.Rules) > maxResponseHeaderRules {
+		return nil, fmt.Errorf("too many response header rules, maximum %d allowed", maxResponseHeaderRules)
+	}
+	for _, rule := range cfg.Rules {
+		if len(rule.Headers) == 0 {
+			return nil, fmt.Errorf("response header rule with suffix %q defines no headers", rule.Suffix)
+		}
+		for name := range rule.Headers {
+			if _, ok := allowedResponseHeaders[http.CanonicalHeaderKey(name)]; !ok {
+				return nil, fmt.Errorf("response header %q may not be injected", name)
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// setBucketResponseHeaders applies the bucket's configured default
+// response headers for the object, without overriding headers already
+// set from object metadata or request parameters.
+func setBucketResponseHeaders(w http.ResponseWriter, bucket, object string) {
+	cfg, err := globalBucketMetadataSys.GetResponseHeadersConfig(bucket)
+	if err != nil || cfg == nil {
+		return
+	}
+	for name, value := range cfg.HeadersFor(object) {
+		if w.Header().Get(name) == "" {
+			w.Header().Set(name, value)
+		}
+	}
+}
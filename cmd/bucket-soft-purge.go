@@ -0,0 +1,228 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/hash"
+)
+
+// bucketSoftPurgeConfigFile is the bucket metadata file holding the soft
+// purge window of a bucket.
+const bucketSoftPurgeConfigFile = "soft-purge.json"
+
+// Metadata keys carried on trashed versions, the expiry drives the final
+// purge independently of later configuration changes.
+const (
+	trashMetaVersion  = ReservedMetadataPrefixLower + "trash-src-version"
+	trashMetaExpireAt = ReservedMetadataPrefixLower + "trash-expire-at"
+)
+
+// softPurgeConfig enables an undelete window on a bucket. While enabled,
+// deleting a specific version preserves a copy of the version in the
+// trash namespace for the configured number of days before it is finally
+// purged.
+type softPurgeConfig struct {
+	Enabled   bool      `json:"enabled"`
+	Days      int       `json:"days"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// parseSoftPurgeConfig unmarshals and validates a bucket soft purge
+// configuration.
+func parseSoftPurgeConfig(data []byte) (*softPurgeConfig, error) {
+	cfg := &softPurgeConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Enabled && (cfg.Days <= 0 || cfg.Days > 365) {
+		return nil, fmt.Errorf("invalid soft purge window %d days, expected 1-365", cfg.Days)
+	}
+	return cfg, nil
+}
+
+// softPurgeTrashPrefix returns the trash namespace of a bucket inside the
+// minio meta bucket.
+func softPurgeTrashPrefix(bucket string) string {
+	return pathJoin(bucketMetaPrefix, bucket, ".trash")
+}
+
+func softPurgeTrashKey(bucket, object, versionID string) string {
+	return pathJoin(softPurgeTrashPrefix(bucket), object, versionID)
+}
+
+// trashObjectVersion preserves a copy of the given version in the trash
+// namespace before it is deleted. It is a no-op when the bucket has no
+// soft purge window, when the version is a delete marker or does not
+// exist, and for encrypted versions whose object keys are sealed to the
+// object path and cannot be restored elsewhere.
+func trashObjectVersion(ctx context.Context, objAPI ObjectLayer, bucket, object, versionID string) error {
+	cfg, err := globalBucketMetadataSys.GetSoftPurgeConfig(bucket)
+	if err != nil || cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	gr, err := objAPI.GetObjectNInfo(ctx, bucket, object, nil, http.Header{}, ObjectOptions{
+		VersionID: versionID,
+	})
+	if err != nil {
+		if isErrObjectNotFound(err) || isErrVersionNotFound(err) || isErrMethodNotAllowed(err) {
+			// Nothing to preserve, the delete itself reports the
+			// outcome to the caller.
+			return nil
+		}
+		return err
+	}
+	defer gr.Close()
+
+	oi := gr.ObjInfo
+	if oi.DeleteMarker {
+		return nil
+	}
+	if _, encrypted := crypto.IsEncrypted(oi.UserDefined); encrypted {
+		return nil
+	}
+
+	meta := make(map[string]string, len(oi.UserDefined)+3)
+	for k, v := range oi.UserDefined {
+		meta[k] = v
+	}
+	if oi.ContentType != "" {
+		meta["content-type"] = oi.ContentType
+	}
+	// Deletes without an explicit version id reach here only on
+	// unversioned or suspended buckets, key the trash entry by the
+	// version actually removed.
+	vid := versionID
+	if vid == "" {
+		vid = oi.VersionID
+	}
+	if vid == "" {
+		vid = nullVersionID
+	}
+	meta[trashMetaVersion] = vid
+	meta[trashMetaExpireAt] = time.Now().UTC().Add(time.Duration(cfg.Days) * 24 * time.Hour).Format(time.RFC3339)
+
+	hr, err := hash.NewReader(ctx, gr, oi.Size, "", "", oi.Size)
+	if err != nil {
+		return err
+	}
+	_, err = objAPI.PutObject(ctx, minioMetaBucket, softPurgeTrashKey(bucket, object, vid),
+		NewPutObjReader(hr), ObjectOptions{UserDefined: meta})
+	return err
+}
+
+// undeleteObjectVersion restores a trashed version as the latest version
+// of its object and removes the trash entry. The restored version gets a
+// fresh version ID, the object data and metadata are those of the
+// deleted version.
+func undeleteObjectVersion(ctx context.Context, objAPI ObjectLayer, bucket, object, versionID string) (ObjectInfo, error) {
+	trashKey := softPurgeTrashKey(bucket, object, versionID)
+	gr, err := objAPI.GetObjectNInfo(ctx, minioMetaBucket, trashKey, nil, http.Header{}, ObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	defer gr.Close()
+
+	meta := make(map[string]string, len(gr.ObjInfo.UserDefined))
+	for k, v := range gr.ObjInfo.UserDefined {
+		meta[k] = v
+	}
+	delete(meta, trashMetaVersion)
+	delete(meta, trashMetaExpireAt)
+
+	hr, err := hash.NewReader(ctx, gr, gr.ObjInfo.Size, "", "", gr.ObjInfo.Size)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	oi, err := objAPI.PutObject(ctx, bucket, object, NewPutObjReader(hr), ObjectOptions{
+		UserDefined:      meta,
+		Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, object),
+		VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, object),
+	})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	if _, err := objAPI.DeleteObject(ctx, minioMetaBucket, trashKey, ObjectOptions{}); err != nil {
+		internalLogIf(ctx, fmt.Errorf("unable to remove trash entry %s after undelete: %w", trashKey, err))
+	}
+	return oi, nil
+}
+
+// purgeExpiredTrashEntries walks the trash namespaces of all buckets and
+// finally deletes entries past their expiry.
+func purgeExpiredTrashEntries(ctx context.Context, objAPI ObjectLayer) {
+	buckets, err := objAPI.ListBuckets(ctx, BucketOptions{})
+	if err != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, bucket := range buckets {
+		prefix := softPurgeTrashPrefix(bucket.Name) + slashSeparator
+		marker := ""
+		for {
+			result, err := objAPI.ListObjects(ctx, minioMetaBucket, prefix, marker, "", maxObjectList)
+			if err != nil {
+				break
+			}
+			for _, obj := range result.Objects {
+				expireAt, err := time.Parse(time.RFC3339, obj.UserDefined[trashMetaExpireAt])
+				if err != nil {
+					// Leave entries whose expiry cannot be read in place,
+					// purging them early would defeat the undelete window
+					// they were preserved for.
+					internalLogOnceIf(ctx, fmt.Errorf("trash entry %s has unreadable expiry: %w", obj.Name, err), obj.Name)
+					continue
+				}
+				if now.After(expireAt) {
+					objAPI.DeleteObject(ctx, minioMetaBucket, obj.Name, ObjectOptions{})
+				}
+			}
+			if !result.IsTruncated {
+				break
+			}
+			marker = result.NextMarker
+		}
+	}
+}
+
+// initSoftPurgeCleanup starts the background purge of expired trash
+// entries, it shares its interval with the drive level trash cleanup.
+func initSoftPurgeCleanup(ctx context.Context, objAPI ObjectLayer) {
+	go func() {
+		timer := time.NewTimer(globalAPIConfig.getDeleteCleanupInterval())
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				purgeExpiredTrashEntries(ctx, objAPI)
+				timer.Reset(globalAPIConfig.getDeleteCleanupInterval())
+			}
+		}
+	}()
+}
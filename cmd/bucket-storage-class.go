@@ -0,0 +1,76 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/minio/minio/internal/config/storageclass"
+)
+
+// bucketStorageClassConfigFile is the bucket metadata file holding the
+// default storage class of a bucket.
+const bucketStorageClassConfigFile = "storage-class.json"
+
+// bucketStorageClassConfig carries the default storage class (EC:N) of a
+// bucket, overriding the global default parity for new objects written
+// without an explicit storage class.
+type bucketStorageClassConfig struct {
+	StorageClass string    `json:"storageClass"`
+	UpdatedAt    time.Time `json:"updatedAt,omitempty"`
+}
+
+// Parity returns the parity drive count of the configured storage class,
+// -1 when unset.
+func (c *bucketStorageClassConfig) Parity() int {
+	if c == nil || c.StorageClass == "" {
+		return -1
+	}
+	var sc storageclass.StorageClass
+	if err := sc.UnmarshalText([]byte(c.StorageClass)); err != nil {
+		return -1
+	}
+	return sc.Parity
+}
+
+// parseBucketStorageClassConfig unmarshals and validates a bucket storage
+// class configuration.
+func parseBucketStorageClassConfig(data []byte) (*bucketStorageClassConfig, error) {
+	cfg := &bucketStorageClassConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	if cfg.StorageClass != "" {
+		var sc storageclass.StorageClass
+		if err := sc.UnmarshalText([]byte(cfg.StorageClass)); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+// bucketDefaultParity returns the parity drive count of the bucket's
+// default storage class, -1 when the bucket has none configured.
+func bucketDefaultParity(bucket string) int {
+	cfg, err := globalBucketMetadataSys.GetStorageClassConfig(bucket)
+	if err != nil {
+		return -1
+	}
+	return cfg.Parity()
+}
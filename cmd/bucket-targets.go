@@ -30,6 +30,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio/internal/bucket/replication"
 	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/kms"
 )
 
@@ -37,6 +38,8 @@ const (
 	defaultHealthCheckDuration = 5 * time.Second
 	// default interval for reload of all remote target endpoints
 	defaultHealthCheckReloadDuration = 30 * time.Minute
+	// ceiling on the exponential backoff between probes of an offline endpoint
+	maxHealthCheckBackoff = 2 * time.Minute
 )
 
 type arnTarget struct {
@@ -91,6 +94,8 @@ type epHealth struct {
 	lastHCAt        time.Time
 	offlineDuration time.Duration
 	latency         latencyStat
+	failCount       int       // consecutive failed probes
+	nextProbeAt     time.Time // next probe time while backing off
 }
 
 // isOffline returns current liveness result of remote target. Add endpoint to
@@ -145,7 +150,12 @@ func (sys *BucketTargetSys) heartBeat(ctx context.Context) {
 		case <-hcTimer.C:
 			sys.hMutex.RLock()
 			eps := make([]madmin.ServerProperties, 0, len(sys.hc))
+			now := time.Now()
 			for _, ep := range sys.hc {
+				if !ep.Online && now.Before(ep.nextProbeAt) {
+					// offline endpoint still backing off, skip this round
+					continue
+				}
 				eps = append(eps, madmin.ServerProperties{Endpoint: ep.Endpoint, Scheme: ep.Scheme})
 			}
 			sys.hMutex.RUnlock()
@@ -179,7 +189,7 @@ func (sys *BucketTargetSys) heartBeat(ctx context.Context) {
 					}
 					l := prev.latency
 					l.update(time.Since(start))
-					m[result.Endpoint.Host] = epHealth{
+					h := epHealth{
 						Endpoint:        result.Endpoint.Host,
 						Scheme:          result.Endpoint.Scheme,
 						Online:          result.Online,
@@ -188,9 +198,32 @@ func (sys *BucketTargetSys) heartBeat(ctx context.Context) {
 						lastHCAt:        time.Now(),
 						latency:         l,
 					}
+					if !result.Online {
+						// back off exponentially between probes of an
+						// unreachable endpoint, up to maxHealthCheckBackoff.
+						h.failCount = prev.failCount + 1
+						backoff := defaultHealthCheckDuration
+						for i := 1; i < h.failCount && backoff < maxHealthCheckBackoff; i++ {
+							backoff *= 2
+						}
+						if backoff > maxHealthCheckBackoff {
+							backoff = maxHealthCheckBackoff
+						}
+						h.nextProbeAt = time.Now().Add(backoff)
+					}
+					m[result.Endpoint.Host] = h
+					if ok && prev.Online != result.Online {
+						go sys.notifyTargetState(result.Endpoint.Host, result.Online)
+					}
 				}
 				cancel()
 				sys.hMutex.Lock()
+				// retain endpoints skipped this round while backing off
+				for k, v := range sys.hc {
+					if _, ok := m[k]; !ok {
+						m[k] = v
+					}
+				}
 				sys.hc = m
 				sys.hMutex.Unlock()
 			}
@@ -232,6 +265,53 @@ func (sys *BucketTargetSys) reloadHealthCheckers(ctx context.Context) {
 	sys.hMutex.Unlock()
 }
 
+// notifyTargetState sends a notification event for every bucket replicating
+// to an endpoint whose liveness changed.
+func (sys *BucketTargetSys) notifyTargetState(host string, online bool) {
+	evt := event.ReplicationTargetOffline
+	if online {
+		evt = event.ReplicationTargetOnline
+	}
+	sys.RLock()
+	defer sys.RUnlock()
+	for bucket, tgts := range sys.targetsMap {
+		for _, t := range tgts {
+			if t.URL().Host != host {
+				continue
+			}
+			sendEvent(eventArgs{
+				BucketName: bucket,
+				Object: ObjectInfo{
+					Bucket: bucket,
+					Name:   t.Arn,
+				},
+				UserAgent: "Internal: [Replication]",
+				Host:      globalLocalNodeName,
+				EventName: evt,
+			})
+		}
+	}
+}
+
+// allTargetsOffline returns true if every replication target configured on
+// the bucket is currently unreachable.
+func (sys *BucketTargetSys) allTargetsOffline(bucket string) bool {
+	sys.RLock()
+	tgts := sys.targetsMap[bucket]
+	sys.RUnlock()
+	var repl, down int
+	for _, t := range tgts {
+		if t.Type != madmin.ReplicationService {
+			continue
+		}
+		repl++
+		if sys.isOffline(t.URL()) {
+			down++
+		}
+	}
+	return repl > 0 && repl == down
+}
+
 func (sys *BucketTargetSys) healthStats() map[string]epHealth {
 	sys.hMutex.RLock()
 	defer sys.hMutex.RUnlock()
@@ -407,11 +487,15 @@ func (sys *BucketTargetSys) SetTarget(ctx context.Context, bucket string, tgt *m
 func (sys *BucketTargetSys) updateBandwidthLimit(bucket, arn string, limit int64) {
 	if limit == 0 {
 		globalBucketMonitor.DeleteBucketThrottle(bucket, arn)
+		globalBucketMonitor.DeleteBucketThrottle(bucket, arn+bulkThrottleSuffix)
 		return
 	}
 	// Setup bandwidth throttling
 
 	globalBucketMonitor.SetBandwidthLimit(bucket, arn, limit)
+	// bulk class replication rules are limited to a share of the
+	// configured target bandwidth.
+	globalBucketMonitor.SetBandwidthLimit(bucket, arn+bulkThrottleSuffix, int64(float64(limit)*bulkBandwidthShare))
 }
 
 // RemoveTarget - removes a remote bucket target for this source bucket.
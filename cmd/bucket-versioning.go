@@ -67,6 +67,30 @@ func (sys *BucketVersioningSys) PrefixSuspended(bucket, prefix string) bool {
 	return vc.PrefixSuspended(prefix)
 }
 
+// TagsSuspended returns true if an object with the given name and tags
+// matches an excluded tag rule of the bucket. This is part of a MinIO
+// versioning configuration extension.
+func (sys *BucketVersioningSys) TagsSuspended(bucket, prefix string, tags map[string]string) bool {
+	vc, err := sys.Get(bucket)
+	if err != nil {
+		logger.CriticalIf(GlobalContext, err)
+	}
+
+	return vc.TagsSuspended(prefix, tags)
+}
+
+// ExcludedRule returns a description of the first exclusion rule
+// suppressing versioning for an object with the given name and tags,
+// empty when versioning applies to the object.
+func (sys *BucketVersioningSys) ExcludedRule(bucket, prefix string, tags map[string]string) string {
+	vc, err := sys.Get(bucket)
+	if err != nil {
+		logger.CriticalIf(GlobalContext, err)
+	}
+
+	return vc.ExcludedRule(prefix, tags)
+}
+
 // Get returns stored bucket policy
 func (sys *BucketVersioningSys) Get(bucket string) (*versioning.Versioning, error) {
 	if bucket == minioMetaBucket || strings.HasPrefix(bucket, minioMetaBucket) {
@@ -0,0 +1,177 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/internal/hash"
+	"github.com/minio/pkg/v3/env"
+)
+
+// Scheduled backup of the cluster configuration and IAM data. When a
+// destination bucket is configured, everything below the config prefix in
+// the metadata bucket - server config, config history and the IAM store -
+// is periodically copied into a timestamped prefix in the destination
+// bucket. Pointing the destination at an object-locked bucket gives
+// immutable snapshots.
+const (
+	envConfigBackupBucket   = "MINIO_CONFIG_BACKUP_BUCKET"
+	envConfigBackupInterval = "MINIO_CONFIG_BACKUP_INTERVAL"
+
+	configBackupPrefix          = "backup"
+	configBackupDefaultInterval = 24 * time.Hour
+)
+
+// initConfigBackup starts the scheduled configuration/IAM backup loop if a
+// destination bucket has been configured.
+func initConfigBackup(ctx context.Context, objAPI ObjectLayer) {
+	bucket := env.Get(envConfigBackupBucket, "")
+	if bucket == "" {
+		return
+	}
+	interval, err := time.ParseDuration(env.Get(envConfigBackupInterval, configBackupDefaultInterval.String()))
+	if err != nil || interval <= 0 {
+		bootLogIf(ctx, fmt.Errorf("invalid %s value, config backup disabled: %w", envConfigBackupInterval, err))
+		return
+	}
+	go configBackupLoop(ctx, objAPI, bucket, interval)
+}
+
+func configBackupLoop(ctx context.Context, objAPI ObjectLayer, bucket string, interval time.Duration) {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			// Only one node in the cluster must run the backup, the
+			// others simply skip the cycle if the lock is held.
+			nsLock := objAPI.NewNSLock(minioMetaBucket, "config-backup.lock")
+			lkctx, err := nsLock.GetLock(ctx, newDynamicTimeout(5*time.Second, 5*time.Second))
+			if err == nil {
+				adminLogIf(ctx, backupConfigOnce(lkctx.Context(), objAPI, bucket))
+				nsLock.Unlock(lkctx)
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// backupConfigOnce copies all objects below the config prefix of the
+// metadata bucket into a timestamped prefix in the backup bucket.
+func backupConfigOnce(ctx context.Context, objAPI ObjectLayer, bucket string) error {
+	if _, err := objAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		return fmt.Errorf("config backup destination %s: %w", bucket, err)
+	}
+
+	backupPrefix := pathJoin(configBackupPrefix, time.Now().UTC().Format("2006-01-02T15-04-05Z"))
+
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan itemOrErr[ObjectInfo])
+	if err := objAPI.Walk(walkCtx, minioMetaBucket, minioConfigPrefix, results, WalkOptions{
+		LatestOnly: true,
+	}); err != nil {
+		return err
+	}
+
+	for res := range results {
+		if res.Err != nil {
+			return res.Err
+		}
+		oi := res.Item
+		gr, err := objAPI.GetObjectNInfo(ctx, minioMetaBucket, oi.Name, nil, http.Header{}, ObjectOptions{
+			NoLock:     true,
+			NoAuditLog: true,
+		})
+		if err != nil {
+			return err
+		}
+		hr, err := hash.NewReader(ctx, gr, gr.ObjInfo.Size, "", "", gr.ObjInfo.Size)
+		if err != nil {
+			gr.Close()
+			return err
+		}
+		_, err = objAPI.PutObject(ctx, bucket, pathJoin(backupPrefix, oi.Name), NewPutObjReader(hr), ObjectOptions{
+			NoAuditLog: true,
+		})
+		gr.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreConfigBackup copies all objects from the given backup prefix in
+// the backup bucket back below the config prefix of the metadata bucket.
+// A restart is required for all nodes to pick up the restored state.
+func restoreConfigBackup(ctx context.Context, objAPI ObjectLayer, bucket, backupPath string) error {
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	prefix := pathJoin(backupPath, minioConfigPrefix)
+	results := make(chan itemOrErr[ObjectInfo])
+	if err := objAPI.Walk(walkCtx, bucket, prefix, results, WalkOptions{
+		LatestOnly: true,
+	}); err != nil {
+		return err
+	}
+
+	restored := 0
+	for res := range results {
+		if res.Err != nil {
+			return res.Err
+		}
+		oi := res.Item
+		gr, err := objAPI.GetObjectNInfo(ctx, bucket, oi.Name, nil, http.Header{}, ObjectOptions{
+			NoLock:     true,
+			NoAuditLog: true,
+		})
+		if err != nil {
+			return err
+		}
+		hr, err := hash.NewReader(ctx, gr, gr.ObjInfo.Size, "", "", gr.ObjInfo.Size)
+		if err != nil {
+			gr.Close()
+			return err
+		}
+		_, err = objAPI.PutObject(ctx, minioMetaBucket, strings.TrimPrefix(oi.Name, backupPath+slashSeparator),
+			NewPutObjReader(hr), ObjectOptions{
+				NoAuditLog: true,
+			})
+		gr.Close()
+		if err != nil {
+			return err
+		}
+		restored++
+	}
+	if restored == 0 {
+		return fmt.Errorf("no configuration found under %s/%s", bucket, prefix)
+	}
+	return nil
+}
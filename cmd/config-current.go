@@ -604,7 +604,10 @@ func applyDynamicConfigForSubSys(ctx context.Context, objAPI ObjectLayer, s conf
 			scannerCycle.Store(scannerCfg.Cycle)
 			scannerExcessObjectVersions.Store(scannerCfg.ExcessVersions)
 			scannerExcessFolders.Store(scannerCfg.ExcessFolders)
-			configLogIf(ctx, scannerSleeper.Update(scannerCfg.Delay, scannerCfg.MaxWait))
+			globalAdaptiveScanner.update(scannerCfg.Adaptive, scannerCfg.TargetLatency)
+			if !scannerCfg.Adaptive {
+				configLogIf(ctx, scannerSleeper.Update(scannerCfg.Delay, scannerCfg.MaxWait))
+			}
 		}
 	case config.LoggerWebhookSubSys:
 		loggerCfg, err := logger.LookupConfigForSubSys(ctx, s, config.LoggerWebhookSubSys)
@@ -0,0 +1,175 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// scannerAdaptiveInterval is how often the adaptive scanner re-evaluates
+// observed request latency and adjusts its speed level.
+const scannerAdaptiveInterval = 10 * time.Second
+
+// scannerLatencyBuckets are the time-to-first-byte boundaries used to
+// approximate the p99 of S3 request latency, mirroring the buckets of the
+// s3_ttfb_seconds histogram.
+var scannerLatencyBuckets = [...]time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// scannerAdaptiveLevels are the sleep settings the adaptive scanner moves
+// between, ordered from most to least aggressive. They mirror the static
+// "fastest" through "slowest" speed presets.
+var scannerAdaptiveLevels = []struct {
+	factor  float64
+	maxWait time.Duration
+}{
+	{0, 0},                      // fastest
+	{1, 100 * time.Millisecond}, // fast
+	{2, time.Second},            // default
+	{10, 15 * time.Second},      // slow
+	{100, 15 * time.Second},     // slowest
+}
+
+// adaptiveScanner implements a feedback loop between S3 request latency and
+// scanner aggressiveness. Request time-to-first-byte samples are collected
+// into a coarse histogram; every scannerAdaptiveInterval the approximate p99
+// of the samples since the last evaluation is compared against the
+// configured target and the scanner speed is moved one level up or down.
+// With no requests at all the scanner jumps to the most aggressive level.
+type adaptiveScanner struct {
+	enabled atomic.Bool
+	target  atomic.Int64 // target p99 latency in nanoseconds
+	level   atomic.Int32
+
+	counts [len(scannerLatencyBuckets) + 1]atomic.Uint64
+}
+
+var globalAdaptiveScanner = &adaptiveScanner{}
+
+// update applies the scanner config, (re)setting the latency target and
+// resetting to the default level when adaptive speed gets enabled.
+func (a *adaptiveScanner) update(enabled bool, target time.Duration) {
+	a.target.Store(int64(target))
+	if enabled && !a.enabled.Load() {
+		a.level.Store(2) // start at the "default" preset
+	}
+	a.enabled.Store(enabled)
+}
+
+// observe records an S3 request's time-to-first-byte sample.
+func (a *adaptiveScanner) observe(ttfb time.Duration) {
+	if !a.enabled.Load() {
+		return
+	}
+	for i, boundary := range scannerLatencyBuckets {
+		if ttfb <= boundary {
+			a.counts[i].Add(1)
+			return
+		}
+	}
+	a.counts[len(scannerLatencyBuckets)].Add(1)
+}
+
+// collect drains the samples recorded since the last call and returns their
+// total count and approximate p99, reported as the upper boundary of the
+// bucket the p99 falls into.
+func (a *adaptiveScanner) collect() (total uint64, p99 time.Duration) {
+	var counts [len(scannerLatencyBuckets) + 1]uint64
+	for i := range a.counts {
+		counts[i] = a.counts[i].Swap(0)
+		total += counts[i]
+	}
+	if total == 0 {
+		return 0, 0
+	}
+	want := total - total/100 // samples at or below p99
+	var seen uint64
+	for i, c := range counts {
+		seen += c
+		if seen >= want {
+			if i < len(scannerLatencyBuckets) {
+				return total, scannerLatencyBuckets[i]
+			}
+			break
+		}
+	}
+	// p99 is beyond the largest boundary.
+	return total, 2 * scannerLatencyBuckets[len(scannerLatencyBuckets)-1]
+}
+
+// currentLevel returns the current speed level, -1 when adaptive speed is
+// disabled.
+func (a *adaptiveScanner) currentLevel() int32 {
+	if !a.enabled.Load() {
+		return -1
+	}
+	return a.level.Load()
+}
+
+// run evaluates observed latency periodically and adjusts the scanner
+// sleeper, one level at a time with hysteresis: the scanner slows down as
+// soon as p99 exceeds the target but only speeds up once p99 drops below
+// half of it.
+func (a *adaptiveScanner) run(ctx context.Context) {
+	t := time.NewTicker(scannerAdaptiveInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		if !a.enabled.Load() {
+			continue
+		}
+		total, p99 := a.collect()
+		target := time.Duration(a.target.Load())
+		level := a.level.Load()
+		switch {
+		case total == 0:
+			// Idle server, use all available capacity.
+			level = 0
+		case p99 > target:
+			level++
+		case p99 <= target/2:
+			level--
+		}
+		if level < 0 {
+			level = 0
+		}
+		if maxLevel := int32(len(scannerAdaptiveLevels) - 1); level > maxLevel {
+			level = maxLevel
+		}
+		if level == a.level.Load() {
+			continue
+		}
+		a.level.Store(level)
+		l := scannerAdaptiveLevels[level]
+		scannerLogIf(ctx, scannerSleeper.Update(l.factor, l.maxWait))
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdaptiveScannerCollect(t *testing.T) {
+	a := &adaptiveScanner{}
+	a.update(true, 500*time.Millisecond)
+
+	// No samples recorded yet.
+	total, p99 := a.collect()
+	if total != 0 || p99 != 0 {
+		t.Fatalf("expected no samples, got total=%d p99=%v", total, p99)
+	}
+
+	// 99 fast requests and one slow one, p99 should report the fast bucket.
+	for range 99 {
+		a.observe(10 * time.Millisecond)
+	}
+	a.observe(3 * time.Second)
+	total, p99 = a.collect()
+	if total != 100 {
+		t.Fatalf("expected 100 samples, got %d", total)
+	}
+	if p99 != 50*time.Millisecond {
+		t.Fatalf("expected p99 of 50ms, got %v", p99)
+	}
+
+	// collect must have drained the window.
+	if total, _ = a.collect(); total != 0 {
+		t.Fatalf("expected drained window, got %d samples", total)
+	}
+
+	// All samples beyond the largest boundary.
+	for range 10 {
+		a.observe(time.Minute)
+	}
+	if _, p99 = a.collect(); p99 <= scannerLatencyBuckets[len(scannerLatencyBuckets)-1] {
+		t.Fatalf("expected p99 beyond largest bucket, got %v", p99)
+	}
+
+	// Samples are dropped while disabled.
+	a.update(false, 500*time.Millisecond)
+	a.observe(10 * time.Millisecond)
+	if total, _ = a.collect(); total != 0 {
+		t.Fatalf("expected no samples while disabled, got %d", total)
+	}
+	if lvl := a.currentLevel(); lvl != -1 {
+		t.Fatalf("expected level -1 while disabled, got %d", lvl)
+	}
+}
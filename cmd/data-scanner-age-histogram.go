@@ -0,0 +1,212 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"sync"
+	"time"
+)
+
+// objectAgeIntervals are the interval boundaries used by the scanner when
+// building per-bucket object age distributions. The last interval is
+// open-ended and collects everything older than the preceding boundary.
+var objectAgeIntervals = [...]struct {
+	name string
+	d    time.Duration
+}{
+	{"LESS_THAN_24_HOURS", 24 * time.Hour},
+	{"BETWEEN_24_HOURS_AND_7_DAYS", 7 * 24 * time.Hour},
+	{"BETWEEN_7_AND_30_DAYS", 30 * 24 * time.Hour},
+	{"BETWEEN_30_AND_90_DAYS", 90 * 24 * time.Hour},
+	{"BETWEEN_90_AND_180_DAYS", 180 * 24 * time.Hour},
+	{"BETWEEN_180_DAYS_AND_1_YEAR", 365 * 24 * time.Hour},
+	{"GREATER_THAN_1_YEAR", 0},
+}
+
+// objectAgeHistogram is a histogram of object ages following
+// objectAgeIntervals.
+type objectAgeHistogram [len(objectAgeIntervals)]uint64
+
+// add increments the histogram interval the given age falls into.
+func (h *objectAgeHistogram) add(age time.Duration) {
+	for i := range objectAgeIntervals[:len(objectAgeIntervals)-1] {
+		if age < objectAgeIntervals[i].d {
+			h[i]++
+			return
+		}
+	}
+	h[len(h)-1]++
+}
+
+// merge adds the counts of the other histogram to h.
+func (h *objectAgeHistogram) merge(o objectAgeHistogram) {
+	for i := range h {
+		h[i] += o[i]
+	}
+}
+
+// toMap returns the histogram as a map with the interval names as keys.
+func (h objectAgeHistogram) toMap() map[string]uint64 {
+	res := make(map[string]uint64, len(h))
+	for i, count := range h {
+		res[objectAgeIntervals[i].name] = count
+	}
+	return res
+}
+
+// objectAgeCollector accumulates object age histograms for a single
+// bucket scan on one drive.
+type objectAgeCollector struct {
+	byStorageClass map[string]*objectAgeHistogram
+	byLastAccess   objectAgeHistogram
+	accessRecorded bool
+	updatedAt      time.Time
+}
+
+func newObjectAgeCollector() *objectAgeCollector {
+	return &objectAgeCollector{
+		byStorageClass: make(map[string]*objectAgeHistogram),
+	}
+}
+
+// record adds one object version to the collector. Access time is only
+// accounted when the backend provides one.
+func (c *objectAgeCollector) record(storageClass string, modTime, accTime time.Time) {
+	now := time.Now()
+	h := c.byStorageClass[storageClass]
+	if h == nil {
+		h = &objectAgeHistogram{}
+		c.byStorageClass[storageClass] = h
+	}
+	h.add(now.Sub(modTime))
+	if !accTime.IsZero() && accTime.Unix() != 0 {
+		c.byLastAccess.add(now.Sub(accTime))
+		c.accessRecorded = true
+	}
+}
+
+// bucketObjectAgeStats is the JSON serializable object age distribution
+// for a single bucket as returned by the admin API.
+type bucketObjectAgeStats struct {
+	// ByStorageClass maps a storage class (or tier for transitioned
+	// objects) to a histogram of object age since last modification.
+	ByStorageClass map[string]map[string]uint64 `json:"byStorageClass"`
+	// ByLastAccess is a histogram of time since last access, present
+	// only when the backend records access times.
+	ByLastAccess map[string]uint64 `json:"byLastAccess,omitempty"`
+	// UpdatedAt is the time the most recent contributing scan finished.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// objectAgeStats holds the per-drive object age collectors from the most
+// recent completed bucket scans on this node.
+type objectAgeStats struct {
+	mu sync.RWMutex
+	// drive -> bucket -> collector
+	drives map[string]map[string]*objectAgeCollector
+}
+
+var globalObjectAgeStats = &objectAgeStats{
+	drives: make(map[string]map[string]*objectAgeCollector),
+}
+
+// update replaces the collector for the given drive and bucket with the
+// results of a freshly completed scan.
+func (s *objectAgeStats) update(drive, bucket string, c *objectAgeCollector) {
+	c.updatedAt = time.Now().UTC()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	buckets := s.drives[drive]
+	if buckets == nil {
+		buckets = make(map[string]*objectAgeCollector)
+		s.drives[drive] = buckets
+	}
+	buckets[bucket] = c
+}
+
+// report returns the aggregated object age distribution across all
+// drives on this node, optionally restricted to the given buckets.
+func (s *objectAgeStats) report(buckets ...string) map[string]bucketObjectAgeStats {
+	wanted := make(map[string]struct{}, len(buckets))
+	for _, bucket := range buckets {
+		wanted[bucket] = struct{}{}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make(map[string]bucketObjectAgeStats)
+	for _, bktCollectors := range s.drives {
+		for bucket, c := range bktCollectors {
+			if len(wanted) > 0 {
+				if _, ok := wanted[bucket]; !ok {
+					continue
+				}
+			}
+			stats := res[bucket]
+			if stats.ByStorageClass == nil {
+				stats.ByStorageClass = make(map[string]map[string]uint64)
+			}
+			for sc, h := range c.byStorageClass {
+				stats.ByStorageClass[sc] = mergeAgeHistogramMap(stats.ByStorageClass[sc], h.toMap())
+			}
+			if c.accessRecorded {
+				stats.ByLastAccess = mergeAgeHistogramMap(stats.ByLastAccess, c.byLastAccess.toMap())
+			}
+			if c.updatedAt.After(stats.UpdatedAt) {
+				stats.UpdatedAt = c.updatedAt
+			}
+			res[bucket] = stats
+		}
+	}
+	return res
+}
+
+// mergeAgeHistogramMap adds the counts of src into dst, allocating dst
+// if necessary.
+func mergeAgeHistogramMap(dst, src map[string]uint64) map[string]uint64 {
+	if dst == nil {
+		dst = make(map[string]uint64, len(src))
+	}
+	for k, v := range src {
+		dst[k] += v
+	}
+	return dst
+}
+
+// mergeObjectAgeStats merges the per-bucket distributions from src into
+// dst, used when aggregating reports from peers.
+func mergeObjectAgeStats(dst, src map[string]bucketObjectAgeStats) {
+	for bucket, srcStats := range src {
+		stats := dst[bucket]
+		if stats.ByStorageClass == nil {
+			stats.ByStorageClass = make(map[string]map[string]uint64)
+		}
+		for sc, h := range srcStats.ByStorageClass {
+			stats.ByStorageClass[sc] = mergeAgeHistogramMap(stats.ByStorageClass[sc], h)
+		}
+		if len(srcStats.ByLastAccess) > 0 {
+			stats.ByLastAccess = mergeAgeHistogramMap(stats.ByLastAccess, srcStats.ByLastAccess)
+		}
+		if srcStats.UpdatedAt.After(stats.UpdatedAt) {
+			stats.UpdatedAt = srcStats.UpdatedAt
+		}
+		dst[bucket] = stats
+	}
+}
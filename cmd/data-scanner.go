@@ -75,6 +75,7 @@ var (
 
 // initDataScanner will start the scanner in the background.
 func initDataScanner(ctx context.Context, objAPI ObjectLayer) {
+	go globalAdaptiveScanner.run(ctx)
 	go func() {
 		r := rand.New(rand.NewSource(time.Now().UnixNano()))
 		// Run the data scanner in a loop
@@ -925,6 +926,9 @@ type sizeSummary struct {
 	failedCount     uint64
 	replTargetStats map[string]replTargetSizeSummary
 	tiers           map[string]tierStats
+	// owners is the total size in bytes per uploading access key, only
+	// filled when owner accounting is enabled.
+	owners map[string]uint64
 }
 
 // replTargetSizeSummary holds summary of replication stats by target
@@ -1116,6 +1120,35 @@ func (i *scannerItem) applyNewerNoncurrentVersionLimit(ctx context.Context, _ Ob
 	return objectInfos, nil
 }
 
+// applyNewerNoncurrentTransitionLimit transitions noncurrent versions older
+// than the most recent NewerNoncurrentVersions configured for transition.
+// Versions within the limit stay untransitioned in the hot tier.
+func (i *scannerItem) applyNewerNoncurrentTransitionLimit(_ context.Context, objInfos []ObjectInfo) {
+	if i.lifeCycle == nil {
+		return
+	}
+
+	event := i.lifeCycle.NoncurrentVersionsTransitionLimit(lifecycle.ObjectOpts{Name: i.objectPath()})
+	lim := event.NewerNoncurrentVersions
+	if lim == 0 || len(objInfos) <= lim+1 { // fewer than lim _noncurrent_ versions
+		return
+	}
+
+	// Retain the current version + most recent lim noncurrent versions
+	for _, obj := range objInfos[lim+1:] {
+		if obj.IsLatest || obj.DeleteMarker || obj.TransitionedObject.Status == lifecycle.TransitionComplete {
+			continue
+		}
+
+		// NoncurrentDays not passed yet.
+		if time.Now().UTC().Before(lifecycle.ExpectedExpiryTime(obj.SuccessorModTime, event.NoncurrentDays)) {
+			continue
+		}
+
+		applyTransitionRule(event, lcEventSrc_Scanner, obj)
+	}
+}
+
 // applyVersionActions will apply lifecycle checks on all versions of a scanned item. Returns versions that remain
 // after applying lifecycle checks configured.
 func (i *scannerItem) applyVersionActions(ctx context.Context, o ObjectLayer, fivs []FileInfo, expState *expiryState) ([]ObjectInfo, error) {
@@ -1124,6 +1157,10 @@ func (i *scannerItem) applyVersionActions(ctx context.Context, o ObjectLayer, fi
 		return nil, err
 	}
 
+	// Transition noncurrent versions overflowing a configured
+	// NewerNoncurrentVersions transition limit.
+	i.applyNewerNoncurrentTransitionLimit(ctx, objInfos)
+
 	// Check if we have many versions after applyNewerNoncurrentVersionLimit.
 	if len(objInfos) >= int(scannerExcessObjectVersions.Load()) {
 		// Notify object accessed via a GET request.
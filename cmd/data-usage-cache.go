@@ -66,7 +66,10 @@ type dataUsageEntry struct {
 	ObjSizes      sizeHistogram     `msg:"szs"`
 	ObjVersions   versionsHistogram `msg:"vh"`
 	AllTierStats  *allTierStats     `msg:"ats,omitempty"`
-	Compacted     bool              `msg:"c"`
+	// OwnersUsage is the total size in bytes per uploading access key,
+	// only populated when owner accounting is enabled.
+	OwnersUsage map[string]uint64 `msg:"own,omitempty"`
+	Compacted   bool              `msg:"c"`
 }
 
 // allTierStats is a collection of per-tier stats across all configured remote
@@ -285,6 +288,15 @@ func (e *dataUsageEntry) addSizes(summary sizeSummary) {
 		}
 		e.AllTierStats.addSizes(summary.tiers)
 	}
+
+	if len(summary.owners) != 0 {
+		if e.OwnersUsage == nil {
+			e.OwnersUsage = make(map[string]uint64, len(summary.owners))
+		}
+		for owner, sz := range summary.owners {
+			e.OwnersUsage[owner] += sz
+		}
+	}
 }
 
 // merge other data usage entry into this, excluding children.
@@ -308,6 +320,15 @@ func (e *dataUsageEntry) merge(other dataUsageEntry) {
 		}
 		e.AllTierStats.merge(other.AllTierStats)
 	}
+
+	if len(other.OwnersUsage) != 0 {
+		if e.OwnersUsage == nil {
+			e.OwnersUsage = make(map[string]uint64, len(other.OwnersUsage))
+		}
+		for owner, sz := range other.OwnersUsage {
+			e.OwnersUsage[owner] += sz
+		}
+	}
 }
 
 // mod returns true if the hash mod cycles == cycle.
@@ -357,6 +378,14 @@ func (e dataUsageEntry) clone() dataUsageEntry {
 	if e.AllTierStats != nil {
 		e.AllTierStats = e.AllTierStats.clone()
 	}
+
+	if e.OwnersUsage != nil {
+		ou := make(map[string]uint64, len(e.OwnersUsage))
+		for owner, sz := range e.OwnersUsage {
+			ou[owner] = sz
+		}
+		e.OwnersUsage = ou
+	}
 	return e
 }
 
@@ -443,6 +472,9 @@ func (d *dataUsageCache) dui(path string, buckets []BucketInfo) DataUsageInfo {
 		BucketsUsage:            d.bucketsUsageInfo(buckets),
 		TierStats:               d.tiersUsageInfo(buckets),
 	}
+	if len(flat.OwnersUsage) != 0 {
+		dui.OwnersUsage = flat.OwnersUsage
+	}
 	return dui
 }
 
@@ -791,6 +823,14 @@ func (d *dataUsageCache) bucketsUsageInfo(buckets []BucketInfo) map[string]Bucke
 			ObjectSizesHistogram:    flat.ObjSizes.toMap(),
 			ObjectVersionsHistogram: flat.ObjVersions.toMap(),
 		}
+		if flat.AllTierStats != nil {
+			ts := make(map[string]madmin.TierStats, len(flat.AllTierStats.Tiers))
+			flat.AllTierStats.populateStats(ts)
+			bui.TierStats = ts
+		}
+		if len(flat.OwnersUsage) != 0 {
+			bui.OwnersUsage = flat.OwnersUsage
+		}
 		dst[bucket.Name] = bui
 	}
 	return dst
@@ -1633,7 +1633,7 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint8 /* 1 bits */
+	var zb0001Mask uint8 /* 2 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -1810,6 +1810,37 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 				}
 			}
 			zb0001Mask |= 0x1
+		case "own":
+			var zb0007 uint32
+			zb0007, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "OwnersUsage")
+				return
+			}
+			if z.OwnersUsage == nil {
+				z.OwnersUsage = make(map[string]uint64, zb0007)
+			} else if len(z.OwnersUsage) > 0 {
+				for key := range z.OwnersUsage {
+					delete(z.OwnersUsage, key)
+				}
+			}
+			for zb0007 > 0 {
+				zb0007--
+				var za0005 string
+				var za0006 uint64
+				za0005, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "OwnersUsage")
+					return
+				}
+				za0006, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "OwnersUsage", za0005)
+					return
+				}
+				z.OwnersUsage[za0005] = za0006
+			}
+			zb0001Mask |= 0x2
 		case "c":
 			z.Compacted, err = dc.ReadBool()
 			if err != nil {
@@ -1825,23 +1856,31 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 		}
 	}
 	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.AllTierStats = nil
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.AllTierStats = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.OwnersUsage = nil
+		}
 	}
-
 	return
 }
 
 // EncodeMsg implements msgp.Encodable
 func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(10)
+	var zb0001Mask uint16 /* 10 bits */
 	_ = zb0001Mask
 	if z.AllTierStats == nil {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
+	if z.OwnersUsage == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -1997,6 +2036,30 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 				}
 			}
 		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// write "own"
+			err = en.Append(0xa3, 0x6f, 0x77, 0x6e)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.OwnersUsage)))
+			if err != nil {
+				err = msgp.WrapError(err, "OwnersUsage")
+				return
+			}
+			for za0005, za0006 := range z.OwnersUsage {
+				err = en.WriteString(za0005)
+				if err != nil {
+					err = msgp.WrapError(err, "OwnersUsage")
+					return
+				}
+				err = en.WriteUint64(za0006)
+				if err != nil {
+					err = msgp.WrapError(err, "OwnersUsage", za0005)
+					return
+				}
+			}
+		}
 		// write "c"
 		err = en.Append(0xa1, 0x63)
 		if err != nil {
@@ -2015,13 +2078,17 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(10)
+	var zb0001Mask uint16 /* 10 bits */
 	_ = zb0001Mask
 	if z.AllTierStats == nil {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
+	if z.OwnersUsage == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 
@@ -2083,6 +2150,15 @@ func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 				}
 			}
 		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// string "own"
+			o = append(o, 0xa3, 0x6f, 0x77, 0x6e)
+			o = msgp.AppendMapHeader(o, uint32(len(z.OwnersUsage)))
+			for za0005, za0006 := range z.OwnersUsage {
+				o = msgp.AppendString(o, za0005)
+				o = msgp.AppendUint64(o, za0006)
+			}
+		}
 		// string "c"
 		o = append(o, 0xa1, 0x63)
 		o = msgp.AppendBool(o, z.Compacted)
@@ -2100,7 +2176,7 @@ func (z *dataUsageEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint8 /* 1 bits */
+	var zb0001Mask uint8 /* 2 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -2276,6 +2352,37 @@ func (z *dataUsageEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 			zb0001Mask |= 0x1
+		case "own":
+			var zb0007 uint32
+			zb0007, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OwnersUsage")
+				return
+			}
+			if z.OwnersUsage == nil {
+				z.OwnersUsage = make(map[string]uint64, zb0007)
+			} else if len(z.OwnersUsage) > 0 {
+				for key := range z.OwnersUsage {
+					delete(z.OwnersUsage, key)
+				}
+			}
+			for zb0007 > 0 {
+				var za0005 string
+				var za0006 uint64
+				zb0007--
+				za0005, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "OwnersUsage")
+					return
+				}
+				za0006, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "OwnersUsage", za0005)
+					return
+				}
+				z.OwnersUsage[za0005] = za0006
+			}
+			zb0001Mask |= 0x2
 		case "c":
 			z.Compacted, bts, err = msgp.ReadBoolBytes(bts)
 			if err != nil {
@@ -2291,10 +2398,14 @@ func (z *dataUsageEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		}
 	}
 	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.AllTierStats = nil
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.AllTierStats = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.OwnersUsage = nil
+		}
 	}
-
 	o = bts
 	return
 }
@@ -2313,6 +2424,13 @@ func (z *dataUsageEntry) Msgsize() (s int) {
 			}
 		}
 	}
+	s += 4 + msgp.MapHeaderSize
+	if z.OwnersUsage != nil {
+		for za0005, za0006 := range z.OwnersUsage {
+			_ = za0006
+			s += msgp.StringPrefixSize + len(za0005) + msgp.Uint64Size
+		}
+	}
 	s += 2 + msgp.BoolSize
 	return
 }
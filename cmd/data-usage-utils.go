@@ -67,6 +67,14 @@ type BucketUsageInfo struct {
 	ReplicaSize             uint64                           `json:"objectReplicaTotalSize"`
 	ReplicaCount            uint64                           `json:"objectReplicaCount"`
 	ReplicationInfo         map[string]BucketTargetUsageInfo `json:"objectsReplicationInfo"`
+
+	// TierStats contains the bucket's per-tier stats of all configured
+	// remote tiers
+	TierStats map[string]madmin.TierStats `json:"tierStats,omitempty"`
+
+	// OwnersUsage is the bucket's stored bytes per uploading access key,
+	// only populated when owner accounting is enabled.
+	OwnersUsage map[string]uint64 `json:"ownersUsage,omitempty"`
 }
 
 // DataUsageInfo represents data usage stats of the underlying Object API
@@ -105,6 +113,10 @@ type DataUsageInfo struct {
 
 	// TierStats contains per-tier stats of all configured remote tiers
 	TierStats *allTierStats `json:"tierStats,omitempty"`
+
+	// OwnersUsage is the stored bytes per uploading access key across all
+	// buckets, only populated when owner accounting is enabled.
+	OwnersUsage map[string]uint64 `json:"ownersUsage,omitempty"`
 }
 
 func (dui DataUsageInfo) tierStats() []madmin.TierInfo {
@@ -25,6 +25,8 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/minio/minio/internal/cachevalue"
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/pkg/v3/env"
 )
 
 const (
@@ -38,9 +40,20 @@ const (
 
 	backgroundHealInfoPath = bucketMetaPrefix + SlashSeparator + ".background-heal.json"
 
+	// ownerMetadataKey records the access key that uploaded an object,
+	// only written when owner accounting is enabled.
+	ownerMetadataKey = ReservedMetadataPrefixLower + "owner"
+
+	ownerAccountingEnvVar = "_MINIO_OWNER_ACCOUNTING"
+
 	dataUsageCacheName = ".usage-cache.bin"
 )
 
+// ownerAccountingEnabled reports whether per-owner data usage accounting is
+// turned on. When enabled, the uploading access key is recorded with each
+// object written and the scanner aggregates stored bytes per access key.
+var ownerAccountingEnabled = env.Get(ownerAccountingEnvVar, config.EnableOff) == config.EnableOn
+
 // storeDataUsageInBackend will store all objects sent on the dui channel until closed.
 func storeDataUsageInBackend(ctx context.Context, objAPI ObjectLayer, dui <-chan DataUsageInfo) {
 	attempts := 1
@@ -0,0 +1,419 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio/internal/bucket/replication"
+	"github.com/minio/minio/internal/config/cache"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/disk"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/v3/wildcard"
+)
+
+const (
+	// Name of the object data file inside a cache entry directory.
+	cacheDataFile = "part.1"
+
+	// Name of the metadata file inside a cache entry directory.
+	cacheMetaJSONFile = "cache.json"
+
+	// Scratch space for entries while they are being written.
+	cacheTmpDir = ".tmp"
+
+	// How often cache drives are checked against the high watermark.
+	cacheGCInterval = 5 * time.Minute
+
+	// Upper bound on tracked read counters when `after` admission is
+	// configured, beyond this the counters are reset.
+	cacheMaxHitCounters = 100000
+)
+
+// cacheEntryMeta is persisted as cache.json next to the cached object
+// data and carries enough of ObjectInfo to serve a GET from cache.
+type cacheEntryMeta struct {
+	Bucket                    string            `json:"bucket"`
+	Object                    string            `json:"object"`
+	ETag                      string            `json:"etag"`
+	VersionID                 string            `json:"versionId,omitempty"`
+	Size                      int64             `json:"size"`
+	ModTime                   time.Time         `json:"modTime"`
+	ContentType               string            `json:"contentType,omitempty"`
+	ContentEncoding           string            `json:"contentEncoding,omitempty"`
+	UserDefined               map[string]string `json:"userDefined,omitempty"`
+	UserTags                  string            `json:"userTags,omitempty"`
+	ReplicationStatusInternal string            `json:"replicationStatusInternal,omitempty"`
+	ReplicationStatus         string            `json:"replicationStatus,omitempty"`
+}
+
+func (m cacheEntryMeta) ToObjectInfo() ObjectInfo {
+	return ObjectInfo{
+		Bucket:                    m.Bucket,
+		Name:                      m.Object,
+		ETag:                      m.ETag,
+		VersionID:                 m.VersionID,
+		IsLatest:                  true,
+		Size:                      m.Size,
+		ModTime:                   m.ModTime,
+		ContentType:               m.ContentType,
+		ContentEncoding:           m.ContentEncoding,
+		UserDefined:               m.UserDefined,
+		UserTags:                  m.UserTags,
+		ReplicationStatusInternal: m.ReplicationStatusInternal,
+		ReplicationStatus:         replication.StatusType(m.ReplicationStatus),
+	}
+}
+
+// diskCache is the store for cached objects on a single cache drive.
+// Entries live in directories keyed by the hash of bucket/object so
+// lookups never list the drive.
+type diskCache struct {
+	dir           string
+	watermarkLow  int
+	watermarkHigh int
+
+	gcRunning atomic.Bool
+}
+
+func newDiskCache(dir string, watermarkLow, watermarkHigh int) (*diskCache, error) {
+	if err := os.MkdirAll(filepath.Join(dir, cacheTmpDir), 0o777); err != nil {
+		return nil, err
+	}
+	return &diskCache{
+		dir:           dir,
+		watermarkLow:  watermarkLow,
+		watermarkHigh: watermarkHigh,
+	}, nil
+}
+
+func (c *diskCache) entryDir(bucket, object string) string {
+	return filepath.Join(c.dir, getSHA256Hash([]byte(pathJoin(bucket, object))))
+}
+
+// usagePercent returns the used space of the cache drive as a
+// percentage of its capacity.
+func (c *diskCache) usagePercent() (int, error) {
+	di, err := disk.GetInfo(c.dir, false)
+	if err != nil {
+		return 0, err
+	}
+	if di.Total == 0 {
+		return 0, errDiskFull
+	}
+	return int((di.Total - di.Free) * 100 / di.Total), nil
+}
+
+// Get opens a cached entry, a hit refreshes the data file mtime so the
+// eviction cycle treats it as recently used.
+func (c *diskCache) Get(bucket, object string) (io.ReadCloser, cacheEntryMeta, error) {
+	var meta cacheEntryMeta
+	entry := c.entryDir(bucket, object)
+	buf, err := os.ReadFile(filepath.Join(entry, cacheMetaJSONFile))
+	if err != nil {
+		return nil, meta, errFileNotFound
+	}
+	if err = json.Unmarshal(buf, &meta); err != nil {
+		c.Delete(bucket, object)
+		return nil, meta, errFileNotFound
+	}
+	f, err := os.Open(filepath.Join(entry, cacheDataFile))
+	if err != nil {
+		return nil, meta, errFileNotFound
+	}
+	now := time.Now()
+	os.Chtimes(f.Name(), now, now)
+	return f, meta, nil
+}
+
+// Put streams an object into the cache, the entry becomes visible only
+// after both data and metadata have been fully written and renamed in
+// place. Short writes - a client hanging up mid download - are thrown
+// away.
+func (c *diskCache) Put(meta cacheEntryMeta, r io.Reader) error {
+	if pct, err := c.usagePercent(); err != nil || pct >= c.watermarkHigh {
+		// Drive is above the high watermark, drop the write and
+		// let the eviction cycle catch up first.
+		io.Copy(io.Discard, r)
+		return errDiskFull
+	}
+	tmp, err := os.MkdirTemp(filepath.Join(c.dir, cacheTmpDir), "entry")
+	if err != nil {
+		io.Copy(io.Discard, r)
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	f, err := os.Create(filepath.Join(tmp, cacheDataFile))
+	if err != nil {
+		io.Copy(io.Discard, r)
+		return err
+	}
+	n, err := io.Copy(f, r)
+	f.Close()
+	if err != nil || n != meta.Size {
+		// A cache drive error mid copy must not stall the client GET
+		// feeding the other end of the pipe, keep draining.
+		io.Copy(io.Discard, r)
+		return errFileCorrupt
+	}
+	buf, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(filepath.Join(tmp, cacheMetaJSONFile), buf, 0o666); err != nil {
+		return err
+	}
+
+	entry := c.entryDir(meta.Bucket, meta.Object)
+	os.RemoveAll(entry)
+	return os.Rename(tmp, entry)
+}
+
+// Delete removes a cached entry if present.
+func (c *diskCache) Delete(bucket, object string) {
+	os.RemoveAll(c.entryDir(bucket, object))
+}
+
+// gc evicts least recently used entries until drive usage falls below
+// the low watermark. Recency is the data file mtime, refreshed on every
+// cache hit.
+func (c *diskCache) gc() {
+	if !c.gcRunning.CompareAndSwap(false, true) {
+		return
+	}
+	defer c.gcRunning.Store(false)
+
+	pct, err := c.usagePercent()
+	if err != nil || pct < c.watermarkHigh {
+		return
+	}
+
+	type cacheEntry struct {
+		dir     string
+		size    int64
+		modTime time.Time
+	}
+	var entries []cacheEntry
+	dirs, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	for _, d := range dirs {
+		if !d.IsDir() || d.Name() == cacheTmpDir {
+			continue
+		}
+		fi, err := os.Stat(filepath.Join(c.dir, d.Name(), cacheDataFile))
+		if err != nil {
+			continue
+		}
+		entries = append(entries, cacheEntry{
+			dir:     filepath.Join(c.dir, d.Name()),
+			size:    fi.Size(),
+			modTime: fi.ModTime(),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.Before(entries[j].modTime)
+	})
+
+	di, err := disk.GetInfo(c.dir, false)
+	if err != nil || di.Total == 0 {
+		return
+	}
+	used := di.Total - di.Free
+	for _, entry := range entries {
+		if int(used*100/di.Total) < c.watermarkLow {
+			break
+		}
+		if err := os.RemoveAll(entry.dir); err == nil {
+			used -= uint64(entry.size)
+		}
+	}
+}
+
+// cacheObjects serves repeated GETs from cache drives, falling back to
+// the object layer on miss or stale entries. It is deliberately not an
+// ObjectLayer, writes and all other reads go straight to the backend and
+// correctness relies on revalidating the cached ETag against the backend
+// on every hit.
+type cacheObjects struct {
+	inner   ObjectLayer
+	caches  []*diskCache
+	exclude []string
+	after   int
+
+	hitsMu sync.Mutex
+	hits   map[string]int
+}
+
+// getCache spreads entries over the cache drives by hashing the object
+// name, the same way erasure sets spread objects.
+func (c *cacheObjects) getCache(bucket, object string) *diskCache {
+	return c.caches[crcHashMod(pathJoin(bucket, object), len(c.caches))]
+}
+
+// skipCache returns true for requests the cache does not handle - range
+// reads, versioned reads and internal buckets - and for objects matching
+// a configured exclude pattern.
+func (c *cacheObjects) skipCache(bucket, object string, rs *HTTPRangeSpec, opts ObjectOptions) bool {
+	if rs != nil || opts.PartNumber > 0 || opts.VersionID != "" {
+		return true
+	}
+	if isMinioMetaBucketName(bucket) {
+		return true
+	}
+	for _, pattern := range c.exclude {
+		if wildcard.Match(pattern, pathJoin(bucket, object)) {
+			return true
+		}
+	}
+	return false
+}
+
+// admit reports whether the object has been read often enough to be
+// worth caching, per the configured `after` threshold.
+func (c *cacheObjects) admit(bucket, object string) bool {
+	if c.after <= 0 {
+		return true
+	}
+	c.hitsMu.Lock()
+	defer c.hitsMu.Unlock()
+	if len(c.hits) > cacheMaxHitCounters {
+		c.hits = make(map[string]int)
+	}
+	key := pathJoin(bucket, object)
+	c.hits[key]++
+	return c.hits[key] >= c.after
+}
+
+// GetObjectNInfo serves a GET from the cache when possible. A cache hit
+// is revalidated with a metadata read against the backend, a stale or
+// deleted object invalidates the entry. On miss the backend response is
+// streamed to the client and teed into the cache.
+func (c *cacheObjects) GetObjectNInfo(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, opts ObjectOptions) (*GetObjectReader, error) {
+	if c.skipCache(bucket, object, rs, opts) {
+		return c.inner.GetObjectNInfo(ctx, bucket, object, rs, h, opts)
+	}
+
+	dcache := c.getCache(bucket, object)
+	if rc, meta, cerr := dcache.Get(bucket, object); cerr == nil {
+		oi, err := c.inner.GetObjectInfo(ctx, bucket, object, opts)
+		if err != nil {
+			rc.Close()
+			dcache.Delete(bucket, object)
+			return nil, err
+		}
+		// A matching ETag alone is not enough, an overwrite can carry
+		// the same content under a new version.
+		if oi.ETag == meta.ETag && oi.VersionID == meta.VersionID {
+			return NewGetObjectReaderFromReader(rc, meta.ToObjectInfo(), opts, func() { rc.Close() })
+		}
+		rc.Close()
+		dcache.Delete(bucket, object)
+	}
+
+	gr, err := c.inner.GetObjectNInfo(ctx, bucket, object, rs, h, opts)
+	if err != nil {
+		return nil, err
+	}
+	if _, encrypted := crypto.IsEncrypted(gr.ObjInfo.UserDefined); encrypted ||
+		gr.ObjInfo.Size <= 0 || !c.admit(bucket, object) {
+		return gr, nil
+	}
+
+	meta := cacheEntryMeta{
+		Bucket:                    bucket,
+		Object:                    object,
+		ETag:                      gr.ObjInfo.ETag,
+		VersionID:                 gr.ObjInfo.VersionID,
+		Size:                      gr.ObjInfo.Size,
+		ModTime:                   gr.ObjInfo.ModTime,
+		ContentType:               gr.ObjInfo.ContentType,
+		ContentEncoding:           gr.ObjInfo.ContentEncoding,
+		UserDefined:               gr.ObjInfo.UserDefined,
+		UserTags:                  gr.ObjInfo.UserTags,
+		ReplicationStatusInternal: gr.ObjInfo.ReplicationStatusInternal,
+		ReplicationStatus:         gr.ObjInfo.ReplicationStatus.String(),
+	}
+	pr, pw := io.Pipe()
+	go dcache.Put(meta, pr)
+	tee := io.TeeReader(gr, pw)
+	return NewGetObjectReaderFromReader(tee, gr.ObjInfo, opts, func() {
+		// An early close leaves the pipe short of meta.Size and
+		// Put discards the partial entry.
+		pw.Close()
+		gr.Close()
+	})
+}
+
+// globalCacheObjects is non-nil only when cache drives are configured.
+var globalCacheObjects atomic.Pointer[cacheObjects]
+
+// initDiskCache wires up the read-through disk cache over the given
+// object layer if cache drives have been configured in the environment.
+func initDiskCache(ctx context.Context, objAPI ObjectLayer) {
+	cfg, err := cache.LookupConfig()
+	if err != nil {
+		logger.Fatal(err, "Unable to initialize disk caching")
+	}
+	if !cfg.Enabled {
+		return
+	}
+
+	caches := make([]*diskCache, 0, len(cfg.Drives))
+	for _, dir := range cfg.Drives {
+		dcache, err := newDiskCache(dir, cfg.WatermarkLow, cfg.WatermarkHigh)
+		if err != nil {
+			logger.Fatal(err, "Unable to initialize cache drive %s", dir)
+		}
+		caches = append(caches, dcache)
+	}
+
+	c := &cacheObjects{
+		inner:   objAPI,
+		caches:  caches,
+		exclude: cfg.Exclude,
+		after:   cfg.After,
+		hits:    make(map[string]int),
+	}
+	globalCacheObjects.Store(c)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(cacheGCInterval):
+				for _, dcache := range caches {
+					dcache.gc()
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Tests Put/Get/Delete round trip on a single cache drive.
+func TestDiskCacheRoundTrip(t *testing.T) {
+	dcache, err := newDiskCache(t.TempDir(), 70, 80)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("hello, cache")
+	meta := cacheEntryMeta{
+		Bucket:      "bucket",
+		Object:      "dir/object",
+		ETag:        "etag-1",
+		Size:        int64(len(data)),
+		ModTime:     UTCNow(),
+		ContentType: "text/plain",
+	}
+
+	if _, _, err = dcache.Get(meta.Bucket, meta.Object); err != errFileNotFound {
+		t.Fatalf("expected errFileNotFound on empty cache, got %v", err)
+	}
+
+	if err = dcache.Put(meta, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	rc, gotMeta, err := dcache.Get(meta.Bucket, meta.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("expected data %q, got %q", data, got)
+	}
+	if gotMeta.ETag != meta.ETag || gotMeta.Size != meta.Size {
+		t.Fatalf("expected metadata %+v, got %+v", meta, gotMeta)
+	}
+
+	// A short write must not create a visible entry.
+	short := cacheEntryMeta{Bucket: "bucket", Object: "short", Size: 100, ModTime: time.Now()}
+	if err = dcache.Put(short, strings.NewReader("partial")); err != errFileCorrupt {
+		t.Fatalf("expected errFileCorrupt on short write, got %v", err)
+	}
+	if _, _, err = dcache.Get(short.Bucket, short.Object); err != errFileNotFound {
+		t.Fatalf("expected errFileNotFound after short write, got %v", err)
+	}
+
+	dcache.Delete(meta.Bucket, meta.Object)
+	if _, _, err = dcache.Get(meta.Bucket, meta.Object); err != errFileNotFound {
+		t.Fatalf("expected errFileNotFound after delete, got %v", err)
+	}
+}
+
+// Tests requests the cache must not serve.
+func TestCacheObjectsSkipCache(t *testing.T) {
+	c := &cacheObjects{exclude: []string{"excluded/*", "*/skip-*"}}
+
+	testCases := []struct {
+		bucket, object string
+		rs             *HTTPRangeSpec
+		opts           ObjectOptions
+		skip           bool
+	}{
+		{bucket: "bucket", object: "object", skip: false},
+		{bucket: "bucket", object: "object", rs: &HTTPRangeSpec{Start: 0, End: 10}, skip: true},
+		{bucket: "bucket", object: "object", opts: ObjectOptions{VersionID: mustGetUUID()}, skip: true},
+		{bucket: "bucket", object: "object", opts: ObjectOptions{PartNumber: 1}, skip: true},
+		{bucket: minioMetaBucket, object: "config/iam.json", skip: true},
+		{bucket: "excluded", object: "object", skip: true},
+		{bucket: "bucket", object: "skip-me", skip: true},
+	}
+
+	for i, testCase := range testCases {
+		if got := c.skipCache(testCase.bucket, testCase.object, testCase.rs, testCase.opts); got != testCase.skip {
+			t.Errorf("test %d: expected skip=%v for %s/%s, got %v", i, testCase.skip, testCase.bucket, testCase.object, got)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Client-side encryption envelope metadata keys as stored in the user
+// defined metadata map. These are written by S3 encryption clients and
+// are passed through verbatim, MinIO never interprets the envelope.
+const (
+	cseKeyV1  = "x-amz-meta-x-amz-key"
+	cseKeyV2  = "x-amz-meta-x-amz-key-v2"
+	cseIV     = "x-amz-meta-x-amz-iv"
+	cseMatDes = "x-amz-meta-x-amz-matdesc"
+	cseCEKAlg = "x-amz-meta-x-amz-cek-alg"
+	cseWrpAlg = "x-amz-meta-x-amz-wrap-alg"
+
+	cseUnencLength = "x-amz-meta-x-amz-unencrypted-content-length"
+)
+
+// errInvalidCSEMetadata means the client-side encryption envelope metadata
+// sent along with the object is incomplete or malformed.
+var errInvalidCSEMetadata = errors.New("invalid client-side encryption envelope metadata")
+
+// cseLookup returns the value of the given envelope key, matching the
+// key case-insensitively - metadata extracted from request headers is
+// stored in canonical form (X-Amz-Meta-X-Amz-Key), while other paths
+// store it lowercased.
+func cseLookup(metadata map[string]string, key string) (string, bool) {
+	for k, v := range metadata {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// validateCSEMetadata validates the client-side encryption envelope
+// metadata, if any, before it is stored. The envelope is passed through
+// verbatim, however an incomplete or malformed envelope renders the
+// object undecryptable by encryption clients, reject it early instead.
+func validateCSEMetadata(metadata map[string]string) error {
+	key, v1 := cseLookup(metadata, cseKeyV1)
+	keyV2, v2 := cseLookup(metadata, cseKeyV2)
+	if !v1 && !v2 {
+		return nil
+	}
+	if v1 && v2 {
+		// Either envelope version, never both.
+		return errInvalidCSEMetadata
+	}
+	if v2 {
+		key = keyV2
+	}
+	if _, err := base64.StdEncoding.DecodeString(key); err != nil {
+		return errInvalidCSEMetadata
+	}
+	iv, ok := cseLookup(metadata, cseIV)
+	if !ok {
+		return errInvalidCSEMetadata
+	}
+	if _, err := base64.StdEncoding.DecodeString(iv); err != nil {
+		return errInvalidCSEMetadata
+	}
+	if length, ok := cseLookup(metadata, cseUnencLength); ok {
+		if n, err := strconv.ParseInt(length, 10, 64); err != nil || n < 0 {
+			return errInvalidCSEMetadata
+		}
+	}
+	if matDesc, ok := cseLookup(metadata, cseMatDes); ok {
+		var desc map[string]string
+		if err := json.Unmarshal([]byte(matDesc), &desc); err != nil {
+			return errInvalidCSEMetadata
+		}
+	}
+	if v2 {
+		// The v2 envelope must carry the content and key wrapping
+		// algorithms used by the client.
+		if cekAlg, _ := cseLookup(metadata, cseCEKAlg); cekAlg == "" {
+			return errInvalidCSEMetadata
+		}
+		if wrpAlg, _ := cseLookup(metadata, cseWrpAlg); wrpAlg == "" {
+			return errInvalidCSEMetadata
+		}
+	}
+	return nil
+}
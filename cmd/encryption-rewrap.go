@@ -0,0 +1,179 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/pkg/v3/env"
+)
+
+// When the default SSE-KMS key of a bucket encryption configuration is
+// changed, existing objects still carry data keys sealed with the
+// previous KMS key. Reads keep working since the object metadata
+// records the sealing key, but the objects stay tied to the old key
+// until rotated. With auto rewrap enabled, reading such an object
+// queues a background metadata-only rotation that reseals its data key
+// with the bucket's current default key. An eager sweep remains
+// available via the keyrotate batch job.
+const (
+	sseAutoRewrapEnvVar  = "_MINIO_SSE_AUTO_REWRAP"
+	sseRewrapWorkers     = 4
+	sseRewrapQueueLength = 10000
+)
+
+func sseAutoRewrapEnabled() bool {
+	return env.Get(sseAutoRewrapEnvVar, config.EnableOn) == config.EnableOn
+}
+
+type sseRewrapTask struct {
+	bucket string
+	oi     ObjectInfo
+	keyID  string
+}
+
+// sseRewrapState holds the queue of objects whose sealed data keys are
+// pending a rewrap with their bucket's current default KMS key.
+type sseRewrapState struct {
+	once    sync.Once
+	tasksCh chan sseRewrapTask
+	pending sync.Map // "bucket/object/versionID" -> struct{}
+}
+
+var globalSSERewrapState = &sseRewrapState{
+	tasksCh: make(chan sseRewrapTask, sseRewrapQueueLength),
+}
+
+// queueSSEKeyRewrap queues a lazy rewrap of the object's sealed data
+// key if the bucket's default SSE-KMS key no longer matches the key
+// the object was encrypted with. The queue is best effort: tasks are
+// dropped when it is full and are re-queued by a later access.
+func queueSSEKeyRewrap(bucket string, oi ObjectInfo) {
+	if !sseAutoRewrapEnabled() {
+		return
+	}
+	if !crypto.S3KMS.IsEncrypted(oi.UserDefined) {
+		return
+	}
+	sseCfg, err := globalBucketSSEConfigSys.Get(bucket)
+	if err != nil || sseCfg == nil {
+		return
+	}
+	keyID := sseCfg.KeyID()
+	if keyID == "" {
+		return
+	}
+	if strings.TrimPrefix(oi.KMSKeyID(), crypto.ARNPrefix) == keyID {
+		return
+	}
+
+	s := globalSSERewrapState
+	taskKey := pathJoin(bucket, oi.Name, oi.VersionID)
+	if _, loaded := s.pending.LoadOrStore(taskKey, struct{}{}); loaded {
+		return
+	}
+	s.once.Do(func() {
+		for range sseRewrapWorkers {
+			go s.worker(GlobalContext)
+		}
+	})
+	select {
+	case s.tasksCh <- sseRewrapTask{bucket: bucket, oi: oi, keyID: keyID}:
+	default:
+		s.pending.Delete(taskKey)
+	}
+}
+
+func (s *sseRewrapState) worker(ctx context.Context) {
+	for {
+		select {
+		case task := <-s.tasksCh:
+			objectAPI := newObjectLayerFn()
+			if objectAPI != nil {
+				if err := rewrapObjectKey(ctx, objectAPI, task.bucket, task.oi, task.keyID); err != nil {
+					encLogIf(ctx, err)
+				}
+			}
+			s.pending.Delete(pathJoin(task.bucket, task.oi.Name, task.oi.VersionID))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// rewrapObjectKey reseals the object's data key with the given KMS key
+// via a metadata-only key rotation. The object data is not rewritten.
+func rewrapObjectKey(ctx context.Context, api ObjectLayer, bucket string, oi ObjectInfo, keyID string) error {
+	if oi.DeleteMarker || !oi.VersionPurgeStatus.Empty() {
+		return nil
+	}
+
+	lock := api.NewNSLock(bucket, oi.Name)
+	lkctx, err := lock.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return err
+	}
+	ctx = lkctx.Context()
+	defer lock.Unlock(lkctx)
+
+	opts := ObjectOptions{
+		VersionID:        oi.VersionID,
+		Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, oi.Name),
+		VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, oi.Name),
+		NoLock:           true,
+	}
+	obj, err := api.GetObjectInfo(ctx, bucket, oi.Name, opts)
+	if err != nil {
+		return err
+	}
+	cloned := obj.Clone()
+	if !crypto.S3KMS.IsEncrypted(cloned.UserDefined) {
+		return nil
+	}
+	if strings.TrimPrefix(cloned.KMSKeyID(), crypto.ARNPrefix) == keyID {
+		// Already rewrapped by a concurrent access.
+		return nil
+	}
+
+	encMetadata := make(map[string]string)
+	for k, v := range cloned.UserDefined {
+		if stringsHasPrefixFold(k, ReservedMetadataPrefixLower) {
+			encMetadata[k] = v
+		}
+	}
+	if err = rotateKey(ctx, []byte{}, keyID, []byte{}, bucket, cloned.Name, encMetadata, nil); err != nil {
+		return err
+	}
+
+	cloned.metadataOnly = true
+	cloned.keyRotation = true
+	for k, v := range encMetadata {
+		cloned.UserDefined[k] = v
+	}
+	_, err = api.CopyObject(ctx, bucket, cloned.Name, bucket, cloned.Name, cloned, ObjectOptions{
+		VersionID: cloned.VersionID,
+	}, ObjectOptions{
+		VersionID: cloned.VersionID,
+		NoLock:    true,
+	})
+	return err
+}
@@ -24,6 +24,7 @@ import (
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/subtle"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
@@ -1089,6 +1090,58 @@ func (o *ObjectInfo) metadataDecrypter(h http.Header) objectMetaDecryptFn {
 	}
 }
 
+// partChecksumBaseKey is the base key used to seal the plaintext
+// content checksums of encrypted object parts with the object key.
+const partChecksumBaseKey = "part-checksum"
+
+// sealPartChecksums seals the given part content checksums with the
+// object key. The returned map contains the base64-encoded ciphertext
+// of each checksum value.
+func sealPartChecksums(encryptFn objectMetaEncryptFn, checksums map[string]string) map[string]string {
+	if len(checksums) == 0 {
+		return checksums
+	}
+	sealed := make(map[string]string, len(checksums))
+	for k, v := range checksums {
+		sealed[k] = base64.StdEncoding.EncodeToString(encryptFn(partChecksumBaseKey, []byte(v)))
+	}
+	return sealed
+}
+
+// unsealPartChecksum reverses sealPartChecksums for a single checksum
+// value. The value is returned unmodified if it is not sealed, e.g. for
+// parts uploaded before checksum sealing, or if no object key is
+// available.
+func unsealPartChecksum(key []byte, value string) string {
+	if len(key) != 32 || value == "" {
+		return value
+	}
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return value
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(partChecksumBaseKey))
+	plain, err := sio.DecryptBuffer(nil, sealed, sio.Config{Key: mac.Sum(nil), CipherSuites: fips.DARECiphers()})
+	if err != nil {
+		return value
+	}
+	return string(plain)
+}
+
+// unsealPartChecksums reverses sealPartChecksums. It is a no-op if no
+// object key is available.
+func unsealPartChecksums(key []byte, checksums map[string]string) map[string]string {
+	if len(key) != 32 || len(checksums) == 0 {
+		return checksums
+	}
+	unsealed := make(map[string]string, len(checksums))
+	for k, v := range checksums {
+		unsealed[k] = unsealPartChecksum(key, v)
+	}
+	return unsealed
+}
+
 // decryptPartsChecksums will attempt to decode checksums and return it/them if set.
 // if part > 0, and we have the checksum for the part that will be returned.
 func (o *ObjectInfo) decryptPartsChecksums(h http.Header) {
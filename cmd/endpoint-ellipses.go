@@ -317,6 +317,12 @@ func GetAllSets(setDriveCount uint64, args ...string) ([][]string, error) {
 		}
 	}
 
+	// Spread drives across failure domains, if configured.
+	if fd := loadFailureDomains(); fd != nil {
+		setArgs = fd.rebalanceSets(setArgs)
+		fd.validateSets(setArgs)
+	}
+
 	return setArgs, nil
 }
 
@@ -72,6 +72,11 @@ type Endpoint struct {
 	*url.URL
 	IsLocal bool
 
+	// Weight is an optional per-drive capacity hint from a ",weight=N"
+	// annotation on the command line, 1 when not annotated. Pools with
+	// heavier drives fill proportionally to their weight.
+	Weight int
+
 	PoolIdx, SetIdx, DiskIdx int
 }
 
@@ -149,6 +154,12 @@ func NewEndpoint(arg string) (ep Endpoint, e error) {
 		return ep, fmt.Errorf("empty or root endpoint is not supported")
 	}
 
+	// Strip optional annotations, e.g. "https://host/disk1,weight=2".
+	arg, weight, err := parseEndpointAnnotations(arg)
+	if err != nil {
+		return ep, err
+	}
+
 	var isLocal bool
 	var host string
 	u, err := url.Parse(arg)
@@ -231,12 +242,46 @@ func NewEndpoint(arg string) (ep Endpoint, e error) {
 	return Endpoint{
 		URL:     u,
 		IsLocal: isLocal,
+		Weight:  weight,
 		PoolIdx: -1,
 		SetIdx:  -1,
 		DiskIdx: -1,
 	}, nil
 }
 
+// parseEndpointAnnotations strips optional ",key=value" annotations from
+// the end of an endpoint argument. The only supported annotation is
+// "weight=N", a per-drive capacity hint used by pool selection to fill
+// heterogeneous pools proportionally.
+func parseEndpointAnnotations(arg string) (string, int, error) {
+	weight := 1
+	for {
+		idx := strings.LastIndex(arg, ",")
+		if idx < 0 {
+			break
+		}
+		annotation := arg[idx+1:]
+		k, v, ok := strings.Cut(annotation, "=")
+		if !ok {
+			// Not an annotation, leave the argument untouched.
+			break
+		}
+		switch k {
+		case "weight":
+			w, err := strconv.Atoi(v)
+			if err != nil || w < 1 {
+				return arg, 0, fmt.Errorf("invalid annotation %q: weight expects a positive integer", annotation)
+			}
+			weight = w
+		default:
+			// Unknown key, assume it is part of the path.
+			return arg, weight, nil
+		}
+		arg = arg[:idx]
+	}
+	return arg, weight, nil
+}
+
 // PoolEndpoints represent endpoints in a given pool
 // along with its setCount and setDriveCount.
 type PoolEndpoints struct {
@@ -37,9 +37,13 @@ func TestNewEndpoint(t *testing.T) {
 		expectedType     EndpointType
 		expectedErr      error
 	}{
-		{"/foo", Endpoint{&url.URL{Path: rootSlashFoo}, true, -1, -1, -1}, PathEndpointType, nil},
-		{"https://example.org/path", Endpoint{u2, false, -1, -1, -1}, URLEndpointType, nil},
-		{"http://192.168.253.200/path", Endpoint{u4, false, -1, -1, -1}, URLEndpointType, nil},
+		{"/foo", Endpoint{&url.URL{Path: rootSlashFoo}, true, 1, -1, -1, -1}, PathEndpointType, nil},
+		{"https://example.org/path", Endpoint{u2, false, 1, -1, -1, -1}, URLEndpointType, nil},
+		{"http://192.168.253.200/path", Endpoint{u4, false, 1, -1, -1, -1}, URLEndpointType, nil},
+		{"/foo,weight=2", Endpoint{&url.URL{Path: rootSlashFoo}, true, 2, -1, -1, -1}, PathEndpointType, nil},
+		{"https://example.org/path,weight=4", Endpoint{u2, false, 4, -1, -1, -1}, URLEndpointType, nil},
+		{"/foo,weight=0", Endpoint{}, -1, fmt.Errorf(`invalid annotation "weight=0": weight expects a positive integer`)},
+		{"/foo,weight=x", Endpoint{}, -1, fmt.Errorf(`invalid annotation "weight=x": weight expects a positive integer`)},
 		{"", Endpoint{}, -1, fmt.Errorf("empty or root endpoint is not supported")},
 		{SlashSeparator, Endpoint{}, -1, fmt.Errorf("empty or root endpoint is not supported")},
 		{`\`, Endpoint{}, -1, fmt.Errorf("empty or root endpoint is not supported")},
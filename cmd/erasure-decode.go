@@ -24,6 +24,7 @@ import (
 	"io"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	xioutil "github.com/minio/minio/internal/ioutil"
 )
@@ -123,6 +124,12 @@ func (p *parallelReader) canDecode(buf [][]byte) bool {
 	return bufCount >= p.dataBlocks
 }
 
+// Hedged read counters, exposed via the drive metrics.
+var (
+	hedgedReadsTotal    atomic.Uint64 // stripe reads where a hedged shard read was issued
+	hedgedReadsWonTotal atomic.Uint64 // hedged reads where the extra shard completed the stripe
+)
+
 // Read reads from readers in parallel. Returns p.dataBlocks number of bufs.
 func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 	newBuf := dst
@@ -150,6 +157,21 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 		readTriggerCh <- true
 	}
 
+	// Hedged reads: when configured and spare shards are available, a
+	// single extra shard read is issued if the started reads have not
+	// produced a decodable stripe within the threshold. This cuts the
+	// tail latency caused by an occasional slow drive.
+	hedgeC := (<-chan time.Time)(nil)
+	if threshold := globalDriveConfig.GetHedgeThreshold(); threshold > 0 && p.dataBlocks < len(p.readers) {
+		hedgeTimer := time.NewTimer(threshold)
+		defer hedgeTimer.Stop()
+		hedgeC = hedgeTimer.C
+	}
+	pendingHedge := false
+	hedgedBuf := -1
+	launched := make([]io.ReaderAt, len(p.readers))
+	completed := make([]int32, len(p.readers)) // Atomic bool flags.
+
 	disksNotFound := int32(0)
 	bitrotHeal := int32(0)       // Atomic bool flag.
 	missingPartsHeal := int32(0) // Atomic bool flag.
@@ -158,7 +180,18 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 	// if readTrigger is true, it implies next disk.ReadAt() should be tried
 	// if readTrigger is false, it implies previous disk.ReadAt() was successful and there is no need
 	// to try reading the next disk.
-	for readTrigger := range readTriggerCh {
+	for {
+		var readTrigger bool
+		select {
+		case readTrigger = <-readTriggerCh:
+		case <-hedgeC:
+			// None of the started reads completed the stripe in time,
+			// hedge with one extra shard read.
+			hedgeC = nil
+			pendingHedge = true
+			hedgedReadsTotal.Add(1)
+			readTrigger = true
+		}
 		newBufLK.RLock()
 		canDecode := p.canDecode(newBuf)
 		newBufLK.RUnlock()
@@ -171,9 +204,15 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 		if !readTrigger {
 			continue
 		}
+		if pendingHedge && p.readers[readerIndex] != nil {
+			hedgedBuf = p.readerToBuf[readerIndex]
+			pendingHedge = false
+		}
+		launched[readerIndex] = p.readers[readerIndex]
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
+			defer atomic.StoreInt32(&completed[i], 1)
 			rr := p.readers[i]
 			if rr == nil {
 				// Since reader is nil, trigger another read.
@@ -219,6 +258,26 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 		}(readerIndex)
 		readerIndex++
 	}
+	if hedgedBuf >= 0 {
+		newBufLK.RLock()
+		hedgeWon := len(newBuf[hedgedBuf]) > 0 && p.canDecode(newBuf)
+		newBufLK.RUnlock()
+		if hedgeWon {
+			hedgedReadsWonTotal.Add(1)
+			// The hedged shard completed the stripe - cancel the reads
+			// still in flight so we do not wait on the slowest drive.
+			// Canceled readers fail on the next stripe and are replaced
+			// by spare shards there.
+			for i, rr := range launched {
+				if rr == nil || atomic.LoadInt32(&completed[i]) == 1 {
+					continue
+				}
+				if br, ok := rr.(io.Closer); ok {
+					br.Close()
+				}
+			}
+		}
+	}
 	wg.Wait()
 	if p.canDecode(newBuf) {
 		p.offset += p.shardSize
@@ -234,6 +293,110 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 	return nil, fmt.Errorf("%w (offline-disks=%d/%d)", errErasureReadQuorum, disksNotFound, len(p.readers))
 }
 
+// decodeRange is a fast path for small range reads. A range contained in
+// a single erasure block maps to a contiguous run of data shards, so when
+// all of the overlapping shards are readable directly there is no need to
+// read (or reconstruct) the full stripe. Each overlapping shard is still
+// read whole, since that is the bitrot checksum granularity. Returns false
+// when the range does not qualify or any of the required shards could not
+// be read, in which case the caller must fall back to the regular decode
+// path.
+func (e Erasure) decodeRange(ctx context.Context, writer io.Writer, readers []io.ReaderAt, offset, length, totalLength int64) (int64, error, bool) {
+	block := offset / e.blockSize
+	if (offset+length-1)/e.blockSize != block {
+		// Range spans multiple erasure blocks.
+		return 0, nil, false
+	}
+
+	shardSize := e.ShardSize()
+	stripeOffset := block * shardSize
+	// Shards of the last stripe are shorter than e.ShardSize().
+	stripeShardSize := shardSize
+	if fileSize := e.ShardFileSize(totalLength); stripeOffset+stripeShardSize > fileSize {
+		stripeShardSize = fileSize - stripeOffset
+	}
+	if stripeShardSize <= 0 {
+		return 0, nil, false
+	}
+
+	// The plain data of a block is the concatenation of its data shards.
+	blockOffset := offset % e.blockSize
+	firstShard := int(blockOffset / stripeShardSize)
+	lastShard := int((blockOffset + length - 1) / stripeShardSize)
+	if lastShard-firstShard+1 >= e.dataBlocks {
+		// No savings over reading the full stripe.
+		return 0, nil, false
+	}
+	for i := firstShard; i <= lastShard; i++ {
+		if readers[i] == nil {
+			return 0, nil, false
+		}
+	}
+
+	// Read the overlapping shards in parallel, whole - the bitrot
+	// checksum covers the entire shard.
+	shards := make([][]byte, lastShard-firstShard+1)
+	failedShards := make([]bool, lastShard-firstShard+1)
+	var wg sync.WaitGroup
+	for i := firstShard; i <= lastShard; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			buf := make([]byte, stripeShardSize)
+			n, err := readers[i].ReadAt(buf, stripeOffset)
+			if err != nil && !(errors.Is(err, io.EOF) && int64(n) == stripeShardSize) {
+				// io.ReaderAt permits io.EOF alongside a full read
+				// ending exactly at the end of the shard.
+				failedShards[i-firstShard] = true
+				return
+			}
+			shards[i-firstShard] = buf[:n]
+		}(i)
+	}
+	wg.Wait()
+
+	failed := false
+	for i := firstShard; i <= lastShard; i++ {
+		if !failedShards[i-firstShard] {
+			continue
+		}
+		// Drop only the readers that actually failed so the fallback
+		// path flags them for healing. The successfully read ones stay
+		// usable where the reader supports random access, nil'ing them
+		// too could push the fallback below read quorum.
+		if br, ok := readers[i].(io.Closer); ok {
+			br.Close()
+		}
+		readers[i] = nil
+		failed = true
+	}
+	if failed {
+		return 0, nil, false
+	}
+
+	// Write out the requested sub-range of the concatenated shards.
+	var written int64
+	start := blockOffset - int64(firstShard)*stripeShardSize
+	for _, shard := range shards {
+		n := int64(len(shard)) - start
+		if n > length-written {
+			n = length - written
+		}
+		if n <= 0 {
+			break
+		}
+		if _, err := writer.Write(shard[start : start+n]); err != nil {
+			return written, err, true
+		}
+		written += n
+		start = 0
+	}
+	if written != length {
+		return written, errLessData, true
+	}
+	return written, nil, true
+}
+
 // Decode reads from readers, reconstructs data if needed and writes the data to the writer.
 // A set of preferred drives can be supplied. In that case they will be used and the data reconstructed.
 func (e Erasure) Decode(ctx context.Context, writer io.Writer, readers []io.ReaderAt, offset, length, totalLength int64, prefer []bool) (written int64, derr error) {
@@ -248,6 +411,12 @@ func (e Erasure) Decode(ctx context.Context, writer io.Writer, readers []io.Read
 		return 0, nil
 	}
 
+	if length < e.blockSize {
+		if written, err, ok := e.decodeRange(ctx, writer, readers, offset, length, totalLength); ok {
+			return written, err
+		}
+	}
+
 	reader := newParallelReader(readers, e, offset, totalLength)
 	if len(prefer) == len(readers) {
 		reader.preferReaders(prefer)
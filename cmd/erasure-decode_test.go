@@ -26,6 +26,8 @@ import (
 	"testing"
 
 	"github.com/dustin/go-humanize"
+
+	"github.com/minio/minio/internal/bpool"
 )
 
 func (a badDisk) ReadFile(ctx context.Context, volume string, path string, offset int64, buf []byte, verifier *BitrotVerifier) (n int64, err error) {
@@ -194,6 +196,177 @@ func TestErasureDecode(t *testing.T) {
 	}
 }
 
+var erasureDecodeRangeTests = []struct {
+	dataBlocks, parityBlocks int
+	blocksize, data          int64
+	offset, length           int64
+	fastPath                 bool // whether the range qualifies for decodeRange
+}{
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 0, length: 100, fastPath: true},                // 0 - within the first data shard
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 1020, length: 10, fastPath: true},              // 1 - crosses a shard boundary within one block
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 4095, length: 2, fastPath: false},              // 2 - spans two erasure blocks
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 100, length: 3500, fastPath: false},            // 3 - touches all data shards, no savings
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 4096, length: 1024, fastPath: true},            // 4 - exactly one shard of the second block
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 8492, length: 400, fastPath: true},             // 5 - inside the shortened last stripe
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 9142, length: 50, fastPath: true},              // 6 - tail of the last shard, up to EOF
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 8192, length: 1000, fastPath: false},           // 7 - whole last block
+	{dataBlocks: 4, parityBlocks: 2, blocksize: 4096, data: 2*4096 + 1000, offset: 8092, length: 200, fastPath: false},            // 8 - spans into the last block
+	{dataBlocks: 2, parityBlocks: 2, blocksize: 8192, data: 8192, offset: 10, length: 100, fastPath: true},                        // 9 - single full-size block file
+	{dataBlocks: 2, parityBlocks: 2, blocksize: 8192, data: 8192, offset: 4000, length: 200, fastPath: false},                     // 10 - both data shards touched
+	{dataBlocks: 8, parityBlocks: 8, blocksize: int64(blockSizeV2), data: oneMiByte, offset: 4, length: 8 * 1024, fastPath: true}, // 11 - production geometry small read
+}
+
+// ensureTestBytePool initializes globalBytePoolCap when the test is run
+// in isolation, streaming bitrot writers take their ring buffers from it.
+func ensureTestBytePool() {
+	if globalBytePoolCap.Load() == nil {
+		globalBytePoolCap.Store(bpool.NewBytePoolCap(4, blockSizeV2, blockSizeV2*2))
+	}
+}
+
+// TestErasureDecodeRange exercises the decodeRange fast path directly -
+// both its qualification logic and the data it returns for qualifying
+// ranges - and verifies Decode serves identical content either way.
+func TestErasureDecodeRange(t *testing.T) {
+	ensureTestBytePool()
+	for i, test := range erasureDecodeRangeTests {
+		setup, err := newErasureTestSetup(t, test.dataBlocks, test.parityBlocks, test.blocksize)
+		if err != nil {
+			t.Fatalf("Test %d: failed to create test setup: %v", i, err)
+		}
+		erasure, err := NewErasure(context.Background(), test.dataBlocks, test.parityBlocks, test.blocksize)
+		if err != nil {
+			t.Fatalf("Test %d: failed to create ErasureStorage: %v", i, err)
+		}
+		disks := setup.disks
+		data := make([]byte, test.data)
+		if _, err = io.ReadFull(crand.Reader, data); err != nil {
+			t.Fatalf("Test %d: failed to generate random test data: %v", i, err)
+		}
+
+		buffer := make([]byte, test.blocksize, 2*test.blocksize)
+		writers := make([]io.Writer, len(disks))
+		for i, disk := range disks {
+			writers[i] = newBitrotWriter(disk, "", "testbucket", "object", erasure.ShardFileSize(test.data), DefaultBitrotAlgorithm, erasure.ShardSize())
+		}
+		n, err := erasure.Encode(context.Background(), bytes.NewReader(data), writers, buffer, erasure.dataBlocks+1)
+		closeBitrotWriters(writers)
+		if err != nil {
+			t.Fatalf("Test %d: failed to create erasure test file: %v", i, err)
+		}
+		if n != test.data {
+			t.Fatalf("Test %d: failed to create erasure test file", i)
+		}
+
+		newReaders := func() []io.ReaderAt {
+			bitrotReaders := make([]io.ReaderAt, len(disks))
+			for index, disk := range disks {
+				tillOffset := erasure.ShardFileOffset(test.offset, test.length, test.data)
+				bitrotReaders[index] = newBitrotReader(disk, nil, "testbucket", "object", tillOffset, DefaultBitrotAlgorithm, bitrotWriterSum(writers[index]), erasure.ShardSize())
+			}
+			return bitrotReaders
+		}
+
+		// Qualification and content of the fast path itself.
+		bitrotReaders := newReaders()
+		writer := bytes.NewBuffer(nil)
+		written, err, ok := erasure.decodeRange(context.Background(), writer, bitrotReaders, test.offset, test.length, test.data)
+		closeBitrotReaders(bitrotReaders)
+		if ok != test.fastPath {
+			t.Errorf("Test %d: decodeRange qualification is %v, expected %v", i, ok, test.fastPath)
+		}
+		if ok {
+			if err != nil {
+				t.Errorf("Test %d: decodeRange failed with: %v", i, err)
+			}
+			if written != test.length {
+				t.Errorf("Test %d: decodeRange wrote %d bytes, expected %d", i, written, test.length)
+			}
+			if !bytes.Equal(writer.Bytes(), data[test.offset:test.offset+test.length]) {
+				t.Errorf("Test %d: decodeRange returns wrong file content", i)
+			}
+		}
+
+		// Decode must serve identical content whichever path it takes.
+		bitrotReaders = newReaders()
+		writer.Reset()
+		if _, err = erasure.Decode(context.Background(), writer, bitrotReaders, test.offset, test.length, test.data, nil); err != nil {
+			t.Errorf("Test %d: Decode failed with: %v", i, err)
+		}
+		closeBitrotReaders(bitrotReaders)
+		if !bytes.Equal(writer.Bytes(), data[test.offset:test.offset+test.length]) {
+			t.Errorf("Test %d: Decode returns wrong file content", i)
+		}
+	}
+}
+
+// TestErasureDecodeRangeFallback verifies a failed shard read disqualifies
+// the fast path, drops the failed reader for healing and leaves the regular
+// decode path able to reconstruct the range from the remaining shards.
+func TestErasureDecodeRangeFallback(t *testing.T) {
+	ensureTestBytePool()
+	dataBlocks, parityBlocks := 4, 2
+	blocksize := int64(4096)
+	size := int64(2*4096 + 1000)
+	offset, length := int64(100), int64(200) // contained in the first data shard
+
+	setup, err := newErasureTestSetup(t, dataBlocks, parityBlocks, blocksize)
+	if err != nil {
+		t.Fatalf("failed to create test setup: %v", err)
+	}
+	erasure, err := NewErasure(context.Background(), dataBlocks, parityBlocks, blocksize)
+	if err != nil {
+		t.Fatalf("failed to create ErasureStorage: %v", err)
+	}
+	disks := setup.disks
+	data := make([]byte, size)
+	if _, err = io.ReadFull(crand.Reader, data); err != nil {
+		t.Fatalf("failed to generate random test data: %v", err)
+	}
+
+	buffer := make([]byte, blocksize, 2*blocksize)
+	writers := make([]io.Writer, len(disks))
+	for i, disk := range disks {
+		writers[i] = newBitrotWriter(disk, "", "testbucket", "object", erasure.ShardFileSize(size), DefaultBitrotAlgorithm, erasure.ShardSize())
+	}
+	if _, err = erasure.Encode(context.Background(), bytes.NewReader(data), writers, buffer, erasure.dataBlocks+1); err != nil {
+		t.Fatalf("failed to create erasure test file: %v", err)
+	}
+	closeBitrotWriters(writers)
+
+	bitrotReaders := make([]io.ReaderAt, len(disks))
+	for index, disk := range disks {
+		tillOffset := erasure.ShardFileOffset(offset, length, size)
+		bitrotReaders[index] = newBitrotReader(disk, nil, "testbucket", "object", tillOffset, DefaultBitrotAlgorithm, bitrotWriterSum(writers[index]), erasure.ShardSize())
+	}
+
+	// Fail the only shard the range overlaps.
+	switch r := bitrotReaders[0].(type) {
+	case *wholeBitrotReader:
+		r.disk = badDisk{nil}
+	case *streamingBitrotReader:
+		r.disk = badDisk{nil}
+	}
+
+	writer := bytes.NewBuffer(nil)
+	_, _, ok := erasure.decodeRange(context.Background(), writer, bitrotReaders, offset, length, size)
+	if ok {
+		t.Fatal("decodeRange should decline after a failed shard read")
+	}
+	if bitrotReaders[0] != nil {
+		t.Fatal("failed reader should have been dropped for the fallback")
+	}
+
+	// The regular path must reconstruct the range from the remaining shards.
+	if _, err = erasure.Decode(context.Background(), writer, bitrotReaders, offset, length, size, nil); err != nil {
+		t.Fatalf("Decode failed with: %v", err)
+	}
+	closeBitrotReaders(bitrotReaders)
+	if !bytes.Equal(writer.Bytes(), data[offset:offset+length]) {
+		t.Fatal("Decode returns wrong file content")
+	}
+}
+
 // Test erasureDecode with random offset and lengths.
 // This test is t.Skip()ed as it a long time to run, hence should be run
 // explicitly after commenting out t.Skip()
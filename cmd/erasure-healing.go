@@ -390,7 +390,8 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 	if !latestMeta.Deleted && !latestMeta.IsRemote() {
 		// Initialize erasure coding
 		erasure, err = NewErasure(ctx, latestMeta.Erasure.DataBlocks,
-			latestMeta.Erasure.ParityBlocks, latestMeta.Erasure.BlockSize)
+			latestMeta.Erasure.ParityBlocks, latestMeta.Erasure.BlockSize,
+			erasureOptionsFor(latestMeta.Erasure.Algorithm)...)
 		if err != nil {
 			return result, err
 		}
@@ -37,7 +37,10 @@ import (
 // Object was stored with additional erasure codes due to degraded system at upload time
 const minIOErasureUpgraded = "x-minio-internal-erasure-upgraded"
 
-const erasureAlgorithm = "rs-vandermonde"
+const (
+	erasureAlgorithm       = "rs-vandermonde"
+	erasureAlgorithmCauchy = "rs-cauchy"
+)
 
 // GetChecksumInfo - get checksum of a part.
 func (e ErasureInfo) GetChecksumInfo(partNumber int) (ckSum ChecksumInfo) {
@@ -23,12 +23,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/klauspost/readahead"
@@ -44,6 +47,11 @@ import (
 	"github.com/minio/sio"
 )
 
+// multipartObjectMetaKey records the multipart object path on the upload's
+// metadata at initiation time, the upload directory under minioMetaMultipartBucket
+// is a SHA of bucket/object and cannot be mapped back otherwise.
+const multipartObjectMetaKey = ReservedMetadataPrefixLower + "multipart-object"
+
 func (er erasureObjects) getUploadIDDir(bucket, object, uploadID string) string {
 	uploadUUID := uploadID
 	uploadBytes, err := base64.RawURLEncoding.DecodeString(uploadID)
@@ -191,15 +199,22 @@ func (er erasureObjects) cleanupStaleUploadsOnDisk(ctx context.Context, disk Sto
 				modTime = fi.ModTime
 				wait()
 			}
-			if time.Since(modTime) < globalAPIConfig.getStaleUploadsExpiry() {
-				return nil
+			if age := time.Since(modTime); age < globalAPIConfig.getStaleUploadsExpiry() {
+				// Uploads younger than the stale uploads expiry may still
+				// be aborted earlier by a lifecycle rule on their bucket.
+				if !er.lifecycleAbortsUpload(ctx, disk, uploadIDPath, age) {
+					return nil
+				}
 			}
 			w := xioutil.NewDeadlineWorker(globalDriveConfig.GetMaxTimeout())
 			return w.Run(func() error {
 				wait := deleteMultipartCleanupSleeper.Timer(ctx)
+				reclaimed := dirSize(pathJoin(drivePath, minioMetaMultipartBucket, uploadIDPath))
 				pathUUID := mustGetUUID()
 				targetPath := pathJoin(drivePath, minioMetaTmpDeletedBucket, pathUUID)
 				renameAll(pathJoin(drivePath, minioMetaMultipartBucket, uploadIDPath), targetPath, pathJoin(drivePath, minioMetaBucket))
+				globalStaleUploadsMetrics.removedUploads.Add(1)
+				globalStaleUploadsMetrics.reclaimedBytes.Add(uint64(reclaimed))
 				wait()
 				return nil
 			})
@@ -251,6 +266,56 @@ func (er erasureObjects) cleanupStaleUploadsOnDisk(ctx context.Context, disk Sto
 	})
 }
 
+// staleUploadsMetrics counts multipart uploads removed by the stale uploads
+// cleanup on local drives along with the on-disk space reclaimed.
+type staleUploadsMetrics struct {
+	removedUploads atomic.Uint64
+	reclaimedBytes atomic.Uint64
+}
+
+var globalStaleUploadsMetrics staleUploadsMetrics
+
+// dirSize returns the aggregate size of all regular files below dirPath,
+// errors are ignored - used for metrics accounting only.
+func dirSize(dirPath string) (size int64) {
+	filepath.WalkDir(dirPath, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if fi, err := d.Info(); err == nil {
+			size += fi.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// lifecycleAbortsUpload returns true if a lifecycle AbortIncompleteMultipartUpload
+// rule configured on the upload's bucket expires the upload earlier than the
+// global stale uploads expiry. The upload's bucket and object are recovered from
+// the metadata recorded at initiation time.
+func (er erasureObjects) lifecycleAbortsUpload(ctx context.Context, disk StorageAPI, uploadIDPath string, age time.Duration) bool {
+	if age < 24*time.Hour {
+		// DaysAfterInitiation is at least one day.
+		return false
+	}
+	fi, err := disk.ReadVersion(ctx, "", minioMetaMultipartBucket, uploadIDPath, "", ReadOptions{})
+	if err != nil {
+		return false
+	}
+	bucket, object := path2BucketObject(fi.Metadata[multipartObjectMetaKey])
+	if bucket == "" || object == "" {
+		// Upload predates recording of the object path.
+		return false
+	}
+	lc, err := globalLifecycleSys.Get(bucket)
+	if err != nil {
+		return false
+	}
+	days := lc.AbortIncompleteMultipartUploadDays(object)
+	return days > 0 && age > time.Duration(days)*24*time.Hour
+}
+
 // ListMultipartUploads - lists all the pending multipart
 // uploads for a particular object in a bucket.
 //
@@ -403,6 +468,20 @@ func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string,
 
 	// Get parity and data drive count based on storage class metadata
 	parityDrives := globalStorageClass.GetParityForSC(userDefined[xhttp.AmzStorageClass])
+	switch userDefined[xhttp.AmzStorageClass] {
+	case "":
+		// No storage class on the request, a bucket level default
+		// storage class overrides the global default.
+		if p := bucketDefaultParity(bucket); p >= 0 && p <= len(onlineDisks)/2 {
+			parityDrives = p
+		}
+	case storageclass.AUTO:
+		// The total object size is not known when a multipart upload
+		// is initiated and the parity has to be fixed up front,
+		// multipart uploads are used for large objects so they get
+		// the large object parity.
+		parityDrives = globalStorageClass.GetParityForAuto(-1)
+	}
 	if parityDrives < 0 {
 		parityDrives = er.defaultParityCount
 	}
@@ -483,6 +562,10 @@ func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string,
 		userDefined[hash.MinIOMultipartChecksumType] = opts.WantChecksum.Type.ObjType()
 	}
 
+	// Remember the object this upload belongs to, cleanup relies on it to
+	// apply lifecycle AbortIncompleteMultipartUpload rules.
+	userDefined[multipartObjectMetaKey] = pathJoin(bucket, object)
+
 	modTime := opts.MTime
 	if opts.MTime.IsZero() {
 		modTime = UTCNow()
@@ -618,7 +701,7 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		}
 	}()
 
-	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize, erasureOptionsFor(fi.Erasure.Algorithm)...)
 	if err != nil {
 		return pi, toObjectErr(err, bucket, object)
 	}
@@ -730,7 +813,15 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		Checksums:  r.ContentCRC(),
 	}
 
-	partFI, err := partInfo.MarshalMsg(nil)
+	// Seal plaintext content checksums with the object key before they
+	// are persisted alongside the encrypted part. The response below
+	// keeps the plaintext checksums.
+	storedPartInfo := partInfo
+	if opts.EncryptFn != nil {
+		storedPartInfo.Checksums = sealPartChecksums(opts.EncryptFn, partInfo.Checksums)
+	}
+
+	partFI, err := storedPartInfo.MarshalMsg(nil)
 	if err != nil {
 		return pi, toObjectErr(err, minioMetaMultipartBucket, partPath)
 	}
@@ -1203,6 +1294,10 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 			}
 		}
 
+		// Part checksums of encrypted objects are stored sealed - unseal
+		// them for the validation and consolidation below.
+		part.Checksums = unsealPartChecksums(objectEncryptionKey, part.Checksums)
+
 		// Add the current part.
 		fi.AddObjectPart(part.Number, part.ETag, part.Size, part.ActualSize, part.ModTime, part.Index, part.Checksums)
 	}
@@ -1355,6 +1450,7 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 	// Remove superfluous internal headers.
 	delete(fi.Metadata, hash.MinIOMultipartChecksum)
 	delete(fi.Metadata, hash.MinIOMultipartChecksumType)
+	delete(fi.Metadata, multipartObjectMetaKey)
 
 	// Save the final object size and modtime.
 	fi.Size = objectSize
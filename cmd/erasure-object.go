@@ -275,6 +275,9 @@ func (er erasureObjects) GetObjectNInfo(ctx context.Context, bucket, object stri
 		if err != nil {
 			return nil, err
 		}
+		// Opportunistically warm a restored copy of this object back on
+		// to local drives for subsequent reads, if enabled.
+		globalTierReadCache.warm(er, er.poolIndex, bucket, object, objInfo)
 		unlockOnDefer = false
 		return gr.WithCleanupFuncs(nsUnlocker), nil
 	}
@@ -340,7 +343,7 @@ func (er erasureObjects) getObjectWithFileInfo(ctx context.Context, bucket, obje
 	}
 
 	var totalBytesRead int64
-	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize, erasureOptionsFor(fi.Erasure.Algorithm)...)
 	if err != nil {
 		return toObjectErr(err, bucket, object)
 	}
@@ -386,6 +389,27 @@ func (er erasureObjects) getObjectWithFileInfo(ctx context.Context, bucket, obje
 			prefer[index] = disk.Hostname() == ""
 		}
 
+		// Deprioritize consistently slow drives - move them to the end of
+		// the read sequence so they only serve reads when the remaining
+		// drives cannot satisfy the read.
+		if slowThreshold := globalDriveConfig.GetSlowThreshold(); slowThreshold > 0 {
+			var hasSlow bool
+			slow := make([]bool, len(onlineDisks))
+			for index, disk := range onlineDisks {
+				if readers[index] == nil {
+					continue
+				}
+				if sd, ok := disk.(*xlStorageDiskIDCheck); ok && sd.isSlow(slowThreshold) {
+					slow[index], hasSlow = true, true
+				}
+			}
+			if hasSlow {
+				for index := range prefer {
+					prefer[index] = readers[index] != nil && !slow[index]
+				}
+			}
+		}
+
 		written, err := erasure.Decode(ctx, writer, readers, partOffset, partLength, partSize, prefer)
 		// Note: we should not be defer'ing the following closeBitrotReaders() call as
 		// we are inside a for loop i.e if we use defer, we would accumulate a lot of open files by the time
@@ -985,6 +1009,90 @@ func (er erasureObjects) getObjectInfo(ctx context.Context, bucket, object strin
 	return objInfo, nil
 }
 
+// GetObjectsInfo stats multiple objects of this set with a single storage
+// call per disk, trimming the per object RPC overhead of large multi-object
+// deletes and replication status reads. Reads are lock free and no inline
+// heal is attempted, per entry results follow getObjectInfo semantics.
+func (er erasureObjects) GetObjectsInfo(ctx context.Context, bucket string, objects []ObjectV, opts ObjectOptions) ([]ObjectInfo, []error) {
+	disks := er.getDisks()
+
+	req := ReadVersionsReq{
+		Paths:      make([]string, len(objects)),
+		VersionIDs: make([]string, len(objects)),
+	}
+	for i, object := range objects {
+		req.Paths[i] = object.ObjectName
+		req.VersionIDs[i] = object.VersionID
+	}
+
+	results := make([][]ReadVersionResult, len(disks))
+	derrs := make([]error, len(disks))
+
+	g := errgroup.WithNErrs(len(disks))
+	for index := range disks {
+		index := index
+		g.Go(func() error {
+			if disks[index] == nil {
+				derrs[index] = errDiskNotFound
+				return nil
+			}
+			results[index], derrs[index] = disks[index].ReadVersions(ctx, bucket, req)
+			return nil
+		}, index)
+	}
+	g.Wait()
+
+	objInfos := make([]ObjectInfo, len(objects))
+	errs := make([]error, len(objects))
+	for idx, object := range objects {
+		metaArr := make([]FileInfo, len(disks))
+		errsArr := make([]error, len(disks))
+		for d := range disks {
+			switch {
+			case derrs[d] != nil:
+				errsArr[d] = derrs[d]
+			case len(results[d]) != len(objects):
+				errsArr[d] = errUnexpected
+			case results[d][idx].Error != "":
+				errsArr[d] = toStorageErr(errors.New(results[d][idx].Error))
+			default:
+				metaArr[d] = results[d][idx].FileInfo
+			}
+		}
+
+		readQuorum, _, err := objectQuorumFromMeta(ctx, metaArr, errsArr, er.defaultParityCount)
+		if err != nil {
+			errs[idx] = toObjectErr(err, bucket, object.ObjectName)
+			continue
+		}
+		if err = reduceReadQuorumErrs(ctx, errsArr, objectOpIgnoredErrs, readQuorum); err != nil {
+			errs[idx] = toObjectErr(err, bucket, object.ObjectName)
+			continue
+		}
+		_, modTime, etag := listOnlineDisks(disks, metaArr, errsArr, readQuorum)
+		fi, err := pickValidFileInfo(ctx, metaArr, modTime, etag, readQuorum)
+		if err != nil {
+			errs[idx] = toObjectErr(err, bucket, object.ObjectName)
+			continue
+		}
+
+		versioned := opts.Versioned || opts.VersionSuspended
+		if opts.PrefixEnabledFn != nil {
+			versioned = opts.PrefixEnabledFn(object.ObjectName) || opts.VersionSuspended
+		}
+		objInfos[idx] = fi.ToObjectInfo(bucket, object.ObjectName, versioned)
+		if fi.Deleted {
+			if object.VersionID == "" || opts.DeleteMarker {
+				errs[idx] = toObjectErr(errFileNotFound, bucket, object.ObjectName)
+			} else {
+				// Make sure to return object info to provide extra information.
+				errs[idx] = toObjectErr(errMethodNotAllowed, bucket, object.ObjectName)
+			}
+		}
+	}
+	return objInfos, errs
+}
+
 // getObjectInfoAndQuorum - wrapper for reading object metadata and constructs ObjectInfo, additionally returns write quorum for the object.
 func (er erasureObjects) getObjectInfoAndQuorum(ctx context.Context, bucket, object string, opts ObjectOptions) (objInfo ObjectInfo, wquorum int, err error) {
 	fi, _, _, err := er.getObjectFileInfo(ctx, bucket, object, opts, false)
@@ -1141,7 +1249,7 @@ func (er erasureObjects) putMetacacheObject(ctx context.Context, key string, r *
 	var onlineDisks []StorageAPI
 	onlineDisks, partsMetadata = shuffleDisksAndPartsMetadata(storageDisks, partsMetadata, fi)
 
-	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize, erasureOptionsFor(fi.Erasure.Algorithm)...)
 	if err != nil {
 		return ObjectInfo{}, toObjectErr(err, minioMetaBucket, key)
 	}
@@ -1288,6 +1396,17 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 
 	// Get parity and data drive count based on storage class metadata
 	parityDrives := globalStorageClass.GetParityForSC(userDefined[xhttp.AmzStorageClass])
+	switch userDefined[xhttp.AmzStorageClass] {
+	case "":
+		// No storage class on the request, a bucket level default
+		// storage class overrides the global default.
+		if p := bucketDefaultParity(bucket); p >= 0 && p <= len(storageDisks)/2 {
+			parityDrives = p
+		}
+	case storageclass.AUTO:
+		// AUTO picks the parity from the object size.
+		parityDrives = globalStorageClass.GetParityForAuto(data.Size())
+	}
 	if parityDrives < 0 {
 		parityDrives = er.defaultParityCount
 	}
@@ -1359,7 +1478,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 	var onlineDisks []StorageAPI
 	onlineDisks, partsMetadata = shuffleDisksAndPartsMetadata(storageDisks, partsMetadata, fi)
 
-	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize, erasureOptionsFor(fi.Erasure.Algorithm)...)
 	if err != nil {
 		return ObjectInfo{}, toObjectErr(err, bucket, object)
 	}
@@ -2489,15 +2608,22 @@ func (er erasureObjects) restoreTransitionedObject(ctx context.Context, bucket s
 	}
 
 	oi = actualfi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended)
+
+	// Archived data on the remote tier must be rehydrated before its
+	// contents can be read back, the restore status header stays "ongoing"
+	// until rehydration completes.
+	if err := rehydrateTransitionedObject(ctx, oi); err != nil {
+		return setRestoreHeaderFn(oi, toObjectErr(err, bucket, object))
+	}
+
 	ropts := putRestoreOpts(bucket, object, opts.Transition.RestoreRequest, oi)
 	if len(oi.Parts) == 1 {
-		var rs *HTTPRangeSpec
-		gr, err := getTransitionedObjectReader(ctx, bucket, object, rs, http.Header{}, oi, opts)
+		gr, size, err := getRestoreObjectReader(ctx, bucket, object, oi, opts)
 		if err != nil {
 			return setRestoreHeaderFn(oi, toObjectErr(err, bucket, object))
 		}
 		defer gr.Close()
-		hashReader, err := hash.NewReader(ctx, gr, gr.ObjInfo.Size, "", "", gr.ObjInfo.Size)
+		hashReader, err := hash.NewReader(ctx, gr, size, "", "", size)
 		if err != nil {
 			return setRestoreHeaderFn(oi, toObjectErr(err, bucket, object))
 		}
@@ -2512,9 +2638,8 @@ func (er erasureObjects) restoreTransitionedObject(ctx context.Context, bucket s
 	}
 
 	var uploadedParts []CompletePart
-	var rs *HTTPRangeSpec
 	// get reader from the warm backend - note that even in the case of encrypted objects, this stream is still encrypted.
-	gr, err := getTransitionedObjectReader(ctx, bucket, object, rs, http.Header{}, oi, opts)
+	gr, _, err := getRestoreObjectReader(ctx, bucket, object, oi, opts)
 	if err != nil {
 		return setRestoreHeaderFn(oi, err)
 	}
@@ -922,6 +922,20 @@ func (z *erasureServerPools) decommissionPool(ctx context.Context, idx int, pool
 						}
 						break
 					}
+					// Attempt a direct pool-to-pool handoff of the erasure
+					// shards first, this avoids decoding and re-encoding the
+					// object when the destination pool shares the same set
+					// layout. Any failure falls through to the regular copy
+					// via GET+PUT below.
+					if err := z.poolMigrateObject(ctx, idx, bi.Name, version); err == nil {
+						stopFn(version.Size, nil)
+						failure = false
+						break
+					} else if isErrObjectNotFound(err) || isErrVersionNotFound(err) || isDataMovementOverWriteErr(err) {
+						ignore = true
+						stopFn(0, nil)
+						break
+					}
 					gr, err := set.GetObjectNInfo(ctx,
 						bi.Name,
 						encodeDirObject(version.Name),
@@ -0,0 +1,146 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// errPoolMigrateLayoutMismatch is returned when the source and destination
+// pools do not share the same erasure set layout, callers are expected to
+// fall back to copying the object through the object layer.
+var errPoolMigrateLayoutMismatch = errors.New("source and destination pool layouts do not match")
+
+// poolMigrateObject hands off a single object version between pools by
+// streaming its erasure shards verbatim from the source set drives to the
+// destination set drives, this avoids decoding and re-encoding the object
+// through the object layer during decommission. The fast path is only
+// possible when the destination pool shares the same erasure set drive
+// count as the source, otherwise errPoolMigrateLayoutMismatch is returned
+// and the caller must copy via GET+PUT instead.
+func (z *erasureServerPools) poolMigrateObject(ctx context.Context, srcIdx int, bucket string, version FileInfo) (err error) {
+	object := encodeDirObject(version.Name)
+
+	defer func() {
+		if err != errPoolMigrateLayoutMismatch {
+			auditLogDecom(ctx, "DecomMigrateData", bucket, version.Name, version.VersionID, err)
+		}
+	}()
+
+	// Pick the destination pool the same way regular data movement does.
+	idx, err := z.getPoolIdx(ctx, bucket, object, version.Size)
+	if err != nil {
+		return err
+	}
+	if idx == srcIdx {
+		return DataMovementOverwriteErr{
+			Bucket:    bucket,
+			Object:    version.Name,
+			VersionID: version.VersionID,
+			Err:       errDataMovementSrcDstPoolSame,
+		}
+	}
+
+	srcSet := z.serverPools[srcIdx].getHashedSet(object)
+	dstSet := z.serverPools[idx].getHashedSet(object)
+	if srcSet.setDriveCount != dstSet.setDriveCount {
+		return errPoolMigrateLayoutMismatch
+	}
+
+	versionID := version.VersionID
+	if versionID == "" {
+		versionID = nullVersionID
+	}
+
+	fi, metaArr, onlineDisks, err := srcSet.getObjectFileInfo(ctx, bucket, object, ObjectOptions{
+		VersionID: versionID,
+		NoLock:    true,
+	}, true)
+	if err != nil {
+		return toObjectErr(err, bucket, version.Name, version.VersionID)
+	}
+	if fi.Deleted || fi.IsRemote() || fi.XLV1 {
+		// Delete markers, transitioned objects and legacy format
+		// objects do not have shards that can be streamed verbatim.
+		return errPoolMigrateLayoutMismatch
+	}
+
+	// All shards must be readable from the source to stream them
+	// verbatim, degraded objects take the regular copy path which
+	// can reconstruct missing shards.
+	for i := range metaArr {
+		if onlineDisks[i] == nil || !metaArr[i].IsValid() {
+			return errPoolMigrateLayoutMismatch
+		}
+	}
+
+	dstDisks := dstSet.getDisks()
+	for _, disk := range dstDisks {
+		if disk == nil {
+			return errPoolMigrateLayoutMismatch
+		}
+	}
+
+	tmpID := mustGetUUID()
+	defer func() {
+		// Best effort cleanup of temporary content on the destination.
+		for _, disk := range dstDisks {
+			if disk != nil {
+				disk.Delete(ctx, minioMetaTmpBucket, tmpID, DeleteOptions{Recursive: true})
+			}
+		}
+	}()
+
+	inlineData := fi.InlineData()
+	for i := range metaArr {
+		srcDisk, srcFI := onlineDisks[i], metaArr[i]
+		if !inlineData {
+			for _, part := range srcFI.Parts {
+				shardPath := pathJoin(object, srcFI.DataDir, fmt.Sprintf("part.%d", part.Number))
+				stats, err := srcDisk.StatInfoFile(ctx, bucket, shardPath, false)
+				if err != nil || len(stats) == 0 {
+					return toObjectErr(err, bucket, version.Name, version.VersionID)
+				}
+				rc, err := srcDisk.ReadFileStream(ctx, bucket, shardPath, 0, stats[0].Size)
+				if err != nil {
+					return toObjectErr(err, bucket, version.Name, version.VersionID)
+				}
+				err = dstDisks[i].CreateFile(ctx, "", minioMetaTmpBucket,
+					pathJoin(tmpID, srcFI.DataDir, fmt.Sprintf("part.%d", part.Number)), stats[0].Size, rc)
+				rc.Close()
+				if err != nil {
+					return toObjectErr(err, bucket, version.Name, version.VersionID)
+				}
+			}
+		}
+		if _, err = dstDisks[i].RenameData(ctx, minioMetaTmpBucket, tmpID, srcFI, bucket, object, RenameOptions{}); err != nil {
+			// Undo any shards committed to the destination so far, a
+			// partially written version must not be left visible.
+			dstSet.DeleteObject(ctx, bucket, object, ObjectOptions{
+				VersionID:  fi.VersionID,
+				NoLock:     true,
+				NoAuditLog: true,
+			})
+			return toObjectErr(err, bucket, version.Name, version.VersionID)
+		}
+	}
+
+	return nil
+}
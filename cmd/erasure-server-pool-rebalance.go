@@ -51,6 +51,11 @@ type rebalanceStats struct {
 	InitFreeSpace uint64 `json:"initFreeSpace" msg:"ifs"` // Pool free space at the start of rebalance
 	InitCapacity  uint64 `json:"initCapacity" msg:"ic"`   // Pool capacity at the start of rebalance
 
+	// Per-pool free space goal from a target utilization watermark given
+	// at rebalance-start, 0 means the computed global goal applies. The
+	// pool only rebalances enough data to reach its goal.
+	TargetFreeGoal float64 `json:"targetFreeGoal" msg:"tfg"`
+
 	Buckets           []string      `json:"buckets" msg:"bus"`           // buckets being rebalanced or to be rebalanced
 	RebalancedBuckets []string      `json:"rebalancedBuckets" msg:"rbs"` // buckets rebalanced
 	Bucket            string        `json:"bucket" msg:"bu"`             // Last rebalanced bucket
@@ -100,10 +105,11 @@ type rebalanceInfo struct {
 type rebalanceMeta struct {
 	cancel          context.CancelFunc `msg:"-"` // to be invoked on rebalance-stop
 	lastRefreshedAt time.Time          `msg:"-"`
-	StoppedAt       time.Time          `msg:"stopTs"` // Time when rebalance-stop was issued.
-	ID              string             `msg:"id"`     // ID of the ongoing rebalance operation
-	PercentFreeGoal float64            `msg:"pf"`     // Computed from total free space and capacity at the start of rebalance
-	PoolStats       []*rebalanceStats  `msg:"rss"`    // Per-pool rebalance stats keyed by pool index
+	StoppedAt       time.Time          `msg:"stopTs"`  // Time when rebalance-stop was issued.
+	PausedAt        time.Time          `msg:"pauseTs"` // Time when rebalance-pause was issued, zero when not paused.
+	ID              string             `msg:"id"`      // ID of the ongoing rebalance operation
+	PercentFreeGoal float64            `msg:"pf"`      // Computed from total free space and capacity at the start of rebalance
+	PoolStats       []*rebalanceStats  `msg:"rss"`     // Per-pool rebalance stats keyed by pool index
 }
 
 var errRebalanceNotStarted = errors.New("rebalance not started")
@@ -158,8 +164,11 @@ func (z *erasureServerPools) findIndex(index int) int {
 }
 
 // initRebalanceMeta initializes rebalance metadata for a new rebalance
-// operation and saves it in the object store.
-func (z *erasureServerPools) initRebalanceMeta(ctx context.Context, buckets []string) (arn string, err error) {
+// operation and saves it in the object store. watermarks optionally carries
+// per-pool target utilization percentages, pools with a watermark only
+// rebalance enough data to reach it; a nil slice or 0 entry falls back to
+// the computed global free space goal.
+func (z *erasureServerPools) initRebalanceMeta(ctx context.Context, buckets []string, watermarks []float64) (arn string, err error) {
 	r := &rebalanceMeta{
 		ID:        shortuuid.New(),
 		PoolStats: make([]*rebalanceStats, len(z.serverPools)),
@@ -196,7 +205,12 @@ func (z *erasureServerPools) initRebalanceMeta(ctx context.Context, buckets []st
 		}
 		copy(r.PoolStats[idx].Buckets, buckets)
 
-		if pfi := float64(diskStats[idx].AvailableSpace) / float64(diskStats[idx].TotalSpace); pfi < r.PercentFreeGoal {
+		goal := r.PercentFreeGoal
+		if idx < len(watermarks) && watermarks[idx] > 0 {
+			goal = 1 - watermarks[idx]/100
+			r.PoolStats[idx].TargetFreeGoal = goal
+		}
+		if pfi := float64(diskStats[idx].AvailableSpace) / float64(diskStats[idx].TotalSpace); pfi < goal {
 			r.PoolStats[idx].Participating = true
 			r.PoolStats[idx].Info = rebalanceInfo{
 				StartTime: now,
@@ -360,6 +374,19 @@ func (z *erasureServerPools) IsRebalanceStarted() bool {
 	return false
 }
 
+// IsRebalancePaused returns true if an ongoing rebalance operation is
+// currently paused.
+func (z *erasureServerPools) IsRebalancePaused() bool {
+	z.rebalMu.RLock()
+	defer z.rebalMu.RUnlock()
+
+	r := z.rebalMeta
+	if r == nil {
+		return false
+	}
+	return r.StoppedAt.IsZero() && !r.PausedAt.IsZero()
+}
+
 func (z *erasureServerPools) IsPoolRebalancing(poolIndex int) bool {
 	z.rebalMu.RLock()
 	defer z.rebalMu.RUnlock()
@@ -484,6 +511,16 @@ func (z *erasureServerPools) checkIfRebalanceDone(poolIdx int) bool {
 	}
 
 	pfi := float64(poolStats.InitFreeSpace+poolStats.Bytes) / float64(poolStats.InitCapacity)
+	if poolStats.TargetFreeGoal > 0 {
+		// A per-pool target watermark was given, stop as soon as enough
+		// data has moved to satisfy it.
+		if pfi >= poolStats.TargetFreeGoal {
+			r.PoolStats[poolIdx].Info.Status = rebalCompleted
+			r.PoolStats[poolIdx].Info.EndTime = time.Now()
+			return true
+		}
+		return false
+	}
 	// Mark pool rebalance as done if within 5% from PercentFreeGoal.
 	if diff := math.Abs(pfi - r.PercentFreeGoal); diff <= 0.05 {
 		r.PoolStats[poolIdx].Info.Status = rebalCompleted
@@ -789,6 +826,8 @@ type rebalSaveOpts uint8
 const (
 	rebalSaveStats rebalSaveOpts = iota
 	rebalSaveStoppedAt
+	rebalSavePausedAt
+	rebalSaveResumedAt
 )
 
 func (z *erasureServerPools) saveRebalanceStats(ctx context.Context, poolIdx int, opts rebalSaveOpts) error {
@@ -813,6 +852,10 @@ func (z *erasureServerPools) saveRebalanceStats(ctx context.Context, poolIdx int
 	switch opts {
 	case rebalSaveStoppedAt:
 		r.StoppedAt = time.Now()
+	case rebalSavePausedAt:
+		r.PausedAt = time.Now()
+	case rebalSaveResumedAt:
+		r.PausedAt = time.Time{}
 	case rebalSaveStats:
 		if z.rebalMeta != nil {
 			r.PoolStats[poolIdx] = z.rebalMeta.PoolStats[poolIdx]
@@ -928,7 +971,7 @@ func (z *erasureServerPools) rebalanceObject(ctx context.Context, poolIdx int, b
 
 func (z *erasureServerPools) StartRebalance() {
 	z.rebalMu.Lock()
-	if z.rebalMeta == nil || !z.rebalMeta.StoppedAt.IsZero() { // rebalance not running, nothing to do
+	if z.rebalMeta == nil || !z.rebalMeta.StoppedAt.IsZero() || !z.rebalMeta.PausedAt.IsZero() { // rebalance not running or paused, nothing to do
 		z.rebalMu.Unlock()
 		return
 	}
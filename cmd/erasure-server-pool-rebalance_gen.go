@@ -295,6 +295,12 @@ func (z *rebalanceMeta) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "StoppedAt")
 				return
 			}
+		case "pauseTs":
+			z.PausedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "PausedAt")
+				return
+			}
 		case "id":
 			z.ID, err = dc.ReadString()
 			if err != nil {
@@ -351,9 +357,9 @@ func (z *rebalanceMeta) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *rebalanceMeta) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 4
+	// map header, size 5
 	// write "stopTs"
-	err = en.Append(0x84, 0xa6, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x73)
+	err = en.Append(0x85, 0xa6, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x73)
 	if err != nil {
 		return
 	}
@@ -362,6 +368,16 @@ func (z *rebalanceMeta) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "StoppedAt")
 		return
 	}
+	// write "pauseTs"
+	err = en.Append(0xa7, 0x70, 0x61, 0x75, 0x73, 0x65, 0x54, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.PausedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "PausedAt")
+		return
+	}
 	// write "id"
 	err = en.Append(0xa2, 0x69, 0x64)
 	if err != nil {
@@ -412,10 +428,13 @@ func (z *rebalanceMeta) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *rebalanceMeta) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 4
+	// map header, size 5
 	// string "stopTs"
-	o = append(o, 0x84, 0xa6, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x73)
+	o = append(o, 0x85, 0xa6, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x73)
 	o = msgp.AppendTime(o, z.StoppedAt)
+	// string "pauseTs"
+	o = append(o, 0xa7, 0x70, 0x61, 0x75, 0x73, 0x65, 0x54, 0x73)
+	o = msgp.AppendTime(o, z.PausedAt)
 	// string "id"
 	o = append(o, 0xa2, 0x69, 0x64)
 	o = msgp.AppendString(o, z.ID)
@@ -463,6 +482,12 @@ func (z *rebalanceMeta) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "StoppedAt")
 				return
 			}
+		case "pauseTs":
+			z.PausedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PausedAt")
+				return
+			}
 		case "id":
 			z.ID, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
@@ -519,7 +544,7 @@ func (z *rebalanceMeta) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *rebalanceMeta) Msgsize() (s int) {
-	s = 1 + 7 + msgp.TimeSize + 3 + msgp.StringPrefixSize + len(z.ID) + 3 + msgp.Float64Size + 4 + msgp.ArrayHeaderSize
+	s = 1 + 7 + msgp.TimeSize + 8 + msgp.TimeSize + 3 + msgp.StringPrefixSize + len(z.ID) + 3 + msgp.Float64Size + 4 + msgp.ArrayHeaderSize
 	for za0001 := range z.PoolStats {
 		if z.PoolStats[za0001] == nil {
 			s += msgp.NilSize
@@ -697,6 +722,12 @@ func (z *rebalanceStats) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "InitCapacity")
 				return
 			}
+		case "tfg":
+			z.TargetFreeGoal, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "TargetFreeGoal")
+				return
+			}
 		case "bus":
 			var zb0002 uint32
 			zb0002, err = dc.ReadArrayHeader()
@@ -790,9 +821,9 @@ func (z *rebalanceStats) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *rebalanceStats) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 11
+	// map header, size 12
 	// write "ifs"
-	err = en.Append(0x8b, 0xa3, 0x69, 0x66, 0x73)
+	err = en.Append(0x8c, 0xa3, 0x69, 0x66, 0x73)
 	if err != nil {
 		return
 	}
@@ -811,6 +842,16 @@ func (z *rebalanceStats) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "InitCapacity")
 		return
 	}
+	// write "tfg"
+	err = en.Append(0xa3, 0x74, 0x66, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.TargetFreeGoal)
+	if err != nil {
+		err = msgp.WrapError(err, "TargetFreeGoal")
+		return
+	}
 	// write "bus"
 	err = en.Append(0xa3, 0x62, 0x75, 0x73)
 	if err != nil {
@@ -921,13 +962,16 @@ func (z *rebalanceStats) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *rebalanceStats) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 11
+	// map header, size 12
 	// string "ifs"
-	o = append(o, 0x8b, 0xa3, 0x69, 0x66, 0x73)
+	o = append(o, 0x8c, 0xa3, 0x69, 0x66, 0x73)
 	o = msgp.AppendUint64(o, z.InitFreeSpace)
 	// string "ic"
 	o = append(o, 0xa2, 0x69, 0x63)
 	o = msgp.AppendUint64(o, z.InitCapacity)
+	// string "tfg"
+	o = append(o, 0xa3, 0x74, 0x66, 0x67)
+	o = msgp.AppendFloat64(o, z.TargetFreeGoal)
 	// string "bus"
 	o = append(o, 0xa3, 0x62, 0x75, 0x73)
 	o = msgp.AppendArrayHeader(o, uint32(len(z.Buckets)))
@@ -998,6 +1042,12 @@ func (z *rebalanceStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "InitCapacity")
 				return
 			}
+		case "tfg":
+			z.TargetFreeGoal, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TargetFreeGoal")
+				return
+			}
 		case "bus":
 			var zb0002 uint32
 			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
@@ -1092,7 +1142,7 @@ func (z *rebalanceStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *rebalanceStats) Msgsize() (s int) {
-	s = 1 + 4 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.ArrayHeaderSize
+	s = 1 + 4 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.Float64Size + 4 + msgp.ArrayHeaderSize
 	for za0001 := range z.Buckets {
 		s += msgp.StringPrefixSize + len(z.Buckets[za0001])
 	}
@@ -385,11 +385,50 @@ func (p serverPoolsAvailableSpace) FilterMaxUsed(maxUsed int) {
 	}
 }
 
+// PreferPools will filter out any pools not in the preferred list,
+// unless none of the preferred pools has space left, in which case all
+// are preserved.
+func (p serverPoolsAvailableSpace) PreferPools(pools []int) {
+	if len(p) <= 1 {
+		// Nothing to do.
+		return
+	}
+	preferred := make(map[int]struct{}, len(pools))
+	for _, pool := range pools {
+		preferred[pool] = struct{}{}
+	}
+	var ok bool
+	for _, z := range p {
+		if _, found := preferred[z.Index]; found && z.Available > 0 {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		// No preferred pool has space left.
+		// Do not modify
+		return
+	}
+
+	// Remove entries that are not preferred.
+	for i, z := range p {
+		if _, found := preferred[z.Index]; found {
+			continue
+		}
+		p[i].Available = 0
+	}
+}
+
 // getAvailablePoolIdx will return an index that can hold size bytes.
 // -1 is returned if no serverPools have available space for the size given.
 func (z *erasureServerPools) getAvailablePoolIdx(ctx context.Context, bucket, object string, size int64) int {
 	serverPools := z.getServerPoolsAvailableSpace(ctx, bucket, object, size)
 	serverPools.FilterMaxUsed(100 - (100 * diskReserveFraction))
+	if pools := bucketPlacementPools(bucket); len(pools) > 0 {
+		// Bucket level placement hints restrict new objects to the
+		// preferred pools as long as they have space.
+		serverPools.PreferPools(pools)
+	}
 	total := serverPools.TotalAvailable()
 	if total == 0 {
 		return -1
@@ -469,6 +508,12 @@ func (z *erasureServerPools) getServerPoolsAvailableSpace(ctx context.Context, b
 		// Different set sizes are already compensated by less disks.
 		available *= uint64(nSets[i])
 
+		// Scale by the pool's drive weight hints, heterogeneous pools
+		// fill proportionally to their configured weight.
+		if w := z.serverPools[i].poolWeight(); w != 1 {
+			available = uint64(float64(available) * w)
+		}
+
 		serverPools[i] = poolAvailableSpace{
 			Index:      i,
 			Available:  available,
@@ -627,6 +672,10 @@ func (z *erasureServerPools) getPoolIdxNoLock(ctx context.Context, bucket, objec
 		}
 	}
 
+	if readOnlyModeEnabled(idx) {
+		return -1, errReadOnlyMode
+	}
+
 	return idx, nil
 }
 
@@ -649,6 +698,10 @@ func (z *erasureServerPools) getPoolIdx(ctx context.Context, bucket, object stri
 		}
 	}
 
+	if readOnlyModeEnabled(idx) {
+		return -1, errReadOnlyMode
+	}
+
 	return idx, nil
 }
 
@@ -1287,6 +1340,56 @@ func (z *erasureServerPools) DeleteObjects(ctx context.Context, bucket string, o
 	return dobjects, derrs
 }
 
+// GetObjectsInfo stats multiple objects with one storage call per disk,
+// used by the multi-object delete pre-checks. Reads are lock free, per
+// entry results follow GetObjectInfo semantics.
+func (z *erasureServerPools) GetObjectsInfo(ctx context.Context, bucket string, objects []ObjectV, opts ObjectOptions) ([]ObjectInfo, []error) {
+	objects = append(make([]ObjectV, 0, len(objects)), objects...)
+	for i := range objects {
+		objects[i].ObjectName = encodeDirObject(objects[i].ObjectName)
+	}
+
+	objInfosByPool := make([][]ObjectInfo, len(z.serverPools))
+	errsByPool := make([][]error, len(z.serverPools))
+
+	eg := errgroup.WithNErrs(len(z.serverPools)).WithConcurrency(len(z.serverPools))
+	for i, pool := range z.serverPools {
+		i := i
+		pool := pool
+		eg.Go(func() error {
+			objInfosByPool[i], errsByPool[i] = pool.GetObjectsInfo(ctx, bucket, objects, opts)
+			return nil
+		}, i)
+	}
+	eg.Wait()
+
+	objInfos := make([]ObjectInfo, len(objects))
+	errs := make([]error, len(objects))
+	for idx := range objects {
+		// Pick the latest version across pools, delete markers carry a
+		// valid object info along with their error.
+		found := false
+		for pool := range z.serverPools {
+			oi, err := objInfosByPool[pool][idx], errsByPool[pool][idx]
+			if err != nil && oi.ModTime.IsZero() {
+				if errs[idx] == nil && !found {
+					errs[idx] = err
+				}
+				continue
+			}
+			if !found || oi.ModTime.After(objInfos[idx].ModTime) {
+				objInfos[idx], errs[idx] = oi, err
+				found = true
+			}
+		}
+		if !found && errs[idx] == nil {
+			errs[idx] = toObjectErr(errFileNotFound, bucket, decodeDirObject(objects[idx].ObjectName))
+		}
+		objInfos[idx].Name = decodeDirObject(objInfos[idx].Name)
+	}
+	return objInfos, errs
+}
+
 func (z *erasureServerPools) CopyObject(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string, srcInfo ObjectInfo, srcOpts, dstOpts ObjectOptions) (objInfo ObjectInfo, err error) {
 	if err := checkCopyObjArgs(ctx, srcBucket, srcObject); err != nil {
 		return ObjectInfo{}, err
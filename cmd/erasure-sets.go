@@ -579,6 +579,23 @@ func (s *erasureSets) SetDriveCount() int {
 	return s.setDriveCount
 }
 
+// poolWeight returns the average per-drive weight hint of the pool's
+// endpoints, 1 when no weight annotations were given on the command line.
+func (s *erasureSets) poolWeight() float64 {
+	if len(s.endpoints.Endpoints) == 0 {
+		return 1
+	}
+	var total int
+	for _, endpoint := range s.endpoints.Endpoints {
+		w := endpoint.Weight
+		if w < 1 {
+			w = 1
+		}
+		total += w
+	}
+	return float64(total) / float64(len(s.endpoints.Endpoints))
+}
+
 // ParityCount returns the default parity count used while erasure
 // coding objects
 func (s *erasureSets) ParityCount() int {
@@ -836,6 +853,53 @@ func (s *erasureSets) DeleteObjects(ctx context.Context, bucket string, objects
 	return delObjects, delErrs
 }
 
+// GetObjectsInfo stats multiple objects in one storage call per disk,
+// objects are grouped by their erasure set first.
+func (s *erasureSets) GetObjectsInfo(ctx context.Context, bucket string, objects []ObjectV, opts ObjectOptions) ([]ObjectInfo, []error) {
+	type statObj struct {
+		// Original index from the list of arguments
+		// where this object is passed
+		origIndex int
+		// object to stat
+		object ObjectV
+	}
+
+	objInfos := make([]ObjectInfo, len(objects))
+	errs := make([]error, len(objects))
+
+	// A map between a set and its associated objects
+	objSetMap := make(map[int][]statObj)
+
+	// Group objects by set index
+	for i, object := range objects {
+		index := s.getHashedSetIndex(object.ObjectName)
+		objSetMap[index] = append(objSetMap[index], statObj{origIndex: i, object: object})
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	wg.Add(len(objSetMap))
+	for setIdx, objsGroup := range objSetMap {
+		go func(set *erasureObjects, group []statObj) {
+			defer wg.Done()
+			objs := make([]ObjectV, len(group))
+			for i, obj := range group {
+				objs[i] = obj.object
+			}
+			infos, serrs := set.GetObjectsInfo(ctx, bucket, objs, opts)
+			mu.Lock()
+			defer mu.Unlock()
+			for i, obj := range group {
+				objInfos[obj.origIndex] = infos[i]
+				errs[obj.origIndex] = serrs[i]
+			}
+		}(s.sets[setIdx], objsGroup)
+	}
+	wg.Wait()
+
+	return objInfos, errs
+}
+
 // CopyObject - copies objects from one hashedSet to another hashedSet, on server side.
 func (s *erasureSets) CopyObject(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string, srcInfo ObjectInfo, srcOpts, dstOpts ObjectOptions) (objInfo ObjectInfo, err error) {
 	srcSet := s.getHashedSet(srcObject)
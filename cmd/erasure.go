@@ -222,6 +222,19 @@ func getDisksInfo(disks []StorageAPI, endpoints []Endpoint, metrics bool) (disks
 			for k, v := range info.Metrics.APICalls {
 				di.Metrics.APICalls[k] = v
 			}
+			if idc, ok := disks[index].(*xlStorageDiskIDCheck); ok {
+				// Expose approximate last minute read latency
+				// percentiles of local drives as additional
+				// last minute entries.
+				for _, pq := range []struct {
+					name string
+					q    float64
+				}{{"ReadP50", 0.5}, {"ReadP90", 0.9}, {"ReadP99", 0.99}} {
+					if v, n := idc.readLatency.percentile(pq.q); n > 0 {
+						di.Metrics.LastMinute[pq.name] = madmin.TimedAction{Count: 1, AccTime: uint64(v)}
+					}
+				}
+			}
 			if info.Total > 0 {
 				di.Utilization = float64(info.Used / info.Total * 100)
 			}
@@ -24,27 +24,32 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/event"
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/pubsub"
+	"github.com/minio/pkg/v3/env"
 	"github.com/minio/pkg/v3/policy"
 )
 
 // EventNotifier - notifies external systems about events in MinIO.
 type EventNotifier struct {
 	sync.RWMutex
-	targetList     *event.TargetList
-	bucketRulesMap map[string]event.RulesMap
+	targetList         *event.TargetList
+	bucketRulesMap     map[string]event.RulesMap
+	bucketSyncRulesMap map[string]event.RulesMap
 }
 
 // NewEventNotifier - creates new event notification object.
 func NewEventNotifier(ctx context.Context) *EventNotifier {
 	// targetList/bucketRulesMap/bucketRemoteTargetRulesMap are populated by NotificationSys.InitBucketTargets()
 	return &EventNotifier{
-		targetList:     event.NewTargetList(ctx),
-		bucketRulesMap: make(map[string]event.RulesMap),
+		targetList:         event.NewTargetList(ctx),
+		bucketRulesMap:     make(map[string]event.RulesMap),
+		bucketSyncRulesMap: make(map[string]event.RulesMap),
 	}
 }
 
@@ -81,7 +86,7 @@ func (evnot *EventNotifier) set(bucket string, meta BucketMetadata) {
 			internalLogIf(GlobalContext, err)
 		}
 	}
-	evnot.AddRulesMap(bucket, config.ToRulesMap())
+	evnot.AddRulesMap(bucket, config.ToRulesMap(), config.ToSyncRulesMap())
 }
 
 // Targets returns all the registered targets
@@ -102,8 +107,8 @@ func (evnot *EventNotifier) InitBucketTargets(ctx context.Context, objAPI Object
 	return nil
 }
 
-// AddRulesMap - adds rules map for bucket name.
-func (evnot *EventNotifier) AddRulesMap(bucketName string, rulesMap event.RulesMap) {
+// AddRulesMap - adds rules map and synchronous delivery rules map for bucket name.
+func (evnot *EventNotifier) AddRulesMap(bucketName string, rulesMap, syncRulesMap event.RulesMap) {
 	evnot.Lock()
 	defer evnot.Unlock()
 
@@ -115,6 +120,12 @@ func (evnot *EventNotifier) AddRulesMap(bucketName string, rulesMap event.RulesM
 	} else {
 		evnot.bucketRulesMap[bucketName] = rulesMap
 	}
+
+	if len(syncRulesMap) == 0 {
+		delete(evnot.bucketSyncRulesMap, bucketName)
+	} else {
+		evnot.bucketSyncRulesMap[bucketName] = syncRulesMap.Clone()
+	}
 }
 
 // RemoveNotification - removes all notification configuration for bucket name.
@@ -123,6 +134,7 @@ func (evnot *EventNotifier) RemoveNotification(bucketName string) {
 	defer evnot.Unlock()
 
 	delete(evnot.bucketRulesMap, bucketName)
+	delete(evnot.bucketSyncRulesMap, bucketName)
 }
 
 // RemoveAllBucketTargets - closes and removes all notification targets.
@@ -137,16 +149,56 @@ func (evnot *EventNotifier) RemoveAllBucketTargets() {
 	evnot.targetList.Remove(targetIDSet)
 }
 
+// eventSyncTimeout bounds how long an object operation waits for targets
+// of rules marked <Sync> to acknowledge an event before proceeding.
+var eventSyncTimeout = func() time.Duration {
+	d, err := time.ParseDuration(env.Get(eventSyncTimeoutEnvVar, "10s"))
+	if err != nil {
+		return 10 * time.Second
+	}
+	return d
+}()
+
+const eventSyncTimeoutEnvVar = "_MINIO_NOTIFY_SYNC_TIMEOUT"
+
 // Send - sends the event to all registered notification targets
 func (evnot *EventNotifier) Send(args eventArgs) {
+	objTags, objMetadata := args.ruleConditionValues()
+
 	evnot.RLock()
-	targetIDSet := evnot.bucketRulesMap[args.BucketName].Match(args.EventName, args.Object.Name)
+	targetIDSet := evnot.bucketRulesMap[args.BucketName].MatchWith(args.EventName, args.Object.Name, objTags, objMetadata)
+	syncIDSet := evnot.bucketSyncRulesMap[args.BucketName].MatchWith(args.EventName, args.Object.Name, objTags, objMetadata)
 	evnot.RUnlock()
 
 	if len(targetIDSet) == 0 {
 		return
 	}
 
+	// Targets of matched rules marked <Sync> are sent to synchronously,
+	// blocking the calling object operation until they acknowledge the
+	// event or eventSyncTimeout expires; delivery continues in the
+	// background after a timeout.
+	if len(syncIDSet) > 0 {
+		ev := args.ToEvent(true)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			evnot.targetList.Send(ev, syncIDSet, true)
+		}()
+		t := time.NewTimer(eventSyncTimeout)
+		select {
+		case <-done:
+			t.Stop()
+		case <-t.C:
+		}
+		for id := range syncIDSet {
+			delete(targetIDSet, id)
+		}
+		if len(targetIDSet) == 0 {
+			return
+		}
+	}
+
 	// If MINIO_API_SYNC_EVENTS is set, send events synchronously.
 	evnot.targetList.Send(args.ToEvent(true), targetIDSet, globalAPIConfig.isSyncEventsEnabled())
 }
@@ -161,6 +213,28 @@ type eventArgs struct {
 	UserAgent    string
 }
 
+// ruleConditionValues - returns the object tags and user metadata used
+// to evaluate tag:/metadata: notification rule filters. Metadata keys
+// are lowercased with the x-amz-meta- prefix stripped.
+func (args eventArgs) ruleConditionValues() (objTags, objMetadata map[string]string) {
+	if args.Object.UserTags != "" {
+		if t, err := tags.ParseObjectTags(args.Object.UserTags); err == nil {
+			objTags = t.ToMap()
+		}
+	}
+
+	for k, v := range args.Object.UserDefined {
+		if stringsHasPrefixFold(k, "x-amz-meta-") {
+			if objMetadata == nil {
+				objMetadata = make(map[string]string)
+			}
+			objMetadata[strings.ToLower(k[len("x-amz-meta-"):])] = v
+		}
+	}
+
+	return objTags, objMetadata
+}
+
 // ToEvent - converts to notification event.
 func (args eventArgs) ToEvent(escape bool) event.Event {
 	eventTime := UTCNow()
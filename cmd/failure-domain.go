@@ -0,0 +1,211 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/v3/env"
+)
+
+// Failure-domain aware erasure set construction. Operators can annotate
+// hosts with a failure domain (rack, chassis, ...) and set construction
+// interleaves drives across domains before slicing them into erasure
+// sets, so the shards of a set spread over as many domains as possible
+// instead of relying on naive host ordering. The value must be identical
+// on all nodes, exactly like the endpoint arguments.
+//
+// Format: MINIO_FAILURE_DOMAINS="rack1=host1,host2;rack2=host3,host4"
+// Host entries are prefix-matched against endpoint host names.
+const envFailureDomains = "MINIO_FAILURE_DOMAINS"
+
+// failureDomain is a named group of host prefixes.
+type failureDomain struct {
+	name  string
+	hosts []string
+}
+
+// failureDomains holds the ordered failure domain configuration.
+type failureDomains struct {
+	domains []failureDomain
+}
+
+var (
+	globalFailureDomains     *failureDomains
+	globalFailureDomainsOnce sync.Once
+)
+
+// loadFailureDomains parses MINIO_FAILURE_DOMAINS once, nil when unset.
+func loadFailureDomains() *failureDomains {
+	globalFailureDomainsOnce.Do(func() {
+		v := env.Get(envFailureDomains, "")
+		if v == "" {
+			return
+		}
+		fd, err := parseFailureDomains(v)
+		if err != nil {
+			logger.Fatal(err, "Invalid %s value %q", envFailureDomains, v)
+		}
+		globalFailureDomains = fd
+	})
+	return globalFailureDomains
+}
+
+// parseFailureDomains parses "name=host[,host...][;name=...]".
+func parseFailureDomains(v string) (*failureDomains, error) {
+	fd := &failureDomains{}
+	seen := make(map[string]struct{})
+	for _, part := range strings.Split(v, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, hostList, ok := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid failure domain entry %q", part)
+		}
+		if _, dup := seen[name]; dup {
+			return nil, fmt.Errorf("duplicate failure domain %q", name)
+		}
+		seen[name] = struct{}{}
+		var hosts []string
+		for _, host := range strings.Split(hostList, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				hosts = append(hosts, host)
+			}
+		}
+		if len(hosts) == 0 {
+			return nil, fmt.Errorf("failure domain %q has no hosts", name)
+		}
+		fd.domains = append(fd.domains, failureDomain{name: name, hosts: hosts})
+	}
+	if len(fd.domains) < 2 {
+		return nil, fmt.Errorf("at least two failure domains are required")
+	}
+	return fd, nil
+}
+
+// argHostname returns the host name of an endpoint argument, empty for
+// local paths.
+func argHostname(arg string) string {
+	u, err := url.Parse(arg)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(u.Host); err == nil {
+		return host
+	}
+	return u.Host
+}
+
+// lookup returns the failure domain of a host, empty when unlabeled.
+func (fd *failureDomains) lookup(host string) string {
+	if host == "" {
+		return ""
+	}
+	for _, domain := range fd.domains {
+		for _, prefix := range domain.hosts {
+			if strings.HasPrefix(host, prefix) {
+				return domain.name
+			}
+		}
+	}
+	return ""
+}
+
+// rebalanceSets redistributes endpoint arguments round-robin across
+// failure domains before they are sliced back into the original set
+// shape, so each erasure set spans as many domains as possible. The
+// relative drive order within a domain is preserved, keeping the layout
+// deterministic for a given argument and domain configuration.
+func (fd *failureDomains) rebalanceSets(setArgs [][]string) [][]string {
+	var flat []string
+	for _, sargs := range setArgs {
+		flat = append(flat, sargs...)
+	}
+
+	// Group args per domain preserving order of first appearance.
+	// Unlabeled hosts keep a group of their own, which degrades to the
+	// old host based spreading for them.
+	var order []string
+	groups := make(map[string][]string)
+	for _, arg := range flat {
+		domain := fd.lookup(argHostname(arg))
+		if domain == "" {
+			domain = "\x00unlabeled\x00" + argHostname(arg)
+		}
+		if _, ok := groups[domain]; !ok {
+			order = append(order, domain)
+		}
+		groups[domain] = append(groups[domain], arg)
+	}
+	if len(order) < 2 {
+		return setArgs
+	}
+
+	// Round-robin across domains.
+	interleaved := make([]string, 0, len(flat))
+	for len(interleaved) < len(flat) {
+		for _, domain := range order {
+			if len(groups[domain]) > 0 {
+				interleaved = append(interleaved, groups[domain][0])
+				groups[domain] = groups[domain][1:]
+			}
+		}
+	}
+
+	// Slice back into the original set shape.
+	rebalanced := make([][]string, len(setArgs))
+	for i := range setArgs {
+		rebalanced[i] = interleaved[:len(setArgs[i])]
+		interleaved = interleaved[len(setArgs[i]):]
+	}
+	return rebalanced
+}
+
+// validateSets warns when a single failure domain holds enough drives of
+// an erasure set that losing the domain can break read quorum, and when
+// hosts are left without a domain label.
+func (fd *failureDomains) validateSets(setArgs [][]string) {
+	unlabeled := make(map[string]struct{})
+	for i, sargs := range setArgs {
+		perDomain := make(map[string]int)
+		for _, arg := range sargs {
+			host := argHostname(arg)
+			domain := fd.lookup(host)
+			if domain == "" && host != "" {
+				unlabeled[host] = struct{}{}
+			}
+			perDomain[domain]++
+		}
+		for domain, count := range perDomain {
+			if domain != "" && count > len(sargs)/2 {
+				logger.Info(fmt.Sprintf("Failure domain %q holds %d of %d drives of erasure set %d, losing this domain can break read quorum", domain, count, len(sargs), i+1))
+			}
+		}
+	}
+	for host := range unlabeled {
+		logger.Info(fmt.Sprintf("Host %q has no failure domain label in %s", host, envFailureDomains))
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFailureDomains(t *testing.T) {
+	testCases := []struct {
+		value   string
+		success bool
+	}{
+		{"rack1=host1,host2;rack2=host3,host4", true},
+		{"rack1=host1;rack2=host2;rack3=host3", true},
+		{"rack1=host1", false},             // single domain
+		{"rack1=host1;rack1=host2", false}, // duplicate domain
+		{"rack1=;rack2=host2", false},      // no hosts
+		{"rack1;rack2=host2", false},       // missing '='
+		{"", false},
+	}
+	for _, testCase := range testCases {
+		_, err := parseFailureDomains(testCase.value)
+		if err != nil && testCase.success {
+			t.Errorf("parseFailureDomains(%q): unexpected error %v", testCase.value, err)
+		}
+		if err == nil && !testCase.success {
+			t.Errorf("parseFailureDomains(%q): expected error, got none", testCase.value)
+		}
+	}
+}
+
+func TestRebalanceSetsAcrossFailureDomains(t *testing.T) {
+	fd, err := parseFailureDomains("rack1=host1,host2;rack2=host3,host4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Naive ordering puts both drives of a host into the same set.
+	setArgs := [][]string{
+		{"http://host1/disk1", "http://host1/disk2", "http://host2/disk1", "http://host2/disk2"},
+		{"http://host3/disk1", "http://host3/disk2", "http://host4/disk1", "http://host4/disk2"},
+	}
+	rebalanced := fd.rebalanceSets(setArgs)
+	expected := [][]string{
+		{"http://host1/disk1", "http://host3/disk1", "http://host1/disk2", "http://host3/disk2"},
+		{"http://host2/disk1", "http://host4/disk1", "http://host2/disk2", "http://host4/disk2"},
+	}
+	if !reflect.DeepEqual(rebalanced, expected) {
+		t.Errorf("rebalanceSets: expected %v, got %v", expected, rebalanced)
+	}
+
+	// Same drives in each set, only redistributed.
+	count := func(sets [][]string) int {
+		n := 0
+		for _, set := range sets {
+			n += len(set)
+		}
+		return n
+	}
+	if count(rebalanced) != count(setArgs) {
+		t.Errorf("rebalanceSets changed the number of drives: %d != %d", count(rebalanced), count(setArgs))
+	}
+
+	// Local paths (no hosts) are returned unchanged.
+	local := [][]string{{"/mnt/disk1", "/mnt/disk2"}}
+	if got := fd.rebalanceSets(local); !reflect.DeepEqual(got, local) {
+		t.Errorf("rebalanceSets: expected local paths unchanged, got %v", got)
+	}
+}
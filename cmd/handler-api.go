@@ -53,10 +53,13 @@ type apiConfig struct {
 	staleUploadsCleanupInterval time.Duration
 	deleteCleanupInterval       time.Duration
 	enableODirect               bool
+	odirectMinSize              int64
+	odirectMaxQueue             int32
 	gzipObjects                 bool
 	rootAccess                  bool
 	syncEvents                  bool
 	objectMaxVersions           int64
+	strictS3Compat              bool
 }
 
 const (
@@ -183,10 +186,13 @@ func (t *apiConfig) init(cfg api.Config, setDriveCounts []int, legacy bool) {
 	t.staleUploadsExpiry = cfg.StaleUploadsExpiry
 	t.deleteCleanupInterval = cfg.DeleteCleanupInterval
 	t.enableODirect = cfg.EnableODirect
+	t.odirectMinSize = cfg.ODirectMinSize
+	t.odirectMaxQueue = int32(cfg.ODirectMaxQueue)
 	t.gzipObjects = cfg.GzipObjects
 	t.rootAccess = cfg.RootAccess
 	t.syncEvents = cfg.SyncEvents
 	t.objectMaxVersions = cfg.ObjectMaxVersions
+	t.strictS3Compat = cfg.StrictS3Compat
 
 	if t.staleUploadsCleanupInterval != cfg.StaleUploadsCleanupInterval {
 		t.staleUploadsCleanupInterval = cfg.StaleUploadsCleanupInterval
@@ -206,6 +212,22 @@ func (t *apiConfig) odirectEnabled() bool {
 	return t.enableODirect
 }
 
+// odirectWriteLimits returns the thresholds below/above which writes fall
+// back to buffered I/O even with O_DIRECT enabled.
+func (t *apiConfig) odirectWriteLimits() (minSize int64, maxQueue int32) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.odirectMinSize, t.odirectMaxQueue
+}
+
+func (t *apiConfig) isStrictS3Compat() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.strictS3Compat
+}
+
 func (t *apiConfig) shouldGzipObjects() bool {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
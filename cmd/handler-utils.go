@@ -28,6 +28,7 @@ import (
 
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/handlers"
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
@@ -141,7 +142,18 @@ var userMetadataKeyPrefixes = []string{
 
 // extractMetadataFromReq extracts metadata from HTTP header and HTTP queryString.
 func extractMetadataFromReq(ctx context.Context, r *http.Request) (metadata map[string]string, err error) {
-	return extractMetadata(ctx, textproto.MIMEHeader(r.Form), textproto.MIMEHeader(r.Header))
+	metadata, err = extractMetadata(ctx, textproto.MIMEHeader(r.Form), textproto.MIMEHeader(r.Header))
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the uploading access key for per-owner usage accounting.
+	if ownerAccountingEnabled {
+		if cred := getReqAccessCred(r, globalSite.Region()); cred.AccessKey != "" {
+			metadata[ownerMetadataKey] = cred.AccessKey
+		}
+	}
+	return metadata, nil
 }
 
 func extractMetadata(ctx context.Context, mimesHeader ...textproto.MIMEHeader) (metadata map[string]string, err error) {
@@ -161,9 +173,15 @@ func extractMetadata(ctx context.Context, mimesHeader ...textproto.MIMEHeader) (
 	}
 
 	// https://github.com/google/security-research/security/advisories/GHSA-76wf-9vgp-pj7w
-	for k := range metadata {
-		if equals(k, xhttp.AmzMetaUnencryptedContentLength, xhttp.AmzMetaUnencryptedContentMD5) {
-			delete(metadata, k)
+	//
+	// Keep these entries when they belong to a client-side encryption
+	// envelope - encryption clients need them to decrypt the object.
+	// The envelope itself is validated below.
+	if !crypto.IsCSEncrypted(metadata) {
+		for k := range metadata {
+			if equals(k, xhttp.AmzMetaUnencryptedContentLength, xhttp.AmzMetaUnencryptedContentMD5) {
+				delete(metadata, k)
+			}
 		}
 	}
 
@@ -185,6 +203,11 @@ func extractMetadata(ctx context.Context, mimesHeader ...textproto.MIMEHeader) (
 		}
 	}
 
+	// Validate any client-side encryption envelope before it is stored.
+	if err := validateCSEMetadata(metadata); err != nil {
+		return nil, err
+	}
+
 	// Success.
 	return metadata, nil
 }
@@ -326,12 +349,13 @@ func collectAPIStats(api string, f http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		bucket, _ := path2BucketObject(resource)
+		bucket, object := path2BucketObject(resource)
 
 		meta, err := globalBucketMetadataSys.Get(bucket) // check if this bucket exists.
 		countBktStat := bucket != "" && bucket != minioReservedBucket && err == nil && !meta.Created.IsZero()
 		if countBktStat {
 			globalBucketHTTPStats.updateHTTPStats(bucket, api, nil)
+			recordAPIAccess(api, bucket, object)
 		}
 
 		globalHTTPStats.currentS3Requests.Inc(api)
@@ -348,6 +372,7 @@ func collectAPIStats(api string, f http.HandlerFunc) http.HandlerFunc {
 				globalBucketConnStats.incS3InputBytes(bucket, int64(tc.RequestRecorder.Size()))
 				globalBucketConnStats.incS3OutputBytes(bucket, int64(tc.ResponseRecorder.Size()))
 				globalBucketHTTPStats.updateHTTPStats(bucket, api, tc.ResponseRecorder)
+				globalBucketHTTPStats.updateHTTPHistograms(bucket, api, tc.ResponseRecorder, int64(tc.RequestRecorder.Size()))
 			}
 		}
 	}
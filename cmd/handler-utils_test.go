@@ -20,6 +20,7 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/xml"
 	"io"
 	"net/http"
@@ -95,6 +96,86 @@ func TestIsValidLocationConstraint(t *testing.T) {
 	}
 }
 
+// Tests that client-side encryption envelopes arriving via request
+// headers - stored under canonicalized keys such as X-Amz-Meta-X-Amz-Key -
+// are validated, and malformed envelopes rejected, at extraction time.
+func TestExtractMetadataCSEnvelope(t *testing.T) {
+	validKey := base64.StdEncoding.EncodeToString([]byte("envelope-key"))
+	validIV := base64.StdEncoding.EncodeToString([]byte("iv"))
+	testCases := []struct {
+		header     http.Header
+		shouldFail bool
+	}{
+		// Test case - 1.
+		// Complete v1 envelope is accepted.
+		{
+			header: http.Header{
+				"X-Amz-Meta-X-Amz-Key": {validKey},
+				"X-Amz-Meta-X-Amz-Iv":  {validIV},
+			},
+		},
+		// Test case - 2.
+		// Envelope key that is not valid base64 is rejected.
+		{
+			header: http.Header{
+				"X-Amz-Meta-X-Amz-Key": {"not@base64"},
+				"X-Amz-Meta-X-Amz-Iv":  {validIV},
+			},
+			shouldFail: true,
+		},
+		// Test case - 3.
+		// Envelope without an IV is rejected.
+		{
+			header: http.Header{
+				"X-Amz-Meta-X-Amz-Key": {validKey},
+			},
+			shouldFail: true,
+		},
+		// Test case - 4.
+		// Both envelope versions at once are rejected.
+		{
+			header: http.Header{
+				"X-Amz-Meta-X-Amz-Key":    {validKey},
+				"X-Amz-Meta-X-Amz-Key-V2": {validKey},
+				"X-Amz-Meta-X-Amz-Iv":     {validIV},
+			},
+			shouldFail: true,
+		},
+		// Test case - 5.
+		// v2 envelope without the cek/wrap algorithms is rejected.
+		{
+			header: http.Header{
+				"X-Amz-Meta-X-Amz-Key-V2": {validKey},
+				"X-Amz-Meta-X-Amz-Iv":     {validIV},
+			},
+			shouldFail: true,
+		},
+		// Test case - 6.
+		// Complete v2 envelope is accepted.
+		{
+			header: http.Header{
+				"X-Amz-Meta-X-Amz-Key-V2":   {validKey},
+				"X-Amz-Meta-X-Amz-Iv":       {validIV},
+				"X-Amz-Meta-X-Amz-Cek-Alg":  {"AES/GCM/NoPadding"},
+				"X-Amz-Meta-X-Amz-Wrap-Alg": {"kms"},
+			},
+		},
+	}
+
+	for i, testCase := range testCases {
+		_, err := extractMetadata(context.Background(), textproto.MIMEHeader(testCase.header))
+		if err != nil && !testCase.shouldFail {
+			t.Errorf("Test %d: unexpected error: %v", i+1, err)
+		}
+		if err == nil && testCase.shouldFail {
+			t.Errorf("Test %d: should fail, but it passed", i+1)
+		}
+		if err != nil && testCase.shouldFail && err != errInvalidCSEMetadata {
+			t.Errorf("Test %d: expected %v, got %v", i+1, errInvalidCSEMetadata, err)
+		}
+	}
+}
+
 // Tests validate metadata extraction from http headers.
 func TestExtractMetadataHeaders(t *testing.T) {
 	testCases := []struct {
@@ -18,12 +18,17 @@
 package cmd
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/minio/minio/internal/config"
 	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/pkg/v3/env"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -176,6 +181,98 @@ func (bh *bucketHTTPStats) updateHTTPStats(bucket, api string, w *xhttp.Response
 	bh.httpStats[bucket] = hstats
 }
 
+// Standard env to enable the opt-in per-bucket API histograms and to
+// bound their label cardinality.
+const (
+	EnvBucketHistograms           = "MINIO_METRICS_BUCKET_HISTOGRAMS"
+	EnvBucketHistogramsMaxBuckets = "MINIO_METRICS_BUCKET_HISTOGRAMS_MAX_BUCKETS"
+
+	defaultBucketHistogramsMaxBuckets = 100
+)
+
+// bucketHTTPHistograms guards the opt-in per-bucket API histograms. To keep
+// the label cardinality bounded only the top-N buckets by request count
+// carry histogram samples, the least busy tracked bucket is evicted when a
+// busier one shows up.
+type bucketHTTPHistograms struct {
+	once       sync.Once
+	enabled    bool
+	maxBuckets int
+
+	sync.Mutex
+	counts  map[string]uint64
+	tracked map[string]struct{}
+}
+
+var globalBucketHTTPHistograms = &bucketHTTPHistograms{}
+
+func (bh *bucketHTTPHistograms) init() {
+	bh.enabled = env.Get(EnvBucketHistograms, config.EnableOff) == config.EnableOn
+	bh.maxBuckets = defaultBucketHistogramsMaxBuckets
+	if v, err := strconv.Atoi(env.Get(EnvBucketHistogramsMaxBuckets, "")); err == nil && v > 0 {
+		bh.maxBuckets = v
+	}
+	bh.counts = make(map[string]uint64)
+	bh.tracked = make(map[string]struct{})
+}
+
+// track reports whether histogram samples shall be recorded for the given
+// bucket, maintaining the top-N set of tracked buckets.
+func (bh *bucketHTTPHistograms) track(bucket string) bool {
+	bh.once.Do(bh.init)
+	if !bh.enabled {
+		return false
+	}
+
+	bh.Lock()
+	defer bh.Unlock()
+
+	bh.counts[bucket]++
+	if _, ok := bh.tracked[bucket]; ok {
+		return true
+	}
+	if len(bh.tracked) < bh.maxBuckets {
+		bh.tracked[bucket] = struct{}{}
+		return true
+	}
+
+	minBucket, minCount := "", uint64(math.MaxUint64)
+	for b := range bh.tracked {
+		if c := bh.counts[b]; c < minCount {
+			minBucket, minCount = b, c
+		}
+	}
+	if bh.counts[bucket] <= minCount {
+		return false
+	}
+
+	// Evict the least busy bucket - its histogram history is dropped so
+	// that the label cardinality stays bounded.
+	delete(bh.tracked, minBucket)
+	labels := prometheus.Labels{"bucket": minBucket}
+	bucketHTTPRequestsTotalDuration.DeletePartialMatch(labels)
+	bucketHTTPRequestsRecvBytes.DeletePartialMatch(labels)
+	bucketHTTPRequestsSentBytes.DeletePartialMatch(labels)
+	bh.tracked[bucket] = struct{}{}
+	return true
+}
+
+// updateHTTPHistograms records the opt-in per-bucket request duration and
+// request/response size histograms.
+func (bh *bucketHTTPStats) updateHTTPHistograms(bucket, api string, w *xhttp.ResponseRecorder, recvBytes int64) {
+	if bh == nil || w == nil {
+		return
+	}
+	if !globalBucketHTTPHistograms.track(bucket) {
+		return
+	}
+
+	labels := prometheus.Labels{"api": api, "bucket": bucket}
+	bucketHTTPRequestsTotalDuration.With(labels).Observe(time.Since(w.StartTime).Seconds())
+	bucketHTTPRequestsRecvBytes.With(labels).Observe(float64(recvBytes))
+	bucketHTTPRequestsSentBytes.With(labels).Observe(float64(w.Size()))
+}
+
 func (bh *bucketHTTPStats) load(bucket string) bucketHTTPAPIStats {
 	if bh == nil {
 		return bucketHTTPAPIStats{
@@ -435,6 +532,9 @@ func (st *HTTPStats) updateStats(api string, w *xhttp.ResponseRecorder) {
 	// Increment the prometheus http request response histogram with appropriate label
 	httpRequestsDuration.With(prometheus.Labels{"api": api}).Observe(w.TTFB().Seconds())
 
+	// Feed the adaptive scanner's latency window, no-op unless enabled.
+	globalAdaptiveScanner.observe(w.TTFB())
+
 	code := w.StatusCode
 
 	switch {
@@ -0,0 +1,176 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// accessKeyLastUsedFile persists the merged last-used timestamps in
+	// the IAM config prefix.
+	accessKeyLastUsedFile = "access-keys-last-used.json"
+
+	// accessKeyLastUsedFlushInterval is how often dirty in-memory
+	// timestamps are merged into the persisted file. Tracking is
+	// eventually consistent - on a crash at most this much history is
+	// lost.
+	accessKeyLastUsedFlushInterval = 5 * time.Minute
+
+	// accessKeyLastUsedResolution limits how often the in-memory
+	// timestamp of a single access key is updated, to keep the hot
+	// authentication path cheap.
+	accessKeyLastUsedResolution = time.Minute
+)
+
+// accessKeyLastUsedTracker records the last successful authentication time
+// per access key in memory and periodically persists the merged view.
+type accessKeyLastUsedTracker struct {
+	mu    sync.Mutex
+	times map[string]time.Time
+	dirty bool
+}
+
+var globalAccessKeyLastUsed = &accessKeyLastUsedTracker{
+	times: make(map[string]time.Time),
+}
+
+// record notes a successful authentication for the given access key.
+func (t *accessKeyLastUsedTracker) record(accessKey string) {
+	if accessKey == "" {
+		return
+	}
+	now := UTCNow()
+	t.mu.Lock()
+	if prev, ok := t.times[accessKey]; !ok || now.Sub(prev) >= accessKeyLastUsedResolution {
+		t.times[accessKey] = now
+		t.dirty = true
+	}
+	t.mu.Unlock()
+}
+
+// get returns the last recorded authentication time for the given access key,
+// the zero time if none was recorded.
+func (t *accessKeyLastUsedTracker) get(accessKey string) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.times[accessKey]
+}
+
+// merge folds the given timestamps into the tracker keeping the most recent
+// value per key.
+func (t *accessKeyLastUsedTracker) merge(times map[string]time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for k, v := range times {
+		if v.After(t.times[k]) {
+			t.times[k] = v
+		}
+	}
+}
+
+// snapshot returns a copy of the tracked timestamps if any were updated since
+// the last call, and clears the dirty flag.
+func (t *accessKeyLastUsedTracker) snapshot() map[string]time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.dirty {
+		return nil
+	}
+	t.dirty = false
+	times := make(map[string]time.Time, len(t.times))
+	for k, v := range t.times {
+		times[k] = v
+	}
+	return times
+}
+
+func accessKeyLastUsedPath() string {
+	return pathJoin(iamConfigPrefix, accessKeyLastUsedFile)
+}
+
+// initAccessKeyLastUsed loads persisted last-used timestamps and starts the
+// periodic flush of in-memory updates.
+func initAccessKeyLastUsed(ctx context.Context, objAPI ObjectLayer) {
+	if err := loadAccessKeyLastUsed(ctx, objAPI); err != nil {
+		adminLogIf(ctx, err)
+	}
+
+	go func() {
+		for {
+			if err := sleepContext(ctx, accessKeyLastUsedFlushInterval); err != nil {
+				return
+			}
+			if err := flushAccessKeyLastUsed(ctx, objAPI); err != nil {
+				adminLogIf(ctx, err)
+			}
+		}
+	}()
+}
+
+func loadAccessKeyLastUsed(ctx context.Context, objAPI ObjectLayer) error {
+	data, err := readConfig(ctx, objAPI, accessKeyLastUsedPath())
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil
+		}
+		return err
+	}
+	var times map[string]time.Time
+	if err = json.Unmarshal(data, &times); err != nil {
+		return err
+	}
+	globalAccessKeyLastUsed.merge(times)
+	return nil
+}
+
+// flushAccessKeyLastUsed merges in-memory updates with the persisted file and
+// writes it back. Merging with the stored copy keeps timestamps recorded by
+// other nodes.
+func flushAccessKeyLastUsed(ctx context.Context, objAPI ObjectLayer) error {
+	times := globalAccessKeyLastUsed.snapshot()
+	if times == nil {
+		return nil
+	}
+	data, err := readConfig(ctx, objAPI, accessKeyLastUsedPath())
+	switch {
+	case errors.Is(err, errConfigNotFound):
+	case err != nil:
+		return err
+	default:
+		var stored map[string]time.Time
+		if err = json.Unmarshal(data, &stored); err != nil {
+			return err
+		}
+		for k, v := range stored {
+			if v.After(times[k]) {
+				times[k] = v
+			}
+		}
+		globalAccessKeyLastUsed.merge(stored)
+	}
+	data, err = json.Marshal(times)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, accessKeyLastUsedPath(), data)
+}
@@ -347,6 +347,54 @@ func (c *iamCache) updateGroupMembershipsMap(group string, gi *GroupInfo) {
 	}
 }
 
+// expandGroups returns the given groups along with all parent groups they are
+// transitively members of (nested groups). Tracking already-visited groups
+// protects against membership cycles. IMPORTANT: Assumes c.Lock or c.RLock is
+// held by caller.
+func (c *iamCache) expandGroups(groups set.StringSet) set.StringSet {
+	res := set.NewStringSet()
+	queue := groups.ToSlice()
+	for len(queue) > 0 {
+		group := queue[0]
+		queue = queue[1:]
+		if res.Contains(group) {
+			continue
+		}
+		res.Add(group)
+		queue = append(queue, c.iamUserGroupMemberships[group].ToSlice()...)
+	}
+	return res
+}
+
+// expandGroupMembers returns the direct members of the group followed by
+// members of nested groups, recursively. Nested group names remain in the
+// result so that they may still be removed via group update APIs. IMPORTANT:
+// Assumes c.Lock or c.RLock is held by caller.
+func (c *iamCache) expandGroupMembers(group string) []string {
+	var members []string
+	seenMembers := set.NewStringSet()
+	seenGroups := set.CreateStringSet(group)
+	queue := []string{group}
+	for len(queue) > 0 {
+		gi, ok := c.iamGroupsMap[queue[0]]
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+		for _, member := range gi.Members {
+			if !seenMembers.Contains(member) {
+				seenMembers.Add(member)
+				members = append(members, member)
+			}
+			if _, isGroup := c.iamGroupsMap[member]; isGroup && !seenGroups.Contains(member) {
+				seenGroups.Add(member)
+				queue = append(queue, member)
+			}
+		}
+	}
+	return members
+}
+
 // removeGroupFromMembershipsMap - removes the group from every member
 // in the cache. IMPORTANT: Assumes c.Lock() is held by caller.
 func (c *iamCache) removeGroupFromMembershipsMap(group string) {
@@ -543,7 +591,9 @@ func (c *iamCache) policyDBGet(store *IAMStoreSys, name string, isGroup bool, po
 		updatedAt = mp.UpdatedAt
 	}
 
-	for _, group := range c.iamUserGroupMemberships[name].ToSlice() {
+	// Expand nested groups so that policies attached to parent groups are
+	// inherited by members of their subgroups.
+	for _, group := range c.expandGroups(c.iamUserGroupMemberships[name]).ToSlice() {
 		if store.getUsersSysType() == MinIOUsersSysType {
 			g, ok := c.iamGroupsMap[group]
 			if ok {
@@ -867,7 +917,16 @@ func (store *IAMStoreSys) AddUsersToGroup(ctx context.Context, group string, mem
 	for _, member := range members {
 		u, ok := cache.iamUsersMap[member]
 		if !ok {
-			return updatedAt, errNoSuchUser
+			// Not a user - an existing group may also be a member
+			// (nested groups), as long as that does not create a
+			// membership cycle.
+			if _, gok := cache.iamGroupsMap[member]; !gok {
+				return updatedAt, errNoSuchUser
+			}
+			if cache.expandGroups(set.CreateStringSet(group)).Contains(member) {
+				return updatedAt, errGroupMembershipCycle
+			}
+			continue
 		}
 		cr := u.Credentials
 		if cr.IsTemp() || cr.IsServiceAccount() {
@@ -954,7 +1013,11 @@ func (store *IAMStoreSys) RemoveUsersFromGroup(ctx context.Context, group string
 	for _, member := range members {
 		u, ok := cache.iamUsersMap[member]
 		if !ok {
-			return updatedAt, errNoSuchUser
+			// Nested group members may be removed as well.
+			if _, gok := cache.iamGroupsMap[member]; !gok {
+				return updatedAt, errNoSuchUser
+			}
+			continue
 		}
 		cr := u.Credentials
 		if cr.IsTemp() || cr.IsServiceAccount() {
@@ -1050,9 +1113,11 @@ func (store *IAMStoreSys) GetGroupDescription(group string) (gd madmin.GroupDesc
 	}
 
 	return madmin.GroupDesc{
-		Name:      group,
-		Status:    gi.Status,
-		Members:   gi.Members,
+		Name:   group,
+		Status: gi.Status,
+		// Expand nested groups so the description reflects effective
+		// membership - direct members are listed first.
+		Members:   cache.expandGroupMembers(group),
 		Policy:    policy,
 		UpdatedAt: gi.UpdatedAt,
 	}, nil
@@ -2658,6 +2723,76 @@ func (store *IAMStoreSys) UpdateServiceAccount(ctx context.Context, accessKey st
 	return u.UpdatedAt, nil
 }
 
+// GetExpiredServiceAccounts - returns access keys of service accounts whose
+// expiration has passed.
+func (store *IAMStoreSys) GetExpiredServiceAccounts() []string {
+	cache := store.rlock()
+	defer store.runlock()
+
+	var expired []string
+	for accessKey, ui := range cache.iamUsersMap {
+		if ui.Credentials.IsServiceAccount() && ui.Credentials.IsExpired() {
+			expired = append(expired, accessKey)
+		}
+	}
+	return expired
+}
+
+// RotateServiceAccountSecret - generates a new secret key for the given
+// service account. The previous secret key continues to verify requests until
+// the grace duration expires, so clients can roll credentials without
+// downtime.
+func (store *IAMStoreSys) RotateServiceAccountSecret(ctx context.Context, accessKey string, grace time.Duration) (auth.Credentials, error) {
+	cache := store.lock()
+	defer store.unlock()
+
+	ui, ok := cache.iamUsersMap[accessKey]
+	if !ok || !ui.Credentials.IsServiceAccount() {
+		return auth.Credentials{}, errNoSuchServiceAccount
+	}
+	cr := ui.Credentials
+	currentSecretKey := cr.SecretKey
+
+	_, newSecretKey, err := auth.GenerateCredentials()
+	if err != nil {
+		return auth.Credentials{}, err
+	}
+
+	m, err := getClaimsFromTokenWithSecret(cr.SessionToken, currentSecretKey)
+	if err != nil {
+		return auth.Credentials{}, fmt.Errorf("unable to get svc acc claims: %v", err)
+	}
+
+	// Extracted session policy name string can be removed as its not useful
+	// at this point.
+	m.Delete(sessionPolicyNameExtracted)
+
+	cr.PreviousSecretKey = currentSecretKey
+	cr.RotatedAt = UTCNow()
+	cr.PreviousKeyValidTill = cr.RotatedAt.Add(grace)
+	if !cr.Expiration.IsZero() && !cr.Expiration.Equal(timeSentinel) && cr.PreviousKeyValidTill.After(cr.Expiration) {
+		// The grace window never outlives the account itself.
+		cr.PreviousKeyValidTill = cr.Expiration
+	}
+	cr.SecretKey = newSecretKey
+
+	cr.SessionToken, err = auth.JWTSignWithAccessKey(accessKey, m.Map(), cr.SecretKey)
+	if err != nil {
+		return auth.Credentials{}, err
+	}
+
+	u := newUserIdentity(cr)
+	if err := store.saveUserIdentity(ctx, u.Credentials.AccessKey, svcUser, u); err != nil {
+		return auth.Credentials{}, err
+	}
+
+	if err := cache.updateUserWithClaims(u.Credentials.AccessKey, u); err != nil {
+		return auth.Credentials{}, err
+	}
+
+	return u.Credentials, nil
+}
+
 // ListTempAccounts - lists only temporary accounts from the cache.
 func (store *IAMStoreSys) ListTempAccounts(ctx context.Context, accessKey string) ([]UserIdentity, error) {
 	cache := store.rlock()
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+)
+
+// Builds a cache with nested groups: user1 is a member of "child", "child" is
+// a member of "parent", and "parent" is a member of "grandparent".
+func newNestedGroupCacheForTest() *iamCache {
+	cache := newIamCache()
+	cache.iamGroupsMap["child"] = newGroupInfo([]string{"user1"})
+	cache.iamGroupsMap["parent"] = newGroupInfo([]string{"child", "user2"})
+	cache.iamGroupsMap["grandparent"] = newGroupInfo([]string{"parent"})
+	cache.buildUserGroupMemberships()
+	return cache
+}
+
+func TestIAMCacheExpandGroups(t *testing.T) {
+	cache := newNestedGroupCacheForTest()
+
+	got := cache.expandGroups(cache.iamUserGroupMemberships["user1"])
+	want := set.CreateStringSet("child", "parent", "grandparent")
+	if !got.Equals(want) {
+		t.Errorf("expected groups %v, got %v", want, got)
+	}
+
+	got = cache.expandGroups(cache.iamUserGroupMemberships["user2"])
+	want = set.CreateStringSet("parent", "grandparent")
+	if !got.Equals(want) {
+		t.Errorf("expected groups %v, got %v", want, got)
+	}
+
+	// A membership cycle must not cause expansion to loop.
+	cache.iamGroupsMap["child"] = newGroupInfo([]string{"user1", "grandparent"})
+	cache.buildUserGroupMemberships()
+	got = cache.expandGroups(set.CreateStringSet("child"))
+	want = set.CreateStringSet("child", "parent", "grandparent")
+	if !got.Equals(want) {
+		t.Errorf("expected groups %v, got %v", want, got)
+	}
+}
+
+func TestIAMCacheExpandGroupMembers(t *testing.T) {
+	cache := newNestedGroupCacheForTest()
+
+	got := set.CreateStringSet(cache.expandGroupMembers("grandparent")...)
+	want := set.CreateStringSet("parent", "child", "user1", "user2")
+	if !got.Equals(want) {
+		t.Errorf("expected members %v, got %v", want, got)
+	}
+
+	got = set.CreateStringSet(cache.expandGroupMembers("child")...)
+	want = set.CreateStringSet("user1")
+	if !got.Equals(want) {
+		t.Errorf("expected members %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/minio/pkg/v3/policy"
+)
+
+// Verifies that an inline session policy sent via the STS `Policy` parameter
+// restricts STS credentials to the intersection of the mapped policy and the
+// session policy.
+func TestIsAllowedBySessionPolicy(t *testing.T) {
+	sessionPolicy := `{
+ "Version": "2012-10-17",
+ "Statement": [
+  {
+   "Effect": "Allow",
+   "Action": ["s3:GetObject"],
+   "Resource": ["arn:aws:s3:::mybucket/*"]
+  }
+ ]
+}`
+
+	mkArgs := func(action policy.Action, bucket, object string, claims map[string]interface{}) policy.Args {
+		return policy.Args{
+			AccountName:     "sts-user",
+			Action:          action,
+			BucketName:      bucket,
+			ObjectName:      object,
+			ConditionValues: map[string][]string{},
+			Claims:          claims,
+		}
+	}
+	claims := map[string]interface{}{
+		sessionPolicyNameExtracted: sessionPolicy,
+	}
+
+	testCases := []struct {
+		name             string
+		args             policy.Args
+		hasSessionPolicy bool
+		isAllowed        bool
+	}{
+		{
+			name:             "allowed action within session policy",
+			args:             mkArgs(policy.GetObjectAction, "mybucket", "object", claims),
+			hasSessionPolicy: true,
+			isAllowed:        true,
+		},
+		{
+			name:             "action outside session policy denied",
+			args:             mkArgs(policy.PutObjectAction, "mybucket", "object", claims),
+			hasSessionPolicy: true,
+			isAllowed:        false,
+		},
+		{
+			name:             "bucket outside session policy denied",
+			args:             mkArgs(policy.GetObjectAction, "otherbucket", "object", claims),
+			hasSessionPolicy: true,
+			isAllowed:        false,
+		},
+		{
+			name:             "no session policy claim",
+			args:             mkArgs(policy.GetObjectAction, "mybucket", "object", nil),
+			hasSessionPolicy: false,
+			isAllowed:        false,
+		},
+		{
+			name: "malformed session policy claim denied",
+			args: mkArgs(policy.GetObjectAction, "mybucket", "object",
+				map[string]interface{}{sessionPolicyNameExtracted: 42}),
+			hasSessionPolicy: true,
+			isAllowed:        false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			hasSessionPolicy, isAllowed := isAllowedBySessionPolicy(testCase.args)
+			if hasSessionPolicy != testCase.hasSessionPolicy {
+				t.Errorf("expected hasSessionPolicy=%v, got %v", testCase.hasSessionPolicy, hasSessionPolicy)
+			}
+			if isAllowed != testCase.isAllowed {
+				t.Errorf("expected isAllowed=%v, got %v", testCase.isAllowed, isAllowed)
+			}
+		})
+	}
+}
+
+// Verifies that a session policy restricts even the owner - `.IsOwner` must
+// not bypass a session policy for service accounts.
+func TestSessionPolicyRestrictsOwnerForServiceAccount(t *testing.T) {
+	sessionPolicy := `{
+ "Version": "2012-10-17",
+ "Statement": [
+  {
+   "Effect": "Allow",
+   "Action": ["s3:GetObject"],
+   "Resource": ["arn:aws:s3:::mybucket/*"]
+  }
+ ]
+}`
+	args := policy.Args{
+		AccountName:     "svc-account",
+		Action:          policy.PutObjectAction,
+		BucketName:      "mybucket",
+		ObjectName:      "object",
+		ConditionValues: map[string][]string{},
+		IsOwner:         true,
+		Claims: map[string]interface{}{
+			sessionPolicyNameExtracted: sessionPolicy,
+		},
+	}
+	hasSessionPolicy, isAllowed := isAllowedBySessionPolicyForServiceAccount(args)
+	if !hasSessionPolicy {
+		t.Error("expected a session policy to be detected")
+	}
+	if isAllowed {
+		t.Error("expected owner to be restricted by the session policy")
+	}
+}
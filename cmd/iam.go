@@ -408,6 +408,26 @@ func (sys *IAMSys) periodicRoutines(ctx context.Context, baseInterval time.Durat
 		return baseInterval/2 + randAmt
 	}
 
+	// When a dedicated group membership refresh interval is configured,
+	// re-evaluate LDAP group memberships on that schedule so that group
+	// removals take effect without waiting for credential expiry or the
+	// hourly purge routines.
+	if interval := sys.LDAPConfig.GetGroupRefreshInterval(); sys.LDAPConfig.Enabled() && interval > 0 {
+		go func() {
+			refreshTimer := time.NewTimer(interval)
+			defer refreshTimer.Stop()
+			for {
+				select {
+				case <-refreshTimer.C:
+					sys.updateGroupMembershipsForLDAP(ctx)
+					refreshTimer.Reset(interval)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
 	timer := time.NewTimer(waitInterval())
 	defer timer.Stop()
 
@@ -434,11 +454,18 @@ func (sys *IAMSys) periodicRoutines(ctx context.Context, baseInterval time.Durat
 				// from LDAP/OpenID.
 				if sys.LDAPConfig.Enabled() {
 					sys.purgeExpiredCredentialsForLDAP(ctx)
-					sys.updateGroupMembershipsForLDAP(ctx)
+					if sys.LDAPConfig.GetGroupRefreshInterval() <= 0 {
+						// No dedicated refresh schedule - piggyback
+						// on the hourly purge.
+						sys.updateGroupMembershipsForLDAP(ctx)
+					}
 				}
 				if sys.OpenIDConfig.ProviderEnabled() {
 					sys.purgeExpiredCredentialsForExternalSSO(ctx)
 				}
+				// Remove service accounts whose expiration has passed,
+				// expired STS creds are dropped on load already.
+				sys.purgeExpiredServiceAccounts(ctx)
 			}
 
 			timer.Reset(waitInterval())
@@ -1141,6 +1168,22 @@ func (sys *IAMSys) UpdateServiceAccount(ctx context.Context, accessKey string, o
 	return updatedAt, nil
 }
 
+// RotateServiceAccountSecret - rotates the secret key of a service account,
+// keeping the previous key valid for the grace duration.
+func (sys *IAMSys) RotateServiceAccountSecret(ctx context.Context, accessKey string, grace time.Duration) (auth.Credentials, error) {
+	if !sys.Initialized() {
+		return auth.Credentials{}, errServerNotInitialized
+	}
+
+	cred, err := sys.store.RotateServiceAccountSecret(ctx, accessKey, grace)
+	if err != nil {
+		return auth.Credentials{}, err
+	}
+
+	sys.notifyForServiceAccount(ctx, accessKey)
+	return cred, nil
+}
+
 // ListServiceAccounts - lists all service accounts associated to a specific user
 func (sys *IAMSys) ListServiceAccounts(ctx context.Context, accessKey string) ([]auth.Credentials, error) {
 	if !sys.Initialized() {
@@ -1389,6 +1432,17 @@ func (sys *IAMSys) SetUserSecretKey(ctx context.Context, accessKey string, secre
 	return sys.store.UpdateUserSecretKey(ctx, accessKey, secretKey)
 }
 
+// purgeExpiredServiceAccounts - deletes service accounts whose expiration
+// timestamp has passed. Expired accounts are already rejected at auth time,
+// this reclaims their storage and keeps listings clean.
+func (sys *IAMSys) purgeExpiredServiceAccounts(ctx context.Context) {
+	for _, accessKey := range sys.store.GetExpiredServiceAccounts() {
+		if err := sys.DeleteServiceAccount(ctx, accessKey, true); err != nil {
+			iamLogIf(ctx, fmt.Errorf("unable to delete expired service account %s: %w", accessKey, err))
+		}
+	}
+}
+
 // purgeExpiredCredentialsForExternalSSO - validates if local credentials are still valid
 // by checking remote IDP if the relevant users are still active and present.
 func (sys *IAMSys) purgeExpiredCredentialsForExternalSSO(ctx context.Context) {
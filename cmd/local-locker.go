@@ -27,7 +27,9 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/minio/minio/internal/config"
 	"github.com/minio/minio/internal/dsync"
+	"github.com/minio/pkg/v3/env"
 )
 
 // Reject new lock requests immediately when this many are queued
@@ -38,6 +40,34 @@ import (
 // Rejected clients are expected to retry.
 const lockMutexWaitLimit = 1000
 
+// envLockFairQueue enables FIFO granting of write lock requests. Clients
+// retry with random backoff, so a writer can starve behind a steady stream
+// of readers. With fair queueing enabled the lock server hands out tickets
+// in arrival order: a contended write lock is only granted to the longest
+// waiting requester, and new read locks are refused while a writer is
+// queued. Must be set identically on all nodes.
+const envLockFairQueue = "MINIO_LOCK_FAIR_QUEUE"
+
+// Queued waiters that do not retry within this window are forgotten.
+// dsync retries with a backoff capped at 5 seconds (see lockRetryBackOff),
+// twice that covers a full retry cycle plus network time while a waiter
+// whose client went away drains within seconds instead of blocking new
+// readers and writers on the resource for a minute.
+const lockWaiterExpiry = 10 * time.Second
+
+var (
+	lockFairQueueOnce    sync.Once
+	lockFairQueueEnabled bool
+)
+
+// lockFairQueue returns if FIFO lock queueing was enabled via environment.
+func lockFairQueue() bool {
+	lockFairQueueOnce.Do(func() {
+		lockFairQueueEnabled = env.Get(envLockFairQueue, config.EnableOff) == config.EnableOn
+	})
+	return lockFairQueueEnabled
+}
+
 // lockRequesterInfo stores various info from the client for each lock that is requested.
 type lockRequesterInfo struct {
 	Name            string // name of the resource lock was requested for
@@ -49,9 +79,32 @@ type lockRequesterInfo struct {
 	Group           bool   // indicates if it was a group lock.
 	Owner           string // Owner represents the UUID of the owner who originally requested the lock.
 	Quorum          int    // Quorum represents the quorum required for this lock to be active.
+	Queued          bool   // indicates a waiter queued for the lock, not a lock holder.
+	LeaseExpiry     int64  // Lease deadline in unixnano, zero when the holder did not request a lease.
 	idx             int    `msg:"-"` // index of the lock in the lockMap.
 }
 
+// leaseExpiryOf returns the lease deadline for args relative to now,
+// zero when the client did not request a lease.
+func leaseExpiryOf(args dsync.LockArgs, now time.Time) int64 {
+	if args.Lease <= 0 {
+		return 0
+	}
+	return now.Add(args.Lease).UnixNano()
+}
+
+// lockWaiter is a queued write lock request, granted in arrival order when
+// fair queueing is enabled.
+//
+//msgp:ignore lockWaiter
+type lockWaiter struct {
+	UID    string
+	Owner  string
+	Source string
+	Quorum int
+	Since  int64
+}
+
 // isWriteLock returns whether the lock is a write or read lock.
 func isWriteLock(lri []lockRequesterInfo) bool {
 	return len(lri) == 1 && lri[0].Writer
@@ -64,7 +117,8 @@ type localLocker struct {
 	mutex     sync.Mutex
 	waitMutex atomic.Int32
 	lockMap   map[string][]lockRequesterInfo
-	lockUID   map[string]string // UUID -> resource map.
+	lockUID   map[string]string       // UUID -> resource map.
+	waitQueue map[string][]lockWaiter // queued writers per resource, fair queueing only.
 
 	// the following are updated on every cleanup defined in lockValidityDuration
 	readers         atomic.Int32
@@ -96,6 +150,73 @@ func (l *localLocker) canTakeLock(resources ...string) bool {
 	return true
 }
 
+// pruneWaiters drops queued waiters that stopped retrying.
+// Caller must hold 'l.mutex' lock.
+func (l *localLocker) pruneWaiters(resource string, now time.Time) {
+	waiters, ok := l.waitQueue[resource]
+	if !ok {
+		return
+	}
+	valid := waiters[:0]
+	for _, w := range waiters {
+		if now.Sub(time.Unix(0, w.Since)) <= lockWaiterExpiry {
+			valid = append(valid, w)
+		}
+	}
+	if len(valid) == 0 {
+		delete(l.waitQueue, resource)
+		return
+	}
+	l.waitQueue[resource] = valid
+}
+
+// queueWaiter adds args to the wait queue of resource, a waiter retrying
+// keeps its original position but has its expiry refreshed.
+// Caller must hold 'l.mutex' lock.
+func (l *localLocker) queueWaiter(resource string, args dsync.LockArgs, now time.Time) {
+	for i, w := range l.waitQueue[resource] {
+		if w.UID == args.UID && w.Owner == args.Owner {
+			l.waitQueue[resource][i].Since = now.UnixNano()
+			return
+		}
+	}
+	l.waitQueue[resource] = append(l.waitQueue[resource], lockWaiter{
+		UID:    args.UID,
+		Owner:  args.Owner,
+		Source: args.Source,
+		Quorum: *args.Quorum,
+		Since:  now.UnixNano(),
+	})
+}
+
+// turnOf returns if args is first in line for resource, an empty queue
+// means any requester may take the lock.
+// Caller must hold 'l.mutex' lock.
+func (l *localLocker) turnOf(resource string, args dsync.LockArgs) bool {
+	waiters := l.waitQueue[resource]
+	if len(waiters) == 0 {
+		return true
+	}
+	return waiters[0].UID == args.UID && waiters[0].Owner == args.Owner
+}
+
+// dequeueWaiter removes args from the wait queue of resource.
+// Caller must hold 'l.mutex' lock.
+func (l *localLocker) dequeueWaiter(resource string, args dsync.LockArgs) {
+	waiters := l.waitQueue[resource]
+	for i, w := range waiters {
+		if w.UID == args.UID && w.Owner == args.Owner {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(l.waitQueue, resource)
+		return
+	}
+	l.waitQueue[resource] = waiters
+}
+
 func (l *localLocker) Lock(ctx context.Context, args dsync.LockArgs) (reply bool, err error) {
 	if len(args.Resources) > maxDeleteList {
 		return false, fmt.Errorf("internal error: localLocker.Lock called with more than %d resources", maxDeleteList)
@@ -111,7 +232,20 @@ func (l *localLocker) Lock(ctx context.Context, args dsync.LockArgs) (reply bool
 	if ctx.Err() != nil {
 		return false, ctx.Err()
 	}
-	if !l.canTakeLock(args.Resources...) {
+	now := UTCNow()
+	// Fair queueing applies to single resource write locks only, group
+	// locks keep the existing try-lock behavior.
+	if fifo := lockFairQueue() && len(args.Resources) == 1; fifo {
+		resource := args.Resources[0]
+		l.pruneWaiters(resource, now)
+		if !l.canTakeLock(resource) || !l.turnOf(resource, args) {
+			// Busy or not first in line, remember arrival order
+			// so the retry is granted ahead of newer requests.
+			l.queueWaiter(resource, args, now)
+			return false, nil
+		}
+		l.dequeueWaiter(resource, args)
+	} else if !l.canTakeLock(args.Resources...) {
 		// Not all locks can be taken on resources,
 		// reject it completely.
 		return false, nil
@@ -119,7 +253,6 @@ func (l *localLocker) Lock(ctx context.Context, args dsync.LockArgs) (reply bool
 
 	// No locks held on the all resources, so claim write
 	// lock on all resources at once.
-	now := UTCNow()
 	for i, resource := range args.Resources {
 		l.lockMap[resource] = []lockRequesterInfo{
 			{
@@ -132,6 +265,7 @@ func (l *localLocker) Lock(ctx context.Context, args dsync.LockArgs) (reply bool
 				TimeLastRefresh: now.UnixNano(),
 				Group:           len(args.Resources) > 1,
 				Quorum:          *args.Quorum,
+				LeaseExpiry:     leaseExpiryOf(args, now),
 				idx:             i,
 			},
 		}
@@ -207,6 +341,14 @@ func (l *localLocker) RLock(ctx context.Context, args dsync.LockArgs) (reply boo
 	}
 	resource := args.Resources[0]
 	now := UTCNow()
+	if lockFairQueue() {
+		// Refuse new read locks while a writer is queued, so readers
+		// cannot starve the waiting writer indefinitely.
+		l.pruneWaiters(resource, now)
+		if len(l.waitQueue[resource]) > 0 {
+			return false, nil
+		}
+	}
 	lrInfo := lockRequesterInfo{
 		Name:            resource,
 		Writer:          false,
@@ -216,6 +358,7 @@ func (l *localLocker) RLock(ctx context.Context, args dsync.LockArgs) (reply boo
 		Timestamp:       now.UnixNano(),
 		TimeLastRefresh: now.UnixNano(),
 		Quorum:          *args.Quorum,
+		LeaseExpiry:     leaseExpiryOf(args, now),
 	}
 	lri, ok := l.lockMap[resource]
 	if ok {
@@ -286,6 +429,24 @@ func (l *localLocker) DupLockMap() localLockMap {
 		}
 		lockCopy[k] = append(make([]lockRequesterInfo, 0, len(v)), v...)
 	}
+	now := UTCNow()
+	for k := range l.waitQueue {
+		l.pruneWaiters(k, now)
+		for _, w := range l.waitQueue[k] {
+			lockCopy[k] = append(lockCopy[k], lockRequesterInfo{
+				Name:      k,
+				Writer:    true,
+				UID:       w.UID,
+				Owner:     w.Owner,
+				Source:    w.Source,
+				Timestamp: w.Since,
+				// Waiters are queued per server, quorum of one keeps
+				// them visible in the top locks output.
+				Quorum: 1,
+				Queued: true,
+			})
+		}
+	}
 	return lockCopy
 }
 
@@ -391,6 +552,10 @@ func (l *localLocker) Refresh(ctx context.Context, args dsync.LockArgs) (refresh
 		for i := range lris {
 			if lris[i].UID == args.UID {
 				lris[i].TimeLastRefresh = now.UnixNano()
+				if args.Lease > 0 {
+					// Heartbeat renews the lease.
+					lris[i].LeaseExpiry = leaseExpiryOf(args, now)
+				}
 			}
 		}
 		idx++
@@ -407,12 +572,22 @@ func (l *localLocker) Refresh(ctx context.Context, args dsync.LockArgs) (refresh
 func (l *localLocker) expireOldLocks(interval time.Duration) {
 	defer l.getMutex()()
 
+	now := UTCNow()
+	expired := func(lri *lockRequesterInfo) bool {
+		if lri.LeaseExpiry > 0 {
+			// Reclaim deterministically once the lease ran out
+			// without the holder renewing it.
+			return now.UnixNano() > lri.LeaseExpiry
+		}
+		return now.Sub(time.Unix(0, lri.TimeLastRefresh)) > interval
+	}
+
 	var readers, writers int32
 	for k, lris := range l.lockMap {
 		modified := false
 		for i := 0; i < len(lris); {
 			lri := &lris[i]
-			if time.Since(time.Unix(0, lri.TimeLastRefresh)) > interval {
+			if expired(lri) {
 				delete(l.lockUID, formatUUID(lri.UID, lri.idx))
 				if len(lris) == 1 {
 					// Remove the write lock.
@@ -446,7 +621,8 @@ func (l *localLocker) expireOldLocks(interval time.Duration) {
 
 func newLocker() *localLocker {
 	return &localLocker{
-		lockMap: make(map[string][]lockRequesterInfo, 1000),
-		lockUID: make(map[string]string, 1000),
+		lockMap:   make(map[string][]lockRequesterInfo, 1000),
+		lockUID:   make(map[string]string, 1000),
+		waitQueue: make(map[string][]lockWaiter),
 	}
 }
@@ -241,6 +241,18 @@ func (z *lockRequesterInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Quorum")
 				return
 			}
+		case "Queued":
+			z.Queued, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Queued")
+				return
+			}
+		case "LeaseExpiry":
+			z.LeaseExpiry, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "LeaseExpiry")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -254,9 +266,9 @@ func (z *lockRequesterInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *lockRequesterInfo) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 9
+	// map header, size 11
 	// write "Name"
-	err = en.Append(0x89, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	err = en.Append(0x8b, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	if err != nil {
 		return
 	}
@@ -345,15 +357,35 @@ func (z *lockRequesterInfo) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Quorum")
 		return
 	}
+	// write "Queued"
+	err = en.Append(0xa6, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Queued)
+	if err != nil {
+		err = msgp.WrapError(err, "Queued")
+		return
+	}
+	// write "LeaseExpiry"
+	err = en.Append(0xab, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.LeaseExpiry)
+	if err != nil {
+		err = msgp.WrapError(err, "LeaseExpiry")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *lockRequesterInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 9
+	// map header, size 11
 	// string "Name"
-	o = append(o, 0x89, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = append(o, 0x8b, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	o = msgp.AppendString(o, z.Name)
 	// string "Writer"
 	o = append(o, 0xa6, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72)
@@ -379,6 +411,12 @@ func (z *lockRequesterInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "Quorum"
 	o = append(o, 0xa6, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d)
 	o = msgp.AppendInt(o, z.Quorum)
+	// string "Queued"
+	o = append(o, 0xa6, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.Queued)
+	// string "LeaseExpiry"
+	o = append(o, 0xab, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79)
+	o = msgp.AppendInt64(o, z.LeaseExpiry)
 	return
 }
 
@@ -454,6 +492,18 @@ func (z *lockRequesterInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Quorum")
 				return
 			}
+		case "Queued":
+			z.Queued, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Queued")
+				return
+			}
+		case "LeaseExpiry":
+			z.LeaseExpiry, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LeaseExpiry")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -468,7 +518,7 @@ func (z *lockRequesterInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *lockRequesterInfo) Msgsize() (s int) {
-	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 7 + msgp.BoolSize + 4 + msgp.StringPrefixSize + len(z.UID) + 10 + msgp.Int64Size + 16 + msgp.Int64Size + 7 + msgp.StringPrefixSize + len(z.Source) + 6 + msgp.BoolSize + 6 + msgp.StringPrefixSize + len(z.Owner) + 7 + msgp.IntSize
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 7 + msgp.BoolSize + 4 + msgp.StringPrefixSize + len(z.UID) + 10 + msgp.Int64Size + 16 + msgp.Int64Size + 7 + msgp.StringPrefixSize + len(z.Source) + 6 + msgp.BoolSize + 6 + msgp.StringPrefixSize + len(z.Owner) + 7 + msgp.IntSize + 7 + msgp.BoolSize + 12 + msgp.Int64Size
 	return
 }
 
@@ -441,3 +441,103 @@ func Test_localLocker_RUnlock(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalLockerFairQueue(t *testing.T) {
+	lockFairQueueOnce.Do(func() {})
+	lockFairQueueEnabled = true
+	t.Cleanup(func() { lockFairQueueEnabled = false })
+
+	quorum := 0
+	l := newLocker()
+	ctx := context.Background()
+	resource := mustGetUUID()
+	argFor := func(uid string) dsync.LockArgs {
+		return dsync.LockArgs{
+			UID:       uid,
+			Resources: []string{resource},
+			Source:    t.Name(),
+			Owner:     "owner",
+			Quorum:    &quorum,
+		}
+	}
+
+	holder, first, second := argFor(mustGetUUID()), argFor(mustGetUUID()), argFor(mustGetUUID())
+	ok, err := l.Lock(ctx, holder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("did not get write lock")
+	}
+
+	// Contending writers are queued in arrival order.
+	for _, arg := range []dsync.LockArgs{first, second} {
+		ok, err = l.Lock(ctx, arg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			t.Fatal("got write lock while held")
+		}
+	}
+	if len(l.waitQueue[resource]) != 2 {
+		t.Fatalf("expected 2 queued waiters, got %d", len(l.waitQueue[resource]))
+	}
+
+	// New read locks are refused while writers are queued.
+	reader := argFor(mustGetUUID())
+	ok, err = l.RLock(ctx, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("got read lock while writers are queued")
+	}
+
+	if _, err = l.Unlock(ctx, holder); err != nil {
+		t.Fatal(err)
+	}
+
+	// The lock is free, but 'second' is not first in line.
+	ok, err = l.Lock(ctx, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("second waiter got write lock out of order")
+	}
+
+	ok, err = l.Lock(ctx, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("first waiter did not get write lock")
+	}
+	if _, err = l.Unlock(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err = l.Lock(ctx, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("second waiter did not get write lock")
+	}
+	if _, err = l.Unlock(ctx, second); err != nil {
+		t.Fatal(err)
+	}
+
+	// Queue has drained, read locks are granted again.
+	ok, err = l.RLock(ctx, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("did not get read lock with empty queue")
+	}
+	if len(l.waitQueue) != 0 {
+		t.Fatalf("expected empty wait queue, got %d entries", len(l.waitQueue))
+	}
+}
@@ -111,11 +111,13 @@ func init() {
 		getBucketUsageMetrics(MetricsGroupOpts{dependGlobalObjectAPI: true}),
 		getHTTPMetrics(MetricsGroupOpts{bucketOnly: true}),
 		getBucketTTFBMetric(),
+		getBucketLockMetrics(),
 	}
 
 	bucketPeerMetricsGroups = []*MetricsGroupV2{
 		getHTTPMetrics(MetricsGroupOpts{bucketOnly: true}),
 		getBucketTTFBMetric(),
+		getBucketLockMetrics(),
 	}
 
 	nodeCollector = newMinioCollectorNode(nodeGroups)
@@ -1936,6 +1938,73 @@ func getBucketTTFBMetric() *MetricsGroupV2 {
 	return mg
 }
 
+func getBucketLockWaitDistributionMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: "locks",
+		Name:      "wait_seconds_distribution",
+		Help:      "Distribution of time spent waiting to acquire namespace locks per bucket",
+		Type:      gaugeMetric,
+	}
+}
+
+func getBucketLockAcquiredTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: "locks",
+		Name:      "acquired_total",
+		Help:      "Total number of namespace locks acquired per bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketLockContentionsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: "locks",
+		Name:      "contentions_total",
+		Help:      "Total number of contended namespace lock acquisitions per bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketLockTimeoutsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: "locks",
+		Name:      "timeouts_total",
+		Help:      "Total number of namespace lock acquisition timeouts per bucket",
+		Type:      counterMetric,
+	}
+}
+
+func getBucketLockMetrics() *MetricsGroupV2 {
+	mg := &MetricsGroupV2{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(ctx context.Context) []MetricV2 {
+		metrics := getHistogramMetrics(bucketLockWaitDuration,
+			getBucketLockWaitDistributionMD(), false, true)
+		for bucket, st := range globalBucketLockMetrics.report() {
+			metrics = append(metrics, MetricV2{
+				Description:    getBucketLockAcquiredTotalMD(),
+				VariableLabels: map[string]string{"bucket": bucket},
+				Value:          float64(st.Acquired),
+			}, MetricV2{
+				Description:    getBucketLockContentionsTotalMD(),
+				VariableLabels: map[string]string{"bucket": bucket},
+				Value:          float64(st.Contentions),
+			}, MetricV2{
+				Description:    getBucketLockTimeoutsTotalMD(),
+				VariableLabels: map[string]string{"bucket": bucket},
+				Value:          float64(st.Timeouts),
+			})
+		}
+		return metrics
+	})
+	return mg
+}
+
 func getS3TTFBMetric() *MetricsGroupV2 {
 	mg := &MetricsGroupV2{
 		cacheInterval: 10 * time.Second,
@@ -2182,6 +2251,36 @@ func getScannerNodeMetrics() *MetricsGroupV2 {
 				},
 				Value: float64(globalScannerMetrics.lifetime(scannerMetricScanBucketDrive)),
 			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: scannerSubsystem,
+					Name:      "speed_level",
+					Help:      "Current adaptive scanner speed level from 0 (fastest) to 4 (slowest), -1 if adaptive speed is disabled",
+					Type:      gaugeMetric,
+				},
+				Value: float64(globalAdaptiveScanner.currentLevel()),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: scannerSubsystem,
+					Name:      "stale_uploads_removed",
+					Help:      "Total number of stale multipart uploads removed since server uptime",
+					Type:      counterMetric,
+				},
+				Value: float64(globalStaleUploadsMetrics.removedUploads.Load()),
+			},
+			{
+				Description: MetricDescription{
+					Namespace: nodeMetricNamespace,
+					Subsystem: scannerSubsystem,
+					Name:      "stale_uploads_bytes_reclaimed",
+					Help:      "Total space reclaimed by removing stale multipart uploads since server uptime",
+					Type:      counterMetric,
+				},
+				Value: float64(globalStaleUploadsMetrics.reclaimedBytes.Load()),
+			},
 			{
 				Description: MetricDescription{
 					Namespace: nodeMetricNamespace,
@@ -41,6 +41,10 @@ const (
 
 	apiRequestsTTFBSecondsDistribution MetricName = "ttfb_seconds_distribution"
 
+	apiRequestsDurationSecondsDistribution MetricName = "duration_seconds_distribution"
+	apiRequestsRecvBytesDistribution       MetricName = "received_bytes_distribution"
+	apiRequestsSentBytesDistribution       MetricName = "sent_bytes_distribution"
+
 	apiTrafficSentBytes MetricName = "traffic_sent_bytes"
 	apiTrafficRecvBytes MetricName = "traffic_received_bytes"
 )
@@ -166,6 +170,16 @@ var (
 	bucketAPIRequestsTTFBSecondsDistributionMD = NewCounterMD(apiRequestsTTFBSecondsDistribution,
 		"Distribution of time to first byte across API calls for a bucket",
 		"bucket", "name", "le", "type")
+
+	bucketAPIRequestsDurationSecondsDistributionMD = NewCounterMD(apiRequestsDurationSecondsDistribution,
+		"Distribution of request duration across API calls for a bucket (requires "+EnvBucketHistograms+"=on)",
+		"bucket", "name", "le", "type")
+	bucketAPIRequestsRecvBytesDistributionMD = NewCounterMD(apiRequestsRecvBytesDistribution,
+		"Distribution of request sizes across API calls for a bucket (requires "+EnvBucketHistograms+"=on)",
+		"bucket", "name", "le", "type")
+	bucketAPIRequestsSentBytesDistributionMD = NewCounterMD(apiRequestsSentBytesDistribution,
+		"Distribution of response sizes across API calls for a bucket (requires "+EnvBucketHistograms+"=on)",
+		"bucket", "name", "le", "type")
 )
 
 // loadBucketAPIHTTPMetrics - loads bucket level S3 HTTP metrics.
@@ -222,3 +236,19 @@ func loadBucketAPITTFBMetrics(ctx context.Context, m MetricValues, _ *metricsCac
 		buckets, "type", "s3")
 	return nil
 }
+
+// loadBucketAPIHistogramMetrics - loads the opt-in per-bucket request
+// duration and request/response size histograms.
+//
+// This is a `MetricsLoaderFn`.
+func loadBucketAPIHistogramMetrics(ctx context.Context, m MetricValues, _ *metricsCache, buckets []string) error {
+	renameLabels := map[string]string{"api": "name"}
+	labelsFilter := map[string]set.StringSet{}
+	m.SetHistogram(apiRequestsDurationSecondsDistribution, bucketHTTPRequestsTotalDuration, labelsFilter,
+		renameLabels, buckets, "type", "s3")
+	m.SetHistogram(apiRequestsRecvBytesDistribution, bucketHTTPRequestsRecvBytes, labelsFilter,
+		renameLabels, buckets, "type", "s3")
+	m.SetHistogram(apiRequestsSentBytesDistribution, bucketHTTPRequestsSentBytes, labelsFilter,
+		renameLabels, buckets, "type", "s3")
+	return nil
+}
@@ -20,6 +20,7 @@ package cmd
 import (
 	"context"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/minio/madmin-go/v3"
 )
@@ -55,12 +56,19 @@ const (
 	driveAvailabilityErrorsTotal = "availability_errors_total"
 	driveWaitingIO               = "waiting_io"
 	driveAPILatencyMicros        = "api_latency_micros"
+	driveAPIErrorsTotal          = "api_errors_total"
 	driveHealth                  = "health"
 
 	driveOfflineCount = "offline_count"
 	driveOnlineCount  = "online_count"
 	driveCount        = "count"
 
+	driveHedgedReads    = "hedged_reads_total"
+	driveHedgedReadsWon = "hedged_reads_won_total"
+
+	driveODirectWrites  = "odirect_writes_total"
+	driveBufferedWrites = "buffered_writes_total"
+
 	// iostat related
 	driveReadsPerSec    = "reads_per_sec"
 	driveReadsKBPerSec  = "reads_kb_per_sec"
@@ -96,6 +104,9 @@ var (
 	driveAPILatencyMD = NewGaugeMD(driveAPILatencyMicros,
 		"Average last minute latency in µs for drive API storage operations",
 		append(allDriveLabels, apiL)...)
+	driveAPIErrorsMD = NewCounterMD(driveAPIErrorsTotal,
+		"Total failed drive API storage operations",
+		append(allDriveLabels, apiL)...)
 	driveHealthMD = NewGaugeMD(driveHealth,
 		"Drive health (0 = offline, 1 = healthy, 2 = healing)", allDriveLabels...)
 
@@ -106,6 +117,16 @@ var (
 	driveCountMD = NewGaugeMD(driveCount,
 		"Count of all drives")
 
+	driveHedgedReadsMD = NewCounterMD(driveHedgedReads,
+		"Total erasure stripe reads where a hedged shard read was issued")
+	driveHedgedReadsWonMD = NewCounterMD(driveHedgedReadsWon,
+		"Total hedged reads where the extra shard read completed the stripe first")
+
+	driveODirectWritesMD = NewCounterMD(driveODirectWrites,
+		"Total object writes that used O_DIRECT")
+	driveBufferedWritesMD = NewCounterMD(driveBufferedWrites,
+		"Total object writes that used buffered I/O")
+
 	// iostat related
 	driveReadsPerSecMD = NewGaugeMD(driveReadsPerSec,
 		"Reads per second on a drive",
@@ -203,6 +224,32 @@ func (m *MetricValues) setDriveIOStatMetrics(ioStats driveIOStatMetrics, labels
 	m.Set(drivePercUtil, ioStats.percUtil, labels...)
 }
 
+func (m *MetricValues) setDriveAPIErrorMetrics(p *xlStorageDiskIDCheck, labels []string) {
+	// Append the api label for the drive API error counts.
+	labels = append(labels, apiL, "")
+	lastIdx := len(labels) - 1
+	for i := range p.apiErrs {
+		if errs := atomic.LoadUint64(&p.apiErrs[i]); errs > 0 {
+			labels[lastIdx] = "storage." + storageMetric(i).String()
+			m.Set(driveAPIErrorsTotal, float64(errs), labels...)
+		}
+	}
+}
+
+// localDriveTrackers returns the local drive trackers keyed by drive path.
+func localDriveTrackers() map[string]*xlStorageDiskIDCheck {
+	globalLocalDrivesMu.RLock()
+	defer globalLocalDrivesMu.RUnlock()
+
+	trackers := make(map[string]*xlStorageDiskIDCheck, len(globalLocalDrivesMap))
+	for _, drive := range globalLocalDrivesMap {
+		if p, ok := drive.(*xlStorageDiskIDCheck); ok {
+			trackers[p.String()] = p
+		}
+	}
+	return trackers
+}
+
 // loadDriveMetrics - `MetricsLoaderFn` for node drive metrics.
 func loadDriveMetrics(ctx context.Context, m MetricValues, c *metricsCache) error {
 	driveMetrics, err := c.driveMetrics.Get()
@@ -211,6 +258,7 @@ func loadDriveMetrics(ctx context.Context, m MetricValues, c *metricsCache) erro
 		return nil
 	}
 
+	trackers := localDriveTrackers()
 	for _, disk := range driveMetrics.storageInfo.Disks {
 		labels := []string{
 			driveL, disk.DrivePath,
@@ -224,11 +272,20 @@ func loadDriveMetrics(ctx context.Context, m MetricValues, c *metricsCache) erro
 			m.setDriveIOStatMetrics(dm, labels)
 		}
 		m.setDriveAPIMetrics(disk, labels)
+		if p, found := trackers[disk.DrivePath]; found {
+			m.setDriveAPIErrorMetrics(p, labels)
+		}
 	}
 
 	m.Set(driveOfflineCount, float64(driveMetrics.offlineDrives))
 	m.Set(driveOnlineCount, float64(driveMetrics.onlineDrives))
 	m.Set(driveCount, float64(driveMetrics.totalDrives))
 
+	m.Set(driveHedgedReads, float64(hedgedReadsTotal.Load()))
+	m.Set(driveHedgedReadsWon, float64(hedgedReadsWonTotal.Load()))
+
+	m.Set(driveODirectWrites, float64(odirectWritesTotal.Load()))
+	m.Set(driveBufferedWrites, float64(bufferedWritesTotal.Load()))
+
 	return nil
 }
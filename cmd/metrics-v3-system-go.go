@@ -0,0 +1,187 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"math"
+	"runtime"
+	runtimemetrics "runtime/metrics"
+	"strings"
+)
+
+const (
+	goHeapInuseBytes          = "heap_inuse_bytes"
+	goHeapRetainedBytes       = "heap_retained_bytes"
+	goHeapSubsystemInuseBytes = "heap_subsystem_inuse_bytes"
+	goAllocatedTotalBytes     = "allocated_total_bytes"
+	goGCCyclesTotal           = "gc_cycles_total"
+	goGCPauseTotalSeconds     = "gc_pause_total_seconds"
+	goGCPauseP50Seconds       = "gc_pause_p50_seconds"
+	goGCPauseP99Seconds       = "gc_pause_p99_seconds"
+	goGCPauseMaxSeconds       = "gc_pause_max_seconds"
+	goSchedLatencyP99Seconds  = "sched_latency_p99_seconds"
+)
+
+var (
+	goHeapInuseBytesMD          = NewGaugeMD(goHeapInuseBytes, "Heap memory in use in bytes")
+	goHeapRetainedBytesMD       = NewGaugeMD(goHeapRetainedBytes, "Idle heap memory retained from the OS in bytes")
+	goHeapSubsystemInuseBytesMD = NewGaugeMD(goHeapSubsystemInuseBytes, "Sampled heap memory in use attributed to a server subsystem in bytes", "subsystem")
+	goAllocatedTotalBytesMD     = NewCounterMD(goAllocatedTotalBytes, "Total heap memory allocated since server start in bytes")
+	goGCCyclesTotalMD           = NewCounterMD(goGCCyclesTotal, "Total number of completed GC cycles since server start")
+	goGCPauseTotalSecondsMD     = NewCounterMD(goGCPauseTotalSeconds, "Total stop-the-world GC pause time since server start in seconds")
+	goGCPauseP50SecondsMD       = NewGaugeMD(goGCPauseP50Seconds, "50th percentile of stop-the-world GC pause latency in seconds")
+	goGCPauseP99SecondsMD       = NewGaugeMD(goGCPauseP99Seconds, "99th percentile of stop-the-world GC pause latency in seconds")
+	goGCPauseMaxSecondsMD       = NewGaugeMD(goGCPauseMaxSeconds, "Maximum observed stop-the-world GC pause latency in seconds")
+	goSchedLatencyP99SecondsMD  = NewGaugeMD(goSchedLatencyP99Seconds, "99th percentile of goroutine scheduling latency in seconds")
+)
+
+// goMemSubsystems maps substrings of allocation site function names to the
+// server subsystem charged for the allocation. Frames are examined innermost
+// first and the first match wins.
+var goMemSubsystems = []struct {
+	substr    string
+	subsystem string
+}{
+	{"metacache", "listing"},
+	{"listPath", "listing"},
+	{"WalkDir", "listing"},
+	{"heal", "healing"},
+	{"Heal", "healing"},
+	{"scannerItem", "scanner"},
+	{"scanDataFolder", "scanner"},
+	{"dataUsage", "scanner"},
+	{"replicat", "replication"},
+	{"Replicat", "replication"},
+	{"Multipart", "multipart"},
+	{"minio/internal/grid", "grid"},
+	{"objectAPIHandlers", "api"},
+}
+
+// allocSiteSubsystem classifies an allocation stack to a server subsystem,
+// skipping frames outside of this code base.
+func allocSiteSubsystem(stk []uintptr) string {
+	for _, pc := range stk {
+		fn := runtime.FuncForPC(pc)
+		if fn == nil {
+			continue
+		}
+		name := fn.Name()
+		if !strings.Contains(name, "minio/minio") {
+			continue
+		}
+		for _, c := range goMemSubsystems {
+			if strings.Contains(name, c.substr) {
+				return c.subsystem
+			}
+		}
+	}
+	return "other"
+}
+
+// loadGoHeapSubsystemMetrics attributes sampled in-use heap memory to server
+// subsystems using the allocation profile, so memory growth can be traced to
+// e.g. listing vs healing instead of a single opaque heap number.
+func loadGoHeapSubsystemMetrics(m MetricValues) {
+	n, _ := runtime.MemProfile(nil, true)
+	prof := make([]runtime.MemProfileRecord, n+50)
+	n, ok := runtime.MemProfile(prof, true)
+	if !ok {
+		return
+	}
+	inuse := make(map[string]uint64)
+	for i := range prof[:n] {
+		r := &prof[i]
+		b := r.InUseBytes()
+		if b <= 0 {
+			continue
+		}
+		inuse[allocSiteSubsystem(r.Stack())] += uint64(b)
+	}
+	for subsystem, b := range inuse {
+		m.Set(goHeapSubsystemInuseBytes, float64(b), "subsystem", subsystem)
+	}
+}
+
+// histQuantile returns an upper bound estimate for the q'th quantile of a
+// runtime/metrics histogram.
+func histQuantile(h *runtimemetrics.Float64Histogram, q float64) float64 {
+	var total uint64
+	for _, c := range h.Counts {
+		total += c
+	}
+	if total == 0 {
+		return 0
+	}
+	thresh := uint64(q * float64(total))
+	var running uint64
+	for i, c := range h.Counts {
+		running += c
+		if running > thresh {
+			if v := h.Buckets[i+1]; !math.IsInf(v, 1) {
+				return v
+			}
+			return h.Buckets[i]
+		}
+	}
+	return h.Buckets[len(h.Buckets)-1]
+}
+
+// histMax returns the upper bound of the highest non-empty bucket of a
+// runtime/metrics histogram.
+func histMax(h *runtimemetrics.Float64Histogram) float64 {
+	for i := len(h.Counts) - 1; i >= 0; i-- {
+		if h.Counts[i] == 0 {
+			continue
+		}
+		if v := h.Buckets[i+1]; !math.IsInf(v, 1) {
+			return v
+		}
+		return h.Buckets[i]
+	}
+	return 0
+}
+
+// loadGoRuntimeMetrics - `MetricsLoaderFn` for curated Go runtime metrics.
+func loadGoRuntimeMetrics(_ context.Context, m MetricValues, _ *metricsCache) error {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	m.Set(goHeapInuseBytes, float64(mem.HeapInuse))
+	m.Set(goHeapRetainedBytes, float64(mem.HeapIdle-mem.HeapReleased))
+	m.Set(goAllocatedTotalBytes, float64(mem.TotalAlloc))
+	m.Set(goGCCyclesTotal, float64(mem.NumGC))
+	m.Set(goGCPauseTotalSeconds, float64(mem.PauseTotalNs)/1e9)
+
+	samples := []runtimemetrics.Sample{
+		{Name: "/gc/pauses:seconds"},
+		{Name: "/sched/latencies:seconds"},
+	}
+	runtimemetrics.Read(samples)
+	if samples[0].Value.Kind() == runtimemetrics.KindFloat64Histogram {
+		h := samples[0].Value.Float64Histogram()
+		m.Set(goGCPauseP50Seconds, histQuantile(h, 0.50))
+		m.Set(goGCPauseP99Seconds, histQuantile(h, 0.99))
+		m.Set(goGCPauseMaxSeconds, histMax(h))
+	}
+	if samples[1].Value.Kind() == runtimemetrics.KindFloat64Histogram {
+		m.Set(goSchedLatencyP99Seconds, histQuantile(samples[1].Value.Float64Histogram(), 0.99))
+	}
+
+	loadGoHeapSubsystemMetrics(m)
+	return nil
+}
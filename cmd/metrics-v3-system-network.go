@@ -29,6 +29,9 @@ const (
 	internodeDialAvgTimeNanos MetricName = "dial_avg_time_nanos"
 	internodeSentBytesTotal   MetricName = "sent_bytes_total"
 	internodeRecvBytesTotal   MetricName = "recv_bytes_total"
+
+	internodeCompressedSentBytesTotal   MetricName = "compressed_sent_bytes_total"
+	internodeCompressionSavedBytesTotal MetricName = "compression_saved_bytes_total"
 )
 
 var (
@@ -42,6 +45,10 @@ var (
 		"Total number of bytes sent to other peer nodes")
 	internodeRecvBytesTotalMD = NewCounterMD(internodeRecvBytesTotal,
 		"Total number of bytes received from other peer nodes")
+	internodeCompressedSentBytesTotalMD = NewCounterMD(internodeCompressedSentBytesTotal,
+		"Total number of storage RPC response payload bytes sent after optional s2 compression")
+	internodeCompressionSavedBytesTotalMD = NewCounterMD(internodeCompressionSavedBytesTotal,
+		"Total number of storage RPC response payload bytes saved by s2 compression")
 )
 
 // loadNetworkInternodeMetrics - reads internode network metrics.
@@ -56,6 +63,8 @@ func loadNetworkInternodeMetrics(ctx context.Context, m MetricValues, _ *metrics
 		m.Set(internodeDialAvgTimeNanos, float64(rpcStats.DialAvgDuration))
 		m.Set(internodeSentBytesTotal, float64(connStats.internodeOutputBytes))
 		m.Set(internodeRecvBytesTotal, float64(connStats.internodeInputBytes))
+		m.Set(internodeCompressedSentBytesTotal, float64(globalStorageRESTCompressionStats.sentBytes.Load()))
+		m.Set(internodeCompressionSavedBytesTotal, float64(globalStorageRESTCompressionStats.savedBytes.Load()))
 	}
 	return nil
 }
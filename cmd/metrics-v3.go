@@ -44,6 +44,7 @@ const (
 	systemMemoryCollectorPath           collectorPath = "/system/memory"
 	systemCPUCollectorPath              collectorPath = "/system/cpu"
 	systemProcessCollectorPath          collectorPath = "/system/process"
+	systemGoCollectorPath               collectorPath = "/system/go"
 
 	debugGoCollectorPath collectorPath = "/debug/go"
 
@@ -115,8 +116,12 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 			bucketAPIRequests5xxErrorsMD,
 
 			bucketAPIRequestsTTFBSecondsDistributionMD,
+
+			bucketAPIRequestsDurationSecondsDistributionMD,
+			bucketAPIRequestsRecvBytesDistributionMD,
+			bucketAPIRequestsSentBytesDistributionMD,
 		},
-		JoinBucketLoaders(loadBucketAPIHTTPMetrics, loadBucketAPITTFBMetrics),
+		JoinBucketLoaders(loadBucketAPIHTTPMetrics, loadBucketAPITTFBMetrics, loadBucketAPIHistogramMetrics),
 	)
 
 	bucketReplicationMG := NewBucketMetricsGroup(bucketReplicationCollectorPath,
@@ -151,6 +156,8 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 			internodeDialAvgTimeNanosMD,
 			internodeSentBytesTotalMD,
 			internodeRecvBytesTotalMD,
+			internodeCompressedSentBytesTotalMD,
+			internodeCompressionSavedBytesTotalMD,
 		},
 		loadNetworkInternodeMetrics,
 	)
@@ -206,6 +213,22 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 		loadProcessMetrics,
 	)
 
+	systemGoMG := NewMetricsGroup(systemGoCollectorPath,
+		[]MetricDescriptor{
+			goHeapInuseBytesMD,
+			goHeapRetainedBytesMD,
+			goHeapSubsystemInuseBytesMD,
+			goAllocatedTotalBytesMD,
+			goGCCyclesTotalMD,
+			goGCPauseTotalSecondsMD,
+			goGCPauseP50SecondsMD,
+			goGCPauseP99SecondsMD,
+			goGCPauseMaxSecondsMD,
+			goSchedLatencyP99SecondsMD,
+		},
+		loadGoRuntimeMetrics,
+	)
+
 	systemDriveMG := NewMetricsGroup(systemDriveCollectorPath,
 		[]MetricDescriptor{
 			driveUsedBytesMD,
@@ -219,6 +242,11 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 			driveAvailabilityErrorsMD,
 			driveWaitingIOMD,
 			driveAPILatencyMD,
+			driveAPIErrorsMD,
+			driveHedgedReadsMD,
+			driveHedgedReadsWonMD,
+			driveODirectWritesMD,
+			driveBufferedWritesMD,
 			driveHealthMD,
 
 			driveOfflineCountMD,
@@ -405,6 +433,7 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 		systemMemoryMG,
 		systemCPUMG,
 		systemProcessMG,
+		systemGoMG,
 
 		clusterHealthMG,
 		clusterUsageObjectsMG,
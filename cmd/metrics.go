@@ -46,6 +46,30 @@ var (
 		},
 		[]string{"api", "bucket"},
 	)
+	bucketHTTPRequestsTotalDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "s3_requests_duration_seconds",
+			Help:    "Total time taken by requests served by current MinIO server instance per bucket",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"api", "bucket"},
+	)
+	bucketHTTPRequestsRecvBytes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "s3_requests_received_bytes",
+			Help:    "Size of requests served by current MinIO server instance per bucket",
+			Buckets: prometheus.ExponentialBuckets(1024, 4, 10),
+		},
+		[]string{"api", "bucket"},
+	)
+	bucketHTTPRequestsSentBytes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "s3_requests_sent_bytes",
+			Help:    "Size of responses served by current MinIO server instance per bucket",
+			Buckets: prometheus.ExponentialBuckets(1024, 4, 10),
+		},
+		[]string{"api", "bucket"},
+	)
 	minioVersionInfo = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "minio",
@@ -156,6 +156,7 @@ func (n *nsLockMap) unlock(volume string, path string, readLock bool) {
 // dsync's distributed lock instance.
 type distLockInstance struct {
 	rwMutex *dsync.DRWMutex
+	volume  string
 	opsID   string
 }
 
@@ -174,9 +175,12 @@ func (di *distLockInstance) GetLock(ctx context.Context, timeout *dynamicTimeout
 		if err := newCtx.Err(); err == context.Canceled {
 			return LockContext{ctx: ctx, cancel: func() {}}, err
 		}
+		globalBucketLockMetrics.record(ctx, di.volume, UTCNow().Sub(start), true)
 		return LockContext{ctx: ctx, cancel: func() {}}, OperationTimedOut{}
 	}
-	timeout.LogSuccess(UTCNow().Sub(start))
+	elapsed := UTCNow().Sub(start)
+	timeout.LogSuccess(elapsed)
+	globalBucketLockMetrics.record(ctx, di.volume, elapsed, false)
 	return LockContext{ctx: newCtx, cancel: cancel}, nil
 }
 
@@ -203,9 +207,12 @@ func (di *distLockInstance) GetRLock(ctx context.Context, timeout *dynamicTimeou
 		if errors.Is(newCtx.Err(), context.Canceled) {
 			return LockContext{ctx: ctx, cancel: func() {}}, newCtx.Err()
 		}
+		globalBucketLockMetrics.record(ctx, di.volume, UTCNow().Sub(start), true)
 		return LockContext{ctx: ctx, cancel: func() {}}, OperationTimedOut{}
 	}
-	timeout.LogSuccess(UTCNow().Sub(start))
+	elapsed := UTCNow().Sub(start)
+	timeout.LogSuccess(elapsed)
+	globalBucketLockMetrics.record(ctx, di.volume, elapsed, false)
 	return LockContext{ctx: newCtx, cancel: cancel}, nil
 }
 
@@ -236,7 +243,7 @@ func (n *nsLockMap) NewNSLock(lockers func() ([]dsync.NetLocker, string), volume
 			GetLockers: lockers,
 			Timeouts:   dsync.DefaultTimeouts,
 		}, pathsJoinPrefix(volume, paths...)...)
-		return &distLockInstance{drwmutex, opsID}
+		return &distLockInstance{drwmutex, volume, opsID}
 	}
 	return &localLockInstance{n, volume, paths, opsID}
 }
@@ -222,6 +222,13 @@ func (d *naughtyDisk) ReadParts(ctx context.Context, bucket string, partMetaPath
 	return d.disk.ReadParts(ctx, bucket, partMetaPaths...)
 }
 
+func (d *naughtyDisk) ReadVersions(ctx context.Context, volume string, req ReadVersionsReq) ([]ReadVersionResult, error) {
+	if err := d.calcError(); err != nil {
+		return nil, err
+	}
+	return d.disk.ReadVersions(ctx, volume, req)
+}
+
 func (d *naughtyDisk) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) error {
 	if err := d.calcError(); err != nil {
 		return err
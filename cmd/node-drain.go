@@ -0,0 +1,133 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+// nodeDrainState records when and by whom this node was put into drain.
+type nodeDrainState struct {
+	StartedAt time.Time `json:"startedAt"`
+	StartedBy string    `json:"startedBy,omitempty"`
+}
+
+// globalNodeDrain holds the drain state of this node, nil while the node is
+// serving normally. Drain is strictly node-local, it takes one node at a
+// time out of rotation for rolling maintenance - unlike read-only
+// maintenance mode which applies cluster wide.
+var globalNodeDrain atomic.Pointer[nodeDrainState]
+
+// nodeDrainStatus is the drain progress reported to the admin client, the
+// node is safe to shut down once ReadyForShutdown turns true.
+type nodeDrainStatus struct {
+	Draining  bool      `json:"draining"`
+	StartedAt time.Time `json:"startedAt,omitempty"`
+	StartedBy string    `json:"startedBy,omitempty"`
+
+	InFlightS3Requests   int `json:"inFlightS3Requests"`
+	ActiveReplicationOps int `json:"activeReplicationOps"`
+	QueuedReplicationMRF int `json:"queuedReplicationMRF"`
+	QueuedHealMRF        int `json:"queuedHealMRF"`
+
+	ReadyForShutdown bool `json:"readyForShutdown"`
+}
+
+// startNodeDrain stops this node from accepting new S3 requests and flushes
+// the queues it owns. Returns false if a drain is already in progress.
+func startNodeDrain(startedBy string) bool {
+	st := &nodeDrainState{StartedAt: UTCNow(), StartedBy: startedBy}
+	if !globalNodeDrain.CompareAndSwap(nil, st) {
+		return false
+	}
+	// Persist queued replication failures right away instead of waiting
+	// for the next save cycle, a following shutdown then loses no queued
+	// work even if it comes before the drain fully settles.
+	globalReplicationPool.Get().FlushMRF()
+	return true
+}
+
+// stopNodeDrain puts the node back into rotation, returns false if it was
+// not draining.
+func stopNodeDrain() bool {
+	return globalNodeDrain.Swap(nil) != nil
+}
+
+// nodeDrainActive returns if this node is currently draining.
+func nodeDrainActive() bool {
+	return globalNodeDrain.Load() != nil
+}
+
+// nodeDrainRedirect returns the URL prefix of a peer that clients shall be
+// redirected to while this node is draining, empty on single node
+// deployments.
+func nodeDrainRedirect() string {
+	for _, proxyEp := range globalProxyEndpoints {
+		if proxyEp.IsLocal {
+			continue
+		}
+		return proxyEp.Scheme + "://" + proxyEp.Host
+	}
+	return ""
+}
+
+// nodeDrainBlocked rejects r with a 503 while this node is draining,
+// pointing the client at a peer via the Location header when one is known.
+func nodeDrainBlocked(w http.ResponseWriter, r *http.Request) bool {
+	if !nodeDrainActive() {
+		return false
+	}
+	if host := nodeDrainRedirect(); host != "" {
+		w.Header().Set(xhttp.Location, host+r.URL.RequestURI())
+	}
+	return true
+}
+
+// localNodeDrainStatus reports the drain progress of this node. The node is
+// ready for shutdown once no S3 requests are in flight and the replication
+// and heal MRF queues it owns have been worked off.
+func localNodeDrainStatus() nodeDrainStatus {
+	var status nodeDrainStatus
+	if st := globalNodeDrain.Load(); st != nil {
+		status.Draining = true
+		status.StartedAt = st.StartedAt
+		status.StartedBy = st.StartedBy
+	}
+
+	for _, count := range globalHTTPStats.currentS3Requests.Load(false) {
+		status.InFlightS3Requests += count
+	}
+
+	if pool := globalReplicationPool.Get(); pool.initialized() {
+		status.ActiveReplicationOps = pool.ActiveWorkers() + pool.ActiveLrgWorkers() +
+			pool.ActiveBulkWorkers() + pool.ActiveMRFWorkers()
+		status.QueuedReplicationMRF = len(pool.mrfReplicaCh) + len(pool.mrfSaveCh)
+	}
+	status.QueuedHealMRF = len(globalMRFState.opCh)
+
+	status.ReadyForShutdown = status.Draining &&
+		status.InFlightS3Requests == 0 &&
+		status.ActiveReplicationOps == 0 &&
+		status.QueuedReplicationMRF == 0 &&
+		status.QueuedHealMRF == 0
+	return status
+}
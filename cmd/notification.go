@@ -1206,6 +1206,62 @@ func NewNotificationSys(endpoints EndpointServerPools) *NotificationSys {
 	}
 }
 
+// SetBucketBandwidthLimit - sets the egress bandwidth limit for a bucket on all nodes including self.
+func (sys *NotificationSys) SetBucketBandwidthLimit(ctx context.Context, bucket string, limit int64) {
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		index := index
+		g.Go(func() error {
+			return sys.peerClients[index].SetBucketBandwidthLimit(ctx, bucket, limit)
+		}, index)
+	}
+	if limit > 0 {
+		globalBucketMonitor.SetBandwidthLimit(bucket, "", limit)
+	} else {
+		globalBucketMonitor.DeleteBucketThrottle(bucket, "")
+	}
+	for index, err := range g.Wait() {
+		reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress",
+			sys.peerClients[index].host.String())
+		ctx := logger.SetReqInfo(ctx, reqInfo)
+		peersLogOnceIf(ctx, err, sys.peerClients[index].host.String())
+	}
+}
+
+// GetObjectAgeStats - gets the scanner object age distribution from all nodes
+// including self, merged per bucket.
+func (sys *NotificationSys) GetObjectAgeStats(ctx context.Context, buckets ...string) map[string]bucketObjectAgeStats {
+	reports := make([]map[string]bucketObjectAgeStats, len(sys.peerClients))
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		index := index
+		g.Go(func() error {
+			var err error
+			reports[index], err = sys.peerClients[index].GetObjectAgeStats(ctx, buckets)
+			return err
+		}, index)
+	}
+
+	for index, err := range g.Wait() {
+		reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress",
+			sys.peerClients[index].host.String())
+		ctx := logger.SetReqInfo(ctx, reqInfo)
+		peersLogOnceIf(ctx, err, sys.peerClients[index].host.String())
+	}
+
+	consolidated := globalObjectAgeStats.report(buckets...)
+	for _, report := range reports {
+		mergeObjectAgeStats(consolidated, report)
+	}
+	return consolidated
+}
+
 // GetBandwidthReports - gets the bandwidth report from all nodes including self.
 func (sys *NotificationSys) GetBandwidthReports(ctx context.Context, buckets ...string) bandwidth.BucketBandwidthReport {
 	reports := make([]*bandwidth.BucketBandwidthReport, len(sys.peerClients))
@@ -1646,3 +1702,63 @@ func (sys *NotificationSys) GetReplicationMRF(ctx context.Context, bucket, node
 	}(&wg)
 	return mrfCh, nil
 }
+
+// RequeueReplicationMRF - requeue replication MRF entries on the matching
+// peers (all peers when node is "all") for immediate healing.
+func (sys *NotificationSys) RequeueReplicationMRF(ctx context.Context, bucket, node string) error {
+	return sys.replicationMRFOp(ctx, bucket, node, func(client *peerRESTClient) error {
+		return client.RequeueReplicationMRF(ctx, bucket)
+	}, func() error {
+		return globalReplicationPool.Get().requeueMRF(ctx, bucket)
+	})
+}
+
+// DropReplicationMRF - drop replication MRF entries on the matching peers
+// (all peers when node is "all").
+func (sys *NotificationSys) DropReplicationMRF(ctx context.Context, bucket, node string) error {
+	return sys.replicationMRFOp(ctx, bucket, node, func(client *peerRESTClient) error {
+		return client.DropReplicationMRF(ctx, bucket)
+	}, func() error {
+		return globalReplicationPool.Get().dropMRF(ctx, bucket)
+	})
+}
+
+// replicationMRFOp runs an MRF operation on the matching peers and locally,
+// returning the first error encountered.
+func (sys *NotificationSys) replicationMRFOp(ctx context.Context, bucket, node string, peerFn func(*peerRESTClient) error, localFn func() error) error {
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		host := client.host.String()
+		if host != node && node != "all" {
+			continue
+		}
+		index := index
+		g.Go(func() error {
+			return peerFn(sys.peerClients[index])
+		}, index)
+	}
+	var firstErr error
+	for index, err := range g.Wait() {
+		if err != nil {
+			if sys.peerClients[index] != nil {
+				reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress",
+					sys.peerClients[index].host.String())
+				peersLogOnceIf(logger.SetReqInfo(ctx, reqInfo), err, sys.peerClients[index].host.String())
+			} else {
+				peersLogOnceIf(ctx, err, "peer-offline")
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	if node == "all" || node == globalLocalNodeName {
+		if err := localFn(); err != nil && !osIsNotExist(err) && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/hash"
 	xhttp "github.com/minio/minio/internal/http"
@@ -326,6 +328,19 @@ func putOpts(ctx context.Context, bucket, object, vid string, hdrs http.Header,
 	versioned := globalBucketVersioningSys.PrefixEnabled(bucket, object)
 	versionSuspended := globalBucketVersioningSys.PrefixSuspended(bucket, object)
 
+	// MinIO extension - object tags can exclude an object from being
+	// versioned, like excluded prefixes the object is written as its
+	// `null` version.
+	var objTags map[string]string
+	if tagStr := metadata[xhttp.AmzObjectTagging]; tagStr != "" && (versioned || versionSuspended) {
+		if t, terr := tags.ParseObjectTags(tagStr); terr == nil {
+			objTags = t.ToMap()
+			if versioned && globalBucketVersioningSys.TagsSuspended(bucket, object, objTags) {
+				versioned, versionSuspended = false, true
+			}
+		}
+	}
+
 	vid = strings.TrimSpace(vid)
 	if vid != "" && vid != nullVersionID {
 		_, err := uuid.Parse(vid)
@@ -337,10 +352,14 @@ func putOpts(ctx context.Context, bucket, object, vid string, hdrs http.Header,
 			}
 		}
 		if !versioned {
+			errMsg := fmt.Sprintf("VersionID specified %s, but versioning not enabled on bucket=%s", vid, bucket)
+			if rule := globalBucketVersioningSys.ExcludedRule(bucket, object, objTags); rule != "" {
+				errMsg = fmt.Sprintf("VersionID specified %s, but versioning is suppressed by exclusion rule %s on bucket=%s", vid, rule, bucket)
+			}
 			return opts, InvalidArgument{
 				Bucket: bucket,
 				Object: object,
-				Err:    fmt.Errorf("VersionID specified %s, but versioning not enabled on bucket=%s", opts.VersionID, bucket),
+				Err:    errors.New(errMsg),
 			}
 		}
 	}
@@ -445,8 +464,11 @@ func copyDstOpts(ctx context.Context, r *http.Request, bucket, object string, me
 func copySrcOpts(ctx context.Context, r *http.Request, bucket, object string) (ObjectOptions, error) {
 	var opts ObjectOptions
 
-	// default case of passing encryption headers to backend
-	opts, err := getDefaultOpts(r.Header, false, nil)
+	// Parse the copy source encryption headers - the destination SSE-C
+	// key, if any, belongs into the destination options only. The copy
+	// handlers convert the returned SSE-C copy key into a regular SSE-C
+	// key for the GET call on the source.
+	opts, err := getDefaultOpts(r.Header, true, nil)
 	if err != nil {
 		return opts, err
 	}
@@ -44,6 +44,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/amztime"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/bucket/bandwidth"
 	sse "github.com/minio/minio/internal/bucket/encryption"
 	"github.com/minio/minio/internal/bucket/lifecycle"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
@@ -62,6 +63,7 @@ import (
 	"github.com/minio/minio/internal/s3select"
 	"github.com/minio/mux"
 	"github.com/minio/pkg/v3/policy"
+	"github.com/minio/pkg/v3/wildcard"
 )
 
 // supportedHeadGetReqParams - supported request parameters for GET and HEAD presigned request.
@@ -357,6 +359,10 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 	}
 
 	getObjectNInfo := objectAPI.GetObjectNInfo
+	if cache := globalCacheObjects.Load(); cache != nil {
+		// Serve repeated reads from the disk cache when configured.
+		getObjectNInfo = cache.GetObjectNInfo
+	}
 
 	// Get request range.
 	var rs *HTTPRangeSpec
@@ -427,7 +433,19 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 				}
 			}
 		}
-		if reader == nil || !proxy.Proxy {
+		if reader == nil && !proxy.Proxy && opts.VersionID == "" && opts.PartNumber == 0 && isErrObjectNotFound(err) {
+			// The object may have been packed into a container object by a
+			// repack batch job, resolve it through the pack manifest.
+			rgr, rerr := getRepackedObjectNInfo(ctx, objectAPI, bucket, object, rs, opts)
+			switch {
+			case rerr == nil:
+				gr, err = rgr, nil
+			case isErrPreconditionFailed(rerr):
+				// response already written by the precondition check
+				return
+			}
+		}
+		if err != nil && (reader == nil || !proxy.Proxy) {
 			// validate if the request indeed was authorized, if it wasn't we need to return "ErrAccessDenied"
 			// instead of any namespace related error.
 			if s3Error := authorizeRequest(ctx, r, policy.GetObjectAction); s3Error != ErrNone {
@@ -492,6 +510,7 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		}
 
 		QueueReplicationHeal(ctx, bucket, gr.ObjInfo, 0)
+		queueSSEKeyRewrap(bucket, objInfo)
 	}
 
 	// filter object lock metadata if permission does not permit
@@ -540,6 +559,15 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 	var iw io.Writer
 	iw = w
 
+	// Throttle egress to the configured per-bucket limit, if any.
+	if globalBucketMonitor.IsThrottled(bucket, "") {
+		iw = bandwidth.NewMonitoredWriter(ctx, globalBucketMonitor, iw, &bandwidth.MonitorWriterOptions{
+			BucketOptions: bandwidth.BucketOptions{
+				Name: bucket,
+			},
+		})
+	}
+
 	statusCodeWritten := false
 	httpWriter := xioutil.WriteOnClose(iw)
 	if rs != nil || opts.PartNumber > 0 {
@@ -575,6 +603,11 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
+
+	// Sample full-object reads for shadow comparison, if configured.
+	if globalShadowMirror != nil && rs == nil && opts.PartNumber == 0 {
+		globalShadowMirror.queue(bucket, object, objInfo)
+	}
 }
 
 // GetObjectAttributes ...
@@ -840,6 +873,15 @@ func (api objectAPIHandlers) headObjectHandler(ctx context.Context, objectAPI Ob
 		}
 	}
 
+	if err != nil && !proxy.Proxy && opts.VersionID == "" && opts.PartNumber == 0 && isErrObjectNotFound(err) {
+		// The object may have been packed into a container object by a
+		// repack batch job, resolve its metadata through the pack manifest.
+		if e, ok := lookupRepackedObject(ctx, objectAPI, bucket, object); ok {
+			objInfo = e.objectInfo(bucket, object)
+			err = nil
+		}
+	}
+
 	if objInfo.UserTags != "" {
 		// Set this such that authorization policies can be applied on the object tags.
 		r.Header.Set(xhttp.AmzObjectTagging, objInfo.UserTags)
@@ -1088,6 +1130,96 @@ func getCpObjMetadataFromHeader(ctx context.Context, r *http.Request, userMeta m
 	return defaultMeta, nil
 }
 
+// applyCopyMetadataTransform applies the strip/replace metadata copy
+// extension headers to the copied metadata. Strip patterns match user
+// metadata key names without their `X-Amz-Meta-` prefix and may use
+// wildcards, replacements are supplied in query encoding. Both only
+// affect user metadata.
+func applyCopyMetadataTransform(r *http.Request, metadata map[string]string) (map[string]string, error) {
+	const userMetaPrefix = "x-amz-meta-"
+
+	if strip := r.Header.Get(xhttp.MinIOCopyStripMetadata); strip != "" {
+		for _, pattern := range strings.Split(strip, ",") {
+			pattern = strings.ToLower(strings.TrimSpace(pattern))
+			if pattern == "" {
+				continue
+			}
+			for k := range metadata {
+				lk := strings.ToLower(k)
+				if !strings.HasPrefix(lk, userMetaPrefix) {
+					continue
+				}
+				if wildcard.MatchSimple(pattern, strings.TrimPrefix(lk, userMetaPrefix)) {
+					delete(metadata, k)
+				}
+			}
+		}
+	}
+
+	if replace := r.Header.Get(xhttp.MinIOCopyReplaceMetadata); replace != "" {
+		values, err := url.ParseQuery(replace)
+		if err != nil {
+			return nil, err
+		}
+		for k := range values {
+			key := http.CanonicalHeaderKey(userMetaPrefix + k)
+			for mk := range metadata {
+				if strings.EqualFold(mk, key) {
+					delete(metadata, mk)
+				}
+			}
+			metadata[key] = values.Get(k)
+		}
+	}
+
+	return metadata, nil
+}
+
+// applyCopyTaggingTransform applies the strip/replace tagging copy
+// extension headers to the copied tags. It returns the resulting tag
+// string and whether any transformation was requested.
+func applyCopyTaggingTransform(r *http.Request, objTags string) (string, bool, error) {
+	strip := r.Header.Get(xhttp.MinIOCopyStripTagging)
+	replace := r.Header.Get(xhttp.MinIOCopyReplaceTagging)
+	if strip == "" && replace == "" {
+		return objTags, false, nil
+	}
+
+	tagMap := map[string]string{}
+	if objTags != "" {
+		t, err := tags.ParseObjectTags(objTags)
+		if err != nil {
+			return "", false, err
+		}
+		tagMap = t.ToMap()
+	}
+
+	for _, key := range strings.Split(strip, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			delete(tagMap, key)
+		}
+	}
+
+	if replace != "" {
+		t, err := tags.ParseObjectTags(replace)
+		if err != nil {
+			return "", false, err
+		}
+		for k, v := range t.ToMap() {
+			tagMap[k] = v
+		}
+	}
+
+	if len(tagMap) == 0 {
+		return "", true, nil
+	}
+	t, err := tags.MapToObjectTags(tagMap)
+	if err != nil {
+		return "", false, err
+	}
+	return t.String(), true, nil
+}
+
 // getRemoteInstanceTransport contains a roundtripper for external (not peers) servers
 var remoteInstanceTransport atomic.Value
 
@@ -1512,6 +1644,14 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// MinIO extension - strip or replace metadata keys server-side
+	// while copying.
+	srcInfo.UserDefined, err = applyCopyMetadataTransform(r, srcInfo.UserDefined)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
 	objTags := srcInfo.UserTags
 	// If x-amz-tagging-directive header is REPLACE, get passed tags.
 	if isDirectiveReplace(r.Header.Get(xhttp.AmzTagDirective)) {
@@ -1522,6 +1662,14 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		}
 	}
 
+	// MinIO extension - rewrite tags server-side while copying.
+	var tagsRewritten bool
+	objTags, tagsRewritten, err = applyCopyTaggingTransform(r, objTags)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
 	if objTags != "" {
 		lastTaggingTimestamp := srcInfo.UserDefined[ReservedMetadataPrefixLower+TaggingTimestamp]
 		if dstOpts.ReplicationRequest {
@@ -1538,6 +1686,10 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 			srcInfo.UserDefined[xhttp.AmzObjectTagging] = objTags
 			srcInfo.UserDefined[ReservedMetadataPrefixLower+TaggingTimestamp] = UTCNow().Format(time.RFC3339Nano)
 		}
+	} else if tagsRewritten {
+		// All tags were stripped by the copy tagging transform.
+		delete(srcInfo.UserDefined, xhttp.AmzObjectTagging)
+		srcInfo.UserDefined[ReservedMetadataPrefixLower+TaggingTimestamp] = UTCNow().Format(time.RFC3339Nano)
 	}
 
 	srcInfo.UserDefined = filterReplicationStatusMetadata(srcInfo.UserDefined)
@@ -1566,6 +1718,19 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 			srcInfo.UserDefined[strings.ToLower(xhttp.AmzObjectLockRetainUntilDate)] = amztime.ISO8601Format(retentionDate.UTC())
 			srcInfo.UserDefined[ReservedMetadataPrefixLower+ObjectLockRetentionTimestamp] = UTCNow().Format(time.RFC3339Nano)
 		}
+	} else if s3Err == ErrNone && dstOpts.ReplicationRequest && !retentionMode.Valid() {
+		// source cleared retention after upload - propagate the clear to the
+		// replica if the source change is newer than what's on disk
+		srcTimestamp := dstOpts.ReplicationSourceRetentionTimestamp
+		if !srcTimestamp.IsZero() {
+			lastretentionTimestamp := srcInfo.UserDefined[ReservedMetadataPrefixLower+ObjectLockRetentionTimestamp]
+			ondiskTimestamp, err := time.Parse(time.RFC3339Nano, lastretentionTimestamp)
+			if err != nil || !ondiskTimestamp.After(srcTimestamp) {
+				srcInfo.UserDefined[strings.ToLower(xhttp.AmzObjectLockMode)] = ""
+				srcInfo.UserDefined[strings.ToLower(xhttp.AmzObjectLockRetainUntilDate)] = ""
+				srcInfo.UserDefined[ReservedMetadataPrefixLower+ObjectLockRetentionTimestamp] = srcTimestamp.UTC().Format(time.RFC3339Nano)
+			}
+		}
 	}
 
 	if s3Err == ErrNone && legalHold.Status.Valid() {
@@ -1577,7 +1742,7 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 				// update legalhold metadata only if replica timestamp is newer than what's on disk
 				if err != nil || (err == nil && ondiskTimestamp.Before(srcTimestamp)) {
 					srcInfo.UserDefined[strings.ToLower(xhttp.AmzObjectLockLegalHold)] = string(legalHold.Status)
-					srcInfo.UserDefined[ReservedMetadataPrefixLower+ObjectLockRetentionTimestamp] = srcTimestamp.Format(time.RFC3339Nano)
+					srcInfo.UserDefined[ReservedMetadataPrefixLower+ObjectLockLegalHoldTimestamp] = srcTimestamp.Format(time.RFC3339Nano)
 				}
 			}
 		} else {
@@ -2575,6 +2740,19 @@ func (api objectAPIHandlers) DeleteObjectHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// MinIO extension - objects whose tags match an excluded tag rule are
+	// not versioned, deleting them removes the object instead of adding a
+	// delete marker, mirroring how they were written by putOpts.
+	if opts.Versioned && opts.VersionID == "" && !opts.DeletePrefix {
+		if vcfg, _ := globalBucketVersioningSys.Get(bucket); vcfg != nil && vcfg.TagsExcluded() {
+			if goi, gerr := objectAPI.GetObjectInfo(ctx, bucket, object, ObjectOptions{}); gerr == nil && goi.UserTags != "" {
+				if t, terr := tags.ParseObjectTags(goi.UserTags); terr == nil && vcfg.TagsSuspended(object, t.ToMap()) {
+					opts.Versioned, opts.VersionSuspended = false, true
+				}
+			}
+		}
+	}
+
 	os := newObjSweeper(bucket, object).WithVersion(opts.VersionID).WithVersioning(opts.Versioned, opts.VersionSuspended)
 
 	opts.SetEvalMetadataFn(func(oi *ObjectInfo, gerr error) (dsc ReplicateDecision, err error) {
@@ -2621,6 +2799,18 @@ func (api objectAPIHandlers) DeleteObjectHandler(w http.ResponseWriter, r *http.
 		return
 	})
 
+	// When the bucket has a soft purge window, preserve a copy of the
+	// version in the trash namespace before it is removed so it can be
+	// undeleted within the window. Latest-version deletes on versioned
+	// buckets only stack a delete marker and keep the data, everything
+	// else removes it for good.
+	if (opts.VersionID != "" || !opts.Versioned) && !replica && !opts.DeletePrefix {
+		if err := trashObjectVersion(ctx, objectAPI, bucket, object, opts.VersionID); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
 	deleteObject := objectAPI.DeleteObject
 
 	// http://docs.aws.amazon.com/AmazonS3/latest/API/RESTObjectDELETE.html
@@ -2632,6 +2822,22 @@ func (api objectAPIHandlers) DeleteObjectHandler(w http.ResponseWriter, r *http.
 			return
 		}
 		if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+			// The name may resolve through a repack manifest, removing
+			// the entry is the delete for a packed object.
+			if opts.VersionID == "" && deleteRepackedObject(ctx, objectAPI, bucket, object) {
+				objInfo.Name = object
+				sendEvent(eventArgs{
+					EventName:    event.ObjectRemovedDelete,
+					BucketName:   bucket,
+					Object:       objInfo,
+					ReqParams:    extractReqParams(r),
+					RespElements: extractRespElements(w),
+					UserAgent:    r.UserAgent(),
+					Host:         handlers.GetSourceIP(r),
+				})
+				writeSuccessNoContent(w)
+				return
+			}
 			// Send an event when the object is not found
 			objInfo.Name = object
 			objInfo.VersionID = opts.VersionID
@@ -3544,3 +3750,67 @@ func (api objectAPIHandlers) PostRestoreObjectHandler(w http.ResponseWriter, r *
 		})
 	}()
 }
+
+// GetObjectVersionHistoryHandler - GET Object version history (MinIO extension)
+// ----------
+// Returns the complete version history of a single object - all versions
+// and delete markers in modification time order - as an immutable audit of
+// changes to the key. This is a MinIO specific extension selected with the
+// 'versionHistory' query parameter.
+func (api objectAPIHandlers) GetObjectVersionHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetObjectVersionHistory")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+	object, err := unescapePath(vars["object"])
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.ListBucketVersionsAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	var versions []ObjectInfo
+	var keyMarker, versionIDMarker string
+	for {
+		listInfo, err := objectAPI.ListObjectVersions(ctx, bucket, object, keyMarker, versionIDMarker, "", maxObjectList)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		for _, oi := range listInfo.Objects {
+			if oi.Name == object {
+				versions = append(versions, oi)
+			}
+		}
+		if !listInfo.IsTruncated {
+			break
+		}
+		keyMarker = listInfo.NextMarker
+		versionIDMarker = listInfo.NextVersionIDMarker
+		if keyMarker != object {
+			// moved past the requested object, nothing further to collect.
+			break
+		}
+	}
+	if len(versions) == 0 {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNoSuchKey), r.URL)
+		return
+	}
+
+	response := generateObjectVersionHistoryResponse(bucket, object, versions)
+
+	// Write success response.
+	writeSuccessResponseXML(w, encodeResponse(response))
+}
@@ -33,6 +33,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"path"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
@@ -40,6 +41,7 @@ import (
 	"testing"
 
 	"github.com/dustin/go-humanize"
+	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/auth"
 	"github.com/minio/minio/internal/hash/sha256"
 	xhttp "github.com/minio/minio/internal/http"
@@ -4252,3 +4254,80 @@ func testAPIListObjectPartsHandler(obj ObjectLayer, instanceType, bucketName str
 	// `ExecObjectLayerAPINilTest` sets the Object Layer to `nil` and calls the handler.
 	ExecObjectLayerAPINilTest(t, nilBucket, nilObject, instanceType, apiRouter, nilReq)
 }
+
+// Tests the metadata and tagging transforms applied by the CopyObject
+// extension headers.
+func TestApplyCopyTransforms(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/bucket/object", nil)
+	req.Header.Set(xhttp.MinIOCopyStripMetadata, "session-*, ingest-id")
+	req.Header.Set(xhttp.MinIOCopyReplaceMetadata, "stage=clean")
+
+	metadata := map[string]string{
+		"X-Amz-Meta-Session-Token": "secret",
+		"X-Amz-Meta-Ingest-Id":     "42",
+		"X-Amz-Meta-Stage":         "raw",
+		"X-Amz-Meta-Keep":          "value",
+		"Content-Type":             "application/octet-stream",
+	}
+	metadata, err := applyCopyMetadataTransform(req, metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := metadata["X-Amz-Meta-Session-Token"]; ok {
+		t.Error("expected stripped metadata key to be removed")
+	}
+	if _, ok := metadata["X-Amz-Meta-Ingest-Id"]; ok {
+		t.Error("expected stripped metadata key to be removed")
+	}
+	if v := metadata["X-Amz-Meta-Stage"]; v != "clean" {
+		t.Errorf("expected replaced metadata value `clean`, got %q", v)
+	}
+	if v := metadata["X-Amz-Meta-Keep"]; v != "value" {
+		t.Errorf("expected untouched metadata value, got %q", v)
+	}
+	if v := metadata["Content-Type"]; v != "application/octet-stream" {
+		t.Errorf("expected non-user metadata untouched, got %q", v)
+	}
+
+	req = httptest.NewRequest(http.MethodPut, "/bucket/object", nil)
+	req.Header.Set(xhttp.MinIOCopyStripTagging, "upload-batch")
+	req.Header.Set(xhttp.MinIOCopyReplaceTagging, "stage=clean")
+
+	objTags, rewritten, err := applyCopyTaggingTransform(req, "upload-batch=7&stage=raw&team=a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rewritten {
+		t.Fatal("expected tags to be rewritten")
+	}
+	tagMap, err := tags.ParseObjectTags(objTags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := tagMap.ToMap()
+	want := map[string]string{"stage": "clean", "team": "a"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected tags %v, got %v", want, got)
+	}
+
+	// Stripping the last tag reports a rewrite with no tags left.
+	req = httptest.NewRequest(http.MethodPut, "/bucket/object", nil)
+	req.Header.Set(xhttp.MinIOCopyStripTagging, "team")
+	objTags, rewritten, err = applyCopyTaggingTransform(req, "team=a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if objTags != "" || !rewritten {
+		t.Fatalf("expected empty rewritten tags, got %q rewritten=%v", objTags, rewritten)
+	}
+
+	// No extension headers leave tags untouched.
+	req = httptest.NewRequest(http.MethodPut, "/bucket/object", nil)
+	objTags, rewritten, err = applyCopyTaggingTransform(req, "team=a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if objTags != "team=a" || rewritten {
+		t.Fatalf("expected untouched tags, got %q rewritten=%v", objTags, rewritten)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
@@ -47,6 +48,7 @@ import (
 	"github.com/minio/minio/internal/hash"
 	"github.com/minio/minio/internal/hash/sha256"
 	xhttp "github.com/minio/minio/internal/http"
+	xioutil "github.com/minio/minio/internal/ioutil"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
 	"github.com/minio/pkg/v3/policy"
@@ -457,7 +459,17 @@ func (api objectAPIHandlers) CopyObjectPartHandler(w http.ResponseWriter, r *htt
 	}
 
 	actualPartSize = length
-	var reader io.Reader = etag.NewReader(ctx, gr, nil, nil)
+
+	var srcReader io.Reader = gr
+	if _, encrypted := crypto.IsEncrypted(srcInfo.UserDefined); length >= copyPartParallelThreshold && !encrypted && !srcInfo.IsCompressed() {
+		// Large plain-object ranges are read in parallel chunks to
+		// saturate drive bandwidth instead of a single sequential stream.
+		prdr := parallelCopyPartReader(ctx, getObjectNInfo, srcBucket, srcObject, srcInfo.ETag,
+			ObjectOptions{VersionID: srcInfo.VersionID}, startOffset, length)
+		defer prdr.Close()
+		srcReader = prdr
+	}
+	var reader io.Reader = etag.NewReader(ctx, srcReader, nil, nil)
 
 	mi, err := objectAPI.GetMultipartInfo(ctx, dstBucket, dstObject, uploadID, dstOpts)
 	if err != nil {
@@ -1196,16 +1208,34 @@ func (api objectAPIHandlers) ListObjectPartsHandler(w http.ResponseWriter, r *ht
 	// case for SSE-C and SSE-KMS objects.
 	if kind, ok := crypto.IsEncrypted(listPartsInfo.UserDefined); ok {
 		var objectEncryptionKey []byte
-		if kind == crypto.S3 {
+		switch kind {
+		case crypto.S3:
 			objectEncryptionKey, err = decryptObjectMeta(nil, bucket, object, listPartsInfo.UserDefined)
 			if err != nil {
 				writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 				return
 			}
+		case crypto.S3KMS:
+			// Derive the object key to unseal the part checksums below.
+			// If the KMS is unavailable the checksums remain sealed.
+			objectEncryptionKey, _ = decryptObjectMeta(nil, bucket, object, listPartsInfo.UserDefined)
+		case crypto.SSEC:
+			// ListParts does not require the SSE-C key. Unseal the part
+			// checksums only if the client provided it.
+			if crypto.SSEC.IsRequested(r.Header) {
+				if key, err := ParseSSECustomerRequest(r); err == nil {
+					objectEncryptionKey, _ = decryptObjectMeta(key, bucket, object, listPartsInfo.UserDefined)
+				}
+			}
 		}
 		for i, p := range listPartsInfo.Parts {
 			listPartsInfo.Parts[i].ETag = tryDecryptETag(objectEncryptionKey, p.ETag, kind == crypto.S3)
 			listPartsInfo.Parts[i].Size = p.ActualSize
+			listPartsInfo.Parts[i].ChecksumCRC32 = unsealPartChecksum(objectEncryptionKey, p.ChecksumCRC32)
+			listPartsInfo.Parts[i].ChecksumCRC32C = unsealPartChecksum(objectEncryptionKey, p.ChecksumCRC32C)
+			listPartsInfo.Parts[i].ChecksumSHA1 = unsealPartChecksum(objectEncryptionKey, p.ChecksumSHA1)
+			listPartsInfo.Parts[i].ChecksumSHA256 = unsealPartChecksum(objectEncryptionKey, p.ChecksumSHA256)
+			listPartsInfo.Parts[i].ChecksumCRC64NVME = unsealPartChecksum(objectEncryptionKey, p.ChecksumCRC64NVME)
 		}
 	} else if _, ok := listPartsInfo.UserDefined[ReservedMetadataPrefix+"compression"]; ok {
 		for i, p := range listPartsInfo.Parts {
@@ -1219,3 +1249,85 @@ func (api objectAPIHandlers) ListObjectPartsHandler(w http.ResponseWriter, r *ht
 	// Write success response.
 	writeSuccessResponseXML(w, encodedSuccessResponse)
 }
+
+const (
+	// copyPartChunkSize is the ranged read size used when copying a large
+	// source range in parallel.
+	copyPartChunkSize = 32 * humanize.MiByte
+
+	// copyPartParallelism is the number of chunk reads in flight.
+	copyPartParallelism = 4
+
+	// copyPartParallelThreshold is the minimum range length before
+	// UploadPartCopy switches to parallel chunked reads of the source.
+	copyPartParallelThreshold = copyPartParallelism * copyPartChunkSize
+)
+
+type copyPartChunk struct {
+	data []byte
+	err  error
+}
+
+// parallelCopyPartReader streams [startOffset, startOffset+length) of the
+// source object as ordered chunks, prefetching up to copyPartParallelism
+// chunks with independent ranged reads so UploadPartCopy of multi-GB ranges
+// is not limited by a single sequential stream. Every chunk is verified
+// against the expected source ETag so a concurrent overwrite surfaces as
+// PreConditionFailed instead of mixing versions.
+func parallelCopyPartReader(ctx context.Context, getObjectNInfo func(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, opts ObjectOptions) (*GetObjectReader, error),
+	bucket, object, expETag string, opts ObjectOptions, startOffset, length int64,
+) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		gctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		pending := make(chan chan copyPartChunk, copyPartParallelism)
+		go func() {
+			defer xioutil.SafeClose(pending)
+			for off := startOffset; off < startOffset+length; off += copyPartChunkSize {
+				n := min(int64(copyPartChunkSize), startOffset+length-off)
+				ch := make(chan copyPartChunk, 1)
+				select {
+				case pending <- ch:
+				case <-gctx.Done():
+					return
+				}
+				go func(off, n int64, ch chan copyPartChunk) {
+					rs := &HTTPRangeSpec{Start: off, End: off + n - 1}
+					gr, err := getObjectNInfo(gctx, bucket, object, rs, http.Header{}, opts)
+					if err != nil {
+						ch <- copyPartChunk{err: err}
+						return
+					}
+					defer gr.Close()
+					if gr.ObjInfo.ETag != expETag {
+						ch <- copyPartChunk{err: PreConditionFailed{}}
+						return
+					}
+					buf := make([]byte, n)
+					_, err = io.ReadFull(gr, buf)
+					ch <- copyPartChunk{data: buf, err: err}
+				}(off, n, ch)
+			}
+		}()
+
+		for ch := range pending {
+			res := <-ch
+			if res.err == nil {
+				_, res.err = pw.Write(res.data)
+			}
+			if res.err != nil {
+				cancel()
+				pw.CloseWithError(res.err)
+				// Unblock the dispatcher, in-flight chunk results are
+				// buffered and their readers already closed.
+				for range pending {
+				}
+				return
+			}
+		}
+		pw.Close()
+	}()
+	return pr
+}
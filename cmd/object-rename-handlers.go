@@ -0,0 +1,388 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/handlers"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/mux"
+	"github.com/minio/pkg/v3/policy"
+	"github.com/minio/pkg/v3/sync/errgroup"
+)
+
+// errRenameFallback is returned by the metadata-only rename fast path
+// when the rename cannot be performed in place and the caller should
+// fall back to copy+delete.
+var errRenameFallback = errors.New("in-place rename not possible")
+
+// RenamedObject is a single source/target pair renamed by RenameObject.
+type RenamedObject struct {
+	Source string `xml:"Source"`
+	Target string `xml:"Target"`
+}
+
+// RenameObjectResult is the server response to a RenameObject request.
+type RenameObjectResult struct {
+	XMLName xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ RenameObjectResult" json:"-"`
+	Renamed []RenamedObject `xml:"Renamed"`
+}
+
+// RenameObjectHandler - Rename Object
+// ----------
+// MinIO extension API, renames an object (or all objects below a prefix
+// when both source and target end with "/") server side. Within the same
+// erasure set the rename is metadata-only, otherwise the object is
+// copied to the target and the source is deleted.
+//
+// The source is passed via the "x-minio-rename-source" header following
+// the x-amz-copy-source conventions, the request path names the target.
+func (api objectAPIHandlers) RenameObjectHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RenameObject")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	dstBucket := vars["bucket"]
+	dstObject, err := unescapePath(vars["object"])
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Read escaped rename source path, parsed like x-amz-copy-source.
+	rnSrcPath := r.Header.Get(xhttp.MinIORenameSource)
+	if u, err := url.Parse(rnSrcPath); err == nil {
+		// Note that url.Parse does the unescaping
+		rnSrcPath = u.Path
+	}
+
+	srcBucket, srcObject := path2BucketObject(rnSrcPath)
+	if srcObject == "" || srcBucket == "" {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidCopySource), r.URL)
+		return
+	}
+	srcObject = trimLeadingSlash(srcObject)
+
+	// Prefix rename requires both source and target to denote prefixes.
+	if strings.HasSuffix(srcObject, SlashSeparator) != strings.HasSuffix(dstObject, SlashSeparator) {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if srcBucket == dstBucket && srcObject == dstObject {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	// Renaming requires read+delete permissions on the source and write
+	// permissions on the target. Checked on the request paths upfront,
+	// before any enumeration work leaks names to the response.
+	for _, check := range []struct {
+		action policy.Action
+		bucket string
+		object string
+	}{
+		{policy.GetObjectAction, srcBucket, srcObject},
+		{policy.DeleteObjectAction, srcBucket, srcObject},
+		{policy.PutObjectAction, dstBucket, dstObject},
+	} {
+		if s3Error := checkRequestAuthType(ctx, r, check.action, check.bucket, check.object); s3Error != ErrNone {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+			return
+		}
+	}
+
+	// Collect the source/target pairs to rename.
+	var pairs []RenamedObject
+	if strings.HasSuffix(srcObject, SlashSeparator) {
+		walkCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make(chan itemOrErr[ObjectInfo])
+		if err := objectAPI.Walk(walkCtx, srcBucket, srcObject, results, WalkOptions{
+			LatestOnly: true,
+		}); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		for res := range results {
+			if res.Err != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, res.Err), r.URL)
+				return
+			}
+			if res.Item.DeleteMarker {
+				continue
+			}
+			pairs = append(pairs, RenamedObject{
+				Source: res.Item.Name,
+				Target: dstObject + strings.TrimPrefix(res.Item.Name, srcObject),
+			})
+		}
+		if len(pairs) == 0 {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNoSuchKey), r.URL)
+			return
+		}
+	} else {
+		pairs = append(pairs, RenamedObject{Source: srcObject, Target: dstObject})
+	}
+
+	// Re-check every enumerated pair, object level policies may deny
+	// individual objects below an otherwise allowed prefix.
+	for _, pair := range pairs {
+		for _, check := range []struct {
+			action policy.Action
+			bucket string
+			object string
+		}{
+			{policy.GetObjectAction, srcBucket, pair.Source},
+			{policy.DeleteObjectAction, srcBucket, pair.Source},
+			{policy.PutObjectAction, dstBucket, pair.Target},
+		} {
+			if s3Error := checkRequestAuthType(ctx, r, check.action, check.bucket, check.object); s3Error != ErrNone {
+				writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+				return
+			}
+		}
+	}
+
+	result := RenameObjectResult{}
+	for _, pair := range pairs {
+		if err := renameObject(ctx, objectAPI, srcBucket, pair.Source, dstBucket, pair.Target); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		result.Renamed = append(result.Renamed, pair)
+
+		sendEvent(eventArgs{
+			EventName:    event.ObjectCreatedCopy,
+			BucketName:   dstBucket,
+			Object:       ObjectInfo{Bucket: dstBucket, Name: pair.Target},
+			ReqParams:    extractReqParams(r),
+			RespElements: extractRespElements(w),
+			UserAgent:    r.UserAgent(),
+			Host:         handlers.GetSourceIP(r),
+		})
+		sendEvent(eventArgs{
+			EventName:    event.ObjectRemovedDelete,
+			BucketName:   srcBucket,
+			Object:       ObjectInfo{Bucket: srcBucket, Name: pair.Source},
+			ReqParams:    extractReqParams(r),
+			RespElements: extractRespElements(w),
+			UserAgent:    r.UserAgent(),
+			Host:         handlers.GetSourceIP(r),
+		})
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(result))
+}
+
+// renameObject renames a single object, using the metadata-only fast
+// path within the same erasure set and copy+delete otherwise.
+func renameObject(ctx context.Context, objectAPI ObjectLayer, srcBucket, srcObject, dstBucket, dstObject string) error {
+	oi, err := objectAPI.GetObjectInfo(ctx, srcBucket, srcObject, ObjectOptions{})
+	if err != nil {
+		return err
+	}
+
+	// SSE object keys are sealed to the object path, a renamed object
+	// would no longer decrypt - reject encrypted sources on both paths.
+	if _, ok := crypto.IsEncrypted(oi.UserDefined); ok {
+		return NotImplemented{}
+	}
+
+	if z, ok := objectAPI.(*erasureServerPools); ok && srcBucket == dstBucket {
+		err := z.renameObjectMetaOnly(ctx, srcBucket, srcObject, dstObject)
+		if err == nil || !errors.Is(err, errRenameFallback) {
+			return err
+		}
+	}
+
+	// Fallback: server side copy followed by delete of the source.
+	//
+	// The fallback copies the latest version only. On versioned buckets
+	// that would silently diverge from the metadata-only path, which
+	// moves the entire version stack - reject instead of renaming half
+	// the object.
+	if globalBucketVersioningSys.PrefixEnabled(srcBucket, srcObject) ||
+		globalBucketVersioningSys.PrefixSuspended(srcBucket, srcObject) {
+		return NotImplemented{}
+	}
+
+	gr, err := objectAPI.GetObjectNInfo(ctx, srcBucket, srcObject, nil, http.Header{}, ObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	// GetObjectNInfo returns the decompressed stream while UserDefined
+	// still carries the internal compression markers, strip them so the
+	// target is stored the way it is written.
+	srcInfo := gr.ObjInfo
+	metadata := make(map[string]string, len(srcInfo.UserDefined))
+	for k, v := range srcInfo.UserDefined {
+		if stringsHasPrefixFold(k, ReservedMetadataPrefixLower) {
+			continue
+		}
+		metadata[k] = v
+	}
+
+	// Size of the decompressed stream, not the on-disk size.
+	size, err := srcInfo.GetActualSize()
+	if err != nil {
+		return err
+	}
+
+	reader, err := hash.NewReader(ctx, gr, size, "", "", size)
+	if err != nil {
+		return err
+	}
+
+	if _, err = objectAPI.PutObject(ctx, dstBucket, dstObject, NewPutObjReader(reader), ObjectOptions{
+		UserDefined:  metadata,
+		PreserveETag: srcInfo.ETag,
+	}); err != nil {
+		return err
+	}
+
+	_, err = objectAPI.DeleteObject(ctx, srcBucket, srcObject, ObjectOptions{})
+	return err
+}
+
+// renameObjectMetaOnly renames an object in place when the source and
+// target hash to the same erasure set and shard order and the object has
+// no nested children, avoiding any data movement. The
+// object directory is renamed on every online disk of the set, requiring
+// write quorum; on quorum failure the rename is rolled back best-effort.
+// Returns errRenameFallback when the caller should copy+delete instead.
+func (z *erasureServerPools) renameObjectMetaOnly(ctx context.Context, bucket, srcObject, dstObject string) error {
+	idx, err := z.getPoolIdxExistingWithOpts(ctx, bucket, srcObject, ObjectOptions{})
+	if err != nil {
+		return err
+	}
+
+	pool := z.serverPools[idx]
+	srcSet := pool.getHashedSet(srcObject)
+	if dstSet := pool.getHashedSet(dstObject); srcSet != dstSet {
+		return errRenameFallback
+	}
+
+	// The stored Erasure.Distribution of every version was computed for
+	// the source name and is moved verbatim. It only keeps matching the
+	// shard layout when the target name hashes to the same order, any
+	// other target would be flagged as drive inconsistency.
+	if !slices.Equal(hashOrder(pathJoin(bucket, srcObject), srcSet.setDriveCount),
+		hashOrder(pathJoin(bucket, dstObject), srcSet.setDriveCount)) {
+		return errRenameFallback
+	}
+
+	// In the XL layout the object directory also holds every object
+	// keyed below it - `a/b` and `a/b/c` coexist as nested directories.
+	// Renaming the directory would drag all descendants to names they
+	// were never hashed under, only objects without children qualify.
+	if res, err := z.ListObjects(ctx, bucket, srcObject+SlashSeparator, "", "", 1); err != nil || len(res.Objects) > 0 {
+		return errRenameFallback
+	}
+	if res, err := z.ListObjects(ctx, bucket, dstObject+SlashSeparator, "", "", 1); err != nil || len(res.Objects) > 0 {
+		return errRenameFallback
+	}
+
+	// The target must not exist, in-place rename cannot merge versions.
+	if _, err := srcSet.getObjectInfo(ctx, bucket, dstObject, ObjectOptions{}); err == nil {
+		return errRenameFallback
+	}
+
+	// The rename moves the entire version stack while the caller could
+	// only inspect the latest version, reject when any older version is
+	// encrypted - SSE object keys are sealed to the source path.
+	marker, versionMarker := "", ""
+	for {
+		vres, err := z.ListObjectVersions(ctx, bucket, srcObject, marker, versionMarker, "", maxObjectList)
+		if err != nil {
+			return toObjectErr(err, bucket, srcObject)
+		}
+		for _, v := range vres.Objects {
+			if v.Name != srcObject {
+				continue
+			}
+			if _, ok := crypto.IsEncrypted(v.UserDefined); ok {
+				return NotImplemented{}
+			}
+		}
+		if !vres.IsTruncated {
+			break
+		}
+		marker, versionMarker = vres.NextMarker, vres.NextVersionIDMarker
+	}
+
+	lk := srcSet.NewNSLock(bucket, srcObject, dstObject)
+	lkctx, err := lk.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return err
+	}
+	ctx = lkctx.Context()
+	defer lk.Unlock(lkctx)
+
+	srcPath := srcObject + SlashSeparator
+	dstPath := dstObject + SlashSeparator
+
+	disks := srcSet.getDisks()
+	g := errgroup.WithNErrs(len(disks))
+	for index := range disks {
+		index := index
+		g.Go(func() error {
+			if disks[index] == nil {
+				return errDiskNotFound
+			}
+			return disks[index].RenameFile(ctx, bucket, srcPath, bucket, dstPath)
+		}, index)
+	}
+	errs := g.Wait()
+
+	if err := reduceWriteQuorumErrs(ctx, errs, objectOpIgnoredErrs, srcSet.defaultWQuorum()); err != nil {
+		// Roll back the disks that succeeded, best-effort.
+		for index, rerr := range errs {
+			if rerr != nil || disks[index] == nil {
+				continue
+			}
+			_ = disks[index].RenameFile(ctx, bucket, dstPath, bucket, srcPath)
+		}
+		if errors.Is(err, errFileAccessDenied) || errors.Is(err, errFileNotFound) {
+			return errRenameFallback
+		}
+		return toObjectErr(err, bucket, srcObject)
+	}
+	return nil
+}
@@ -0,0 +1,463 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
+	"github.com/minio/minio/internal/amztime"
+	sse "github.com/minio/minio/internal/bucket/encryption"
+	"github.com/minio/minio/internal/bucket/replication"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/etag"
+	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/fips"
+	"github.com/minio/minio/internal/handlers"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/mux"
+	"github.com/minio/pkg/v3/policy"
+	"github.com/minio/sio"
+)
+
+// resumablePutPartSize is the journal block size, every durably written
+// block of this size can be skipped when a failed upload is resumed.
+const resumablePutPartSize = 64 * humanize.MiByte
+
+// resumablePutJournal tracks the durably written blocks of a resumable
+// PUT, keyed by a client chosen resume id. The actual data is held by an
+// ordinary multipart upload, the journal records the part checksums
+// needed to complete it.
+type resumablePutJournal struct {
+	Version   int            `json:"version"`
+	Bucket    string         `json:"bucket"`
+	Object    string         `json:"object"`
+	UploadID  string         `json:"uploadId"`
+	Size      int64          `json:"size"`
+	Parts     []CompletePart `json:"parts"`
+	UpdatedAt int64          `json:"updatedAt"`
+}
+
+// resumablePutJournalPath returns the meta bucket path of the journal
+// for the given bucket and resume id.
+func resumablePutJournalPath(bucket, resumeID string) string {
+	return pathJoin(bucketMetaPrefix, bucket, "resumable", resumeID+".json")
+}
+
+func loadResumablePutJournal(ctx context.Context, objectAPI ObjectLayer, bucket, resumeID string) (*resumablePutJournal, error) {
+	data, err := readConfig(ctx, objectAPI, resumablePutJournalPath(bucket, resumeID))
+	if err != nil {
+		return nil, err
+	}
+	journal := &resumablePutJournal{}
+	if err = json.Unmarshal(data, journal); err != nil {
+		return nil, err
+	}
+	return journal, nil
+}
+
+func saveResumablePutJournal(ctx context.Context, objectAPI ObjectLayer, resumeID string, journal *resumablePutJournal) error {
+	journal.UpdatedAt = UTCNow().Unix()
+	data, err := json.Marshal(journal)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objectAPI, resumablePutJournalPath(journal.Bucket, resumeID), data)
+}
+
+// ResumablePutStatusHandler - Resumable Put Status
+// ----------
+// MinIO extension API, returns the durable offset of a resumable PUT so
+// a client can continue a dropped upload from the last written block.
+//
+// GET /bucket/object?resumable&resume-id={id}
+func (api objectAPIHandlers) ResumablePutStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ResumablePutStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+	object, err := unescapePath(vars["object"])
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutObjectAction, bucket, object); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	resumeID := r.Form.Get("resume-id")
+	if _, err := uuid.Parse(resumeID); err != nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	journal, err := loadResumablePutJournal(ctx, objectAPI, bucket, resumeID)
+	if err != nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNoSuchUpload), r.URL)
+		return
+	}
+
+	// Verify the backing multipart upload is still live, the journal is
+	// useless once the upload has been cleaned up.
+	if _, err = objectAPI.ListObjectParts(ctx, bucket, object, journal.UploadID, 0, 1, ObjectOptions{}); err != nil {
+		deleteConfig(ctx, objectAPI, resumablePutJournalPath(bucket, resumeID))
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"resumeId": resumeID,
+		"offset":   journal.Size,
+		"parts":    len(journal.Parts),
+	})
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, data)
+}
+
+// ResumablePutObjectHandler - Resumable Put Object
+// ----------
+// MinIO extension API, uploads (part of) an object such that a dropped
+// connection only loses the data past the last durable block. Data is
+// journaled in blocks of resumablePutPartSize via an internal multipart
+// upload; after a failure the client queries the durable offset through
+// ResumablePutStatusHandler and re-sends only the remainder. A request
+// with complete=false leaves the upload open for further sessions and
+// must carry a multiple of the block size.
+//
+// PUT /bucket/object?resumable&resume-id={id}[&offset={offset}][&complete=false]
+func (api objectAPIHandlers) ResumablePutObjectHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ResumablePutObject")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+	object, err := unescapePath(vars["object"])
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutObjectAction, bucket, object); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	resumeID := r.Form.Get("resume-id")
+	if _, err := uuid.Parse(resumeID); err != nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	size := r.ContentLength
+	if size < 0 {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMissingContentLength), r.URL)
+		return
+	}
+
+	complete := r.Form.Get("complete") != "false"
+	if !complete && size%resumablePutPartSize != 0 {
+		// Intermediate sessions must end on a block boundary so that
+		// no non-terminal part violates the minimum part size.
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	var offset int64
+	if v := r.Form.Get("offset"); v != "" {
+		if offset, err = strconv.ParseInt(v, 10, 64); err != nil || offset < 0 {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+	}
+
+	// SSE-C would require the customer key to be re-supplied and verified
+	// on every resumed session, not supported for this extension.
+	if crypto.SSEC.IsRequested(r.Header) || crypto.SSECopy.IsRequested(r.Header) {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	if err = enforceBucketQuotaHard(ctx, bucket, size); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Serialize sessions on the same resume id, the journal is read,
+	// appended to and rewritten below.
+	jlk := objectAPI.NewNSLock(minioMetaBucket, resumablePutJournalPath(bucket, resumeID))
+	lkctx, err := jlk.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	ctx = lkctx.Context()
+	defer jlk.Unlock(lkctx)
+
+	journal, err := loadResumablePutJournal(ctx, objectAPI, bucket, resumeID)
+	if err != nil {
+		if offset != 0 {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNoSuchUpload), r.URL)
+			return
+		}
+
+		// Apply the bucket default encryption configuration before
+		// inspecting the request headers, as the regular PUT path does.
+		sseConfig, _ := globalBucketSSEConfigSys.Get(bucket)
+		sseConfig.Apply(r.Header, sse.ApplyOptions{
+			AutoEncrypt: globalAutoEncryption,
+		})
+
+		encMetadata := map[string]string{}
+		if crypto.Requested(r.Header) {
+			if err = setEncryptionMetadata(r, bucket, object, encMetadata); err != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+				return
+			}
+			// Set this for multipart only operations, we need to differentiate during
+			// decryption if the file was actually multipart or not.
+			encMetadata[ReservedMetadataPrefix+"Encrypted-Multipart"] = ""
+		}
+
+		metadata, err := extractMetadataFromReq(ctx, r)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+
+		retPerms := isPutActionAllowed(ctx, getRequestAuthType(r), bucket, object, r, policy.PutObjectRetentionAction)
+		holdPerms := isPutActionAllowed(ctx, getRequestAuthType(r), bucket, object, r, policy.PutObjectLegalHoldAction)
+		retentionMode, retentionDate, legalHold, s3Err := checkPutObjectLockAllowed(ctx, r, bucket, object, objectAPI.GetObjectInfo, retPerms, holdPerms)
+		if s3Err == ErrNone && retentionMode.Valid() {
+			metadata[strings.ToLower(xhttp.AmzObjectLockMode)] = string(retentionMode)
+			metadata[strings.ToLower(xhttp.AmzObjectLockRetainUntilDate)] = amztime.ISO8601Format(retentionDate.UTC())
+		}
+		if s3Err == ErrNone && legalHold.Status.Valid() {
+			metadata[strings.ToLower(xhttp.AmzObjectLockLegalHold)] = string(legalHold.Status)
+		}
+		if s3Err != ErrNone {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+			return
+		}
+
+		if dsc := mustReplicate(ctx, bucket, object, getMustReplicateOptions(metadata, "", "", replication.ObjectReplicationType, ObjectOptions{})); dsc.ReplicateAny() {
+			metadata[ReservedMetadataPrefixLower+ReplicationTimestamp] = UTCNow().Format(time.RFC3339Nano)
+			metadata[ReservedMetadataPrefixLower+ReplicationStatus] = dsc.PendingStatus()
+		}
+
+		for k, v := range encMetadata {
+			metadata[k] = v
+		}
+
+		// Ensure that metadata does not contain sensitive information
+		crypto.RemoveSensitiveEntries(metadata)
+
+		opts, err := putOptsFromReq(ctx, r, bucket, object, metadata)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+
+		res, err := objectAPI.NewMultipartUpload(ctx, bucket, object, opts)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		journal = &resumablePutJournal{
+			Version:  1,
+			Bucket:   bucket,
+			Object:   object,
+			UploadID: res.UploadID,
+		}
+		if err = saveResumablePutJournal(ctx, objectAPI, resumeID, journal); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	} else if offset != journal.Size || object != journal.Object {
+		// Tell the client where to resume from.
+		w.Header().Set(xhttp.MinIOResumeOffset, strconv.FormatInt(journal.Size, 10))
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	// Pick up the object encryption key once when the backing upload is
+	// encrypted, every journaled part needs its derived part key.
+	mi, err := objectAPI.GetMultipartInfo(ctx, bucket, object, journal.UploadID, ObjectOptions{})
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	var objectEncryptionKey crypto.ObjectKey
+	_, isEncrypted := crypto.IsEncrypted(mi.UserDefined)
+	if isEncrypted {
+		key, err := decryptObjectMeta(nil, bucket, object, mi.UserDefined)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		copy(objectEncryptionKey[:], key)
+	}
+
+	// Journal the incoming stream block by block, each part is durable
+	// once written and survives a dropped connection.
+	remaining := size
+	for remaining > 0 {
+		blockSize := remaining
+		if blockSize > resumablePutPartSize {
+			blockSize = resumablePutPartSize
+		}
+		hashReader, err := hash.NewReader(ctx, io.LimitReader(r.Body, blockSize), blockSize, "", "", blockSize)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		pReader := NewPutObjReader(hashReader)
+		partNumber := len(journal.Parts) + 1
+		popts := ObjectOptions{}
+		if isEncrypted {
+			partEncryptionKey := objectEncryptionKey.DerivePartKey(uint32(partNumber))
+			in := io.Reader(hashReader)
+			if blockSize > encryptBufferThreshold {
+				// The encryption reads in blocks of 64KB.
+				// We add a buffer on bigger files to reduce the number of syscalls upstream.
+				in = bufio.NewReaderSize(hashReader, encryptBufferSize)
+			}
+
+			var nonce [12]byte
+			tmp := sha256.Sum256([]byte(fmt.Sprint(journal.UploadID, partNumber)))
+			copy(nonce[:], tmp[:12])
+
+			encReader, err := sio.EncryptReader(in, sio.Config{
+				Key:          partEncryptionKey[:],
+				CipherSuites: fips.DARECiphers(),
+				Nonce:        &nonce,
+			})
+			if err != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+				return
+			}
+			info := ObjectInfo{Size: blockSize}
+			// do not try to verify encrypted content
+			encHashReader, err := hash.NewReader(ctx, etag.Wrap(encReader, hashReader), info.EncryptedSize(), "", "", blockSize)
+			if err != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+				return
+			}
+			if pReader, err = pReader.WithEncryption(encHashReader, &objectEncryptionKey); err != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+				return
+			}
+			popts.EncryptFn = metadataEncrypter(objectEncryptionKey)
+		}
+		pi, err := objectAPI.PutObjectPart(ctx, bucket, object, journal.UploadID, partNumber, pReader, popts)
+		if err != nil {
+			// The journal still covers all durable parts, the client
+			// may resume from the reported offset.
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		journal.Parts = append(journal.Parts, CompletePart{
+			PartNumber:     partNumber,
+			ETag:           pi.ETag,
+			ChecksumCRC32:  pi.ChecksumCRC32,
+			ChecksumCRC32C: pi.ChecksumCRC32C,
+			ChecksumSHA1:   pi.ChecksumSHA1,
+			ChecksumSHA256: pi.ChecksumSHA256,
+		})
+		journal.Size += blockSize
+		if err = saveResumablePutJournal(ctx, objectAPI, resumeID, journal); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		remaining -= blockSize
+	}
+
+	w.Header().Set(xhttp.MinIOResumeOffset, strconv.FormatInt(journal.Size, 10))
+	if !complete {
+		writeSuccessResponseHeadersOnly(w)
+		return
+	}
+
+	// Enforce object lock governance on the replaced version, the same
+	// check CompleteMultipartUpload applies.
+	if _, _, _, s3Err := checkPutObjectLockAllowed(ctx, r, bucket, object, objectAPI.GetObjectInfo, ErrNone, ErrNone); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
+	opts, err := completeMultipartOpts(ctx, r, bucket, object)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	objInfo, err := objectAPI.CompleteMultipartUpload(ctx, bucket, object, journal.UploadID, journal.Parts, opts)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	deleteConfig(ctx, objectAPI, resumablePutJournalPath(bucket, resumeID))
+
+	if dsc := mustReplicate(ctx, bucket, object, objInfo.getMustReplicateOptions(replication.ObjectReplicationType, opts)); dsc.ReplicateAny() {
+		scheduleReplication(ctx, objInfo, objectAPI, dsc, replication.ObjectReplicationType)
+	}
+
+	setPutObjHeaders(w, objInfo, false, r.Header)
+	writeSuccessResponseHeadersOnly(w)
+
+	sendEvent(eventArgs{
+		EventName:    event.ObjectCreatedPut,
+		BucketName:   bucket,
+		Object:       objInfo,
+		ReqParams:    extractReqParams(r),
+		RespElements: extractRespElements(w),
+		UserAgent:    r.UserAgent(),
+		Host:         handlers.GetSourceIP(r),
+	})
+}
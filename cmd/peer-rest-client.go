@@ -27,6 +27,7 @@ import (
 	"io"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -648,6 +649,32 @@ func newPeerRestClients(endpoints EndpointServerPools) (remote, all []*peerRESTC
 	return remote, all
 }
 
+// SetBucketBandwidthLimit - set per-bucket egress bandwidth limit on the peer node.
+func (client *peerRESTClient) SetBucketBandwidthLimit(ctx context.Context, bucket string, limit int64) error {
+	values := grid.NewURLValuesWith(map[string][]string{
+		peerRESTBucket: {bucket},
+		peerRESTLimit:  {strconv.FormatInt(limit, 10)},
+	})
+	_, err := setBucketBandwidthLimitRPC.Call(ctx, client.gridConn(), values)
+	return err
+}
+
+// GetObjectAgeStats - get the scanner object age distribution from the peer node.
+func (client *peerRESTClient) GetObjectAgeStats(ctx context.Context, buckets []string) (map[string]bucketObjectAgeStats, error) {
+	values := grid.NewURLValuesWith(map[string][]string{
+		"buckets": {strings.Join(buckets, ",")},
+	})
+	resp, err := getObjectAgeStatsRPC.Call(ctx, client.gridConn(), values)
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[string]bucketObjectAgeStats)
+	if err = json.Unmarshal(*resp, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 // MonitorBandwidth - send http trace request to peer nodes
 func (client *peerRESTClient) MonitorBandwidth(ctx context.Context, buckets []string) (*bandwidth.BucketBandwidthReport, error) {
 	values := grid.NewURLValuesWith(map[string][]string{
@@ -815,6 +842,32 @@ func (client *peerRESTClient) Netperf(ctx context.Context, duration time.Duratio
 	return result, err
 }
 
+// RequeueReplicationMRF - requeue replication MRF entries for bucket
+func (client *peerRESTClient) RequeueReplicationMRF(ctx context.Context, bucket string) error {
+	values := make(url.Values)
+	values.Set(peerRESTBucket, bucket)
+
+	respBody, err := client.callWithContext(ctx, peerRESTMethodRequeueReplicationMRF, values, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(respBody)
+	return nil
+}
+
+// DropReplicationMRF - drop replication MRF entries for bucket
+func (client *peerRESTClient) DropReplicationMRF(ctx context.Context, bucket string) error {
+	values := make(url.Values)
+	values.Set(peerRESTBucket, bucket)
+
+	respBody, err := client.callWithContext(ctx, peerRESTMethodDropReplicationMRF, values, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(respBody)
+	return nil
+}
+
 // GetReplicationMRF - get replication MRF for bucket
 func (client *peerRESTClient) GetReplicationMRF(ctx context.Context, bucket string) (chan madmin.ReplicationMRF, error) {
 	values := make(url.Values)
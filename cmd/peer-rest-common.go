@@ -37,6 +37,8 @@ const (
 	peerRESTMethodDevNull               = "/devnull"
 	peerRESTMethodNetperf               = "/netperf"
 	peerRESTMethodGetReplicationMRF     = "/getreplicationmrf"
+	peerRESTMethodRequeueReplicationMRF = "/requeuereplicationmrf"
+	peerRESTMethodDropReplicationMRF    = "/dropreplicationmrf"
 )
 
 const (
@@ -68,6 +70,7 @@ const (
 	peerRESTMetrics         = "metrics"
 	peerRESTDryRun          = "dry-run"
 	peerRESTUploadID        = "up-id"
+	peerRESTLimit           = "limit"
 
 	peerRESTURL         = "url"
 	peerRESTSha256Sum   = "sha256sum"
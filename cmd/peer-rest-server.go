@@ -113,6 +113,8 @@ var (
 	reloadPoolMetaRPC              = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerReloadPoolMeta, grid.NewMSS, grid.NewNoPayload)
 	reloadSiteReplicationConfigRPC = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerReloadSiteReplicationConfig, grid.NewMSS, grid.NewNoPayload)
 	serverInfoRPC                  = grid.NewSingleHandler[*grid.MSS, *grid.JSON[madmin.ServerProperties]](grid.HandlerServerInfo, grid.NewMSS, madminServerProperties.NewJSON)
+	setBucketBandwidthLimitRPC     = grid.NewSingleHandler[*grid.URLValues, grid.NoPayload](grid.HandlerSetBucketBandwidthLimit, grid.NewURLValues, grid.NewNoPayload)
+	getObjectAgeStatsRPC           = grid.NewSingleHandler[*grid.URLValues, *grid.Bytes](grid.HandlerGetObjectAgeStats, grid.NewURLValues, grid.NewBytes)
 	signalServiceRPC               = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerSignalService, grid.NewMSS, grid.NewNoPayload)
 	stopRebalanceRPC               = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerStopRebalance, grid.NewMSS, grid.NewNoPayload)
 	updateMetacacheListingRPC      = grid.NewSingleHandler[*metacache, *metacache](grid.HandlerUpdateMetacacheListing, func() *metacache { return &metacache{} }, func() *metacache { return &metacache{} })
@@ -548,7 +550,7 @@ func (s *peerRESTServer) LoadBucketMetadataHandler(mss *grid.MSS) (np grid.NoPay
 	globalBucketMetadataSys.Set(bucketName, meta)
 
 	if meta.notificationConfig != nil {
-		globalEventNotifier.AddRulesMap(bucketName, meta.notificationConfig.ToRulesMap())
+		globalEventNotifier.AddRulesMap(bucketName, meta.notificationConfig.ToRulesMap(), meta.notificationConfig.ToSyncRulesMap())
 	}
 
 	if meta.bucketTargetConfig != nil {
@@ -597,7 +599,7 @@ func (s *peerRESTServer) PutBucketNotificationHandler(w http.ResponseWriter, r *
 		return
 	}
 
-	globalEventNotifier.AddRulesMap(bucketName, rulesMap)
+	globalEventNotifier.AddRulesMap(bucketName, rulesMap, nil)
 }
 
 // HealthHandler - returns true of health
@@ -1032,6 +1034,40 @@ func (s *peerRESTServer) IsValid(w http.ResponseWriter, r *http.Request) bool {
 	return true
 }
 
+// SetBucketBandwidthLimit sets the egress bandwidth limit for a bucket on this node.
+// A limit of zero removes any existing throttle.
+func (s *peerRESTServer) SetBucketBandwidthLimit(params *grid.URLValues) (np grid.NoPayload, nerr *grid.RemoteErr) {
+	values := params.Values()
+	bucket := values.Get(peerRESTBucket)
+	if bucket == "" {
+		return np, grid.NewRemoteErr(errors.New("bucket name is missing"))
+	}
+	limit, err := strconv.ParseInt(values.Get(peerRESTLimit), 10, 64)
+	if err != nil {
+		return np, grid.NewRemoteErr(err)
+	}
+	if limit > 0 {
+		globalBucketMonitor.SetBandwidthLimit(bucket, "", limit)
+	} else {
+		globalBucketMonitor.DeleteBucketThrottle(bucket, "")
+	}
+	return
+}
+
+// GetObjectAgeStats returns the scanner object age distribution for the
+// buckets requested, from the most recent completed scans on this node.
+func (s *peerRESTServer) GetObjectAgeStats(params *grid.URLValues) (*grid.Bytes, *grid.RemoteErr) {
+	var buckets []string
+	if v := params.Values().Get("buckets"); v != "" {
+		buckets = strings.Split(v, ",")
+	}
+	data, err := json.Marshal(globalObjectAgeStats.report(buckets...))
+	if err != nil {
+		return nil, grid.NewRemoteErr(err)
+	}
+	return grid.NewBytesWith(data), nil
+}
+
 // GetBandwidth gets the bandwidth for the buckets requested.
 func (s *peerRESTServer) GetBandwidth(params *grid.URLValues) (*bandwidth.BucketBandwidthReport, *grid.RemoteErr) {
 	buckets := params.Values().Get("buckets")
@@ -1215,6 +1251,36 @@ func (s *peerRESTServer) GetReplicationMRFHandler(w http.ResponseWriter, r *http
 	}
 }
 
+// RequeueReplicationMRFHandler - requeues replication MRF entries for bucket
+func (s *peerRESTServer) RequeueReplicationMRFHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucketName := vars[peerRESTBucket]
+	ctx := newContext(r, w, "RequeueReplicationMRF")
+	if err := globalReplicationPool.Get().requeueMRF(ctx, bucketName); err != nil && !osIsNotExist(err) {
+		s.writeErrorResponse(w, err)
+	}
+}
+
+// DropReplicationMRFHandler - drops replication MRF entries for bucket
+func (s *peerRESTServer) DropReplicationMRFHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucketName := vars[peerRESTBucket]
+	ctx := newContext(r, w, "DropReplicationMRF")
+	if err := globalReplicationPool.Get().dropMRF(ctx, bucketName); err != nil && !osIsNotExist(err) {
+		s.writeErrorResponse(w, err)
+	}
+}
+
 // DevNull - everything goes to io.Discard
 func (s *peerRESTServer) DevNull(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -1360,6 +1426,8 @@ func registerPeerRESTHandlers(router *mux.Router, gm *grid.Manager) {
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodVerifyBinary).HandlerFunc(h(server.VerifyBinaryHandler)).Queries(restQueries(peerRESTURL, peerRESTSha256Sum, peerRESTReleaseInfo)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodCommitBinary).HandlerFunc(h(server.CommitBinaryHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetReplicationMRF).HandlerFunc(httpTraceHdrs(server.GetReplicationMRFHandler)).Queries(restQueries(peerRESTBucket)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodRequeueReplicationMRF).HandlerFunc(httpTraceHdrs(server.RequeueReplicationMRFHandler)).Queries(restQueries(peerRESTBucket)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDropReplicationMRF).HandlerFunc(httpTraceHdrs(server.DropReplicationMRFHandler)).Queries(restQueries(peerRESTBucket)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodStartProfiling).HandlerFunc(h(server.StartProfilingHandler)).Queries(restQueries(peerRESTProfiler)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDownloadProfilingData).HandlerFunc(h(server.DownloadProfilingDataHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodSpeedTest).HandlerFunc(h(server.SpeedTestHandler))
@@ -1411,6 +1479,8 @@ func registerPeerRESTHandlers(router *mux.Router, gm *grid.Manager) {
 	logger.FatalIf(reloadPoolMetaRPC.Register(gm, server.ReloadPoolMetaHandler), "unable to register handler")
 	logger.FatalIf(reloadSiteReplicationConfigRPC.Register(gm, server.ReloadSiteReplicationConfigHandler), "unable to register handler")
 	logger.FatalIf(serverInfoRPC.Register(gm, server.ServerInfoHandler), "unable to register handler")
+	logger.FatalIf(setBucketBandwidthLimitRPC.Register(gm, server.SetBucketBandwidthLimit), "unable to register handler")
+	logger.FatalIf(getObjectAgeStatsRPC.Register(gm, server.GetObjectAgeStats), "unable to register handler")
 	logger.FatalIf(signalServiceRPC.Register(gm, server.SignalServiceHandler), "unable to register handler")
 	logger.FatalIf(stopRebalanceRPC.Register(gm, server.StopRebalanceHandler), "unable to register handler")
 	logger.FatalIf(updateMetacacheListingRPC.Register(gm, server.UpdateMetacacheListingHandler), "unable to register handler")
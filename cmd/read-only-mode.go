@@ -0,0 +1,134 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// readOnlyModeConfigFile is stored in the config backend so that every
+	// node observes the same maintenance state.
+	readOnlyModeConfigFile = "read-only-mode.json"
+
+	// readOnlyModeRefreshInterval is how often each node re-reads the
+	// persisted maintenance state, an upper bound on how long a change made
+	// on a peer takes to propagate.
+	readOnlyModeRefreshInterval = 10 * time.Second
+
+	// readOnlyModeAllPools applies read-only maintenance mode to all pools.
+	readOnlyModeAllPools = -1
+)
+
+// readOnlyModeConfig describes the read-only maintenance mode of the cluster.
+type readOnlyModeConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Pool limits read-only mode to a single pool index,
+	// readOnlyModeAllPools covers the whole cluster.
+	Pool int `json:"pool"`
+
+	UpdatedBy string    `json:"updatedBy,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// globalReadOnlyMode holds the currently applied maintenance state.
+var globalReadOnlyMode atomic.Pointer[readOnlyModeConfig]
+
+func readOnlyModeConfigPath() string {
+	return pathJoin(minioConfigPrefix, readOnlyModeConfigFile)
+}
+
+// initReadOnlyMode loads the persisted maintenance state and keeps it
+// refreshed in the background.
+func initReadOnlyMode(ctx context.Context, objAPI ObjectLayer) {
+	loadReadOnlyModeConfig(ctx, objAPI)
+	go func() {
+		for {
+			if err := sleepContext(ctx, readOnlyModeRefreshInterval); err != nil {
+				return
+			}
+			loadReadOnlyModeConfig(ctx, objAPI)
+		}
+	}()
+}
+
+func loadReadOnlyModeConfig(ctx context.Context, objAPI ObjectLayer) {
+	data, err := readConfig(ctx, objAPI, readOnlyModeConfigPath())
+	if err != nil {
+		if !errors.Is(err, errConfigNotFound) {
+			adminLogIf(ctx, err)
+		}
+		return
+	}
+	var cfg readOnlyModeConfig
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		adminLogIf(ctx, err)
+		return
+	}
+	globalReadOnlyMode.Store(&cfg)
+}
+
+// saveReadOnlyModeConfig persists cfg and applies it locally, peers pick up
+// the change on their next refresh.
+func saveReadOnlyModeConfig(ctx context.Context, objAPI ObjectLayer, cfg readOnlyModeConfig) error {
+	data, err := json.Marshal(&cfg)
+	if err != nil {
+		return err
+	}
+	if err = saveConfig(ctx, objAPI, readOnlyModeConfigPath(), data); err != nil {
+		return err
+	}
+	globalReadOnlyMode.Store(&cfg)
+	return nil
+}
+
+// readOnlyModeEnabled returns if pool idx is currently in read-only
+// maintenance mode, readOnlyModeAllPools queries the cluster wide state.
+func readOnlyModeEnabled(idx int) bool {
+	cfg := globalReadOnlyMode.Load()
+	if cfg == nil || !cfg.Enabled {
+		return false
+	}
+	return cfg.Pool == readOnlyModeAllPools || cfg.Pool == idx
+}
+
+// readOnlyModeBlocked returns true if r is a mutating S3 call and the whole
+// cluster is in read-only maintenance mode. Pool scoped read-only mode is
+// enforced at object placement time instead.
+func readOnlyModeBlocked(r *http.Request) bool {
+	cfg := globalReadOnlyMode.Load()
+	if cfg == nil || !cfg.Enabled || cfg.Pool != readOnlyModeAllPools {
+		return false
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	case http.MethodPost:
+		// S3 Select is the only read-only POST API.
+		if _, ok := r.URL.Query()["select"]; ok {
+			return false
+		}
+	}
+	return true
+}
@@ -44,6 +44,7 @@ type rebalanceAdminStatus struct {
 	ID        string                // identifies the ongoing rebalance operation by a uuid
 	Pools     []rebalancePoolStatus `json:"pools"` // contains all pools, including inactive
 	StoppedAt time.Time             `json:"stoppedAt,omitempty"`
+	PausedAt  time.Time             `json:"pausedAt,omitempty"`
 }
 
 func rebalanceStatus(ctx context.Context, z *erasureServerPools) (r rebalanceAdminStatus, err error) {
@@ -74,6 +75,7 @@ func rebalanceStatus(ctx context.Context, z *erasureServerPools) (r rebalanceAdm
 	r = rebalanceAdminStatus{
 		ID:        meta.ID,
 		StoppedAt: meta.StoppedAt,
+		PausedAt:  meta.PausedAt,
 		Pools:     make([]rebalancePoolStatus, len(meta.PoolStats)),
 	}
 	for i, ps := range meta.PoolStats {
@@ -89,7 +91,12 @@ func rebalanceStatus(ctx context.Context, z *erasureServerPools) (r rebalanceAdm
 		// pf_c = (f_i + x)/c_i,
 		// pf_c - percentage free space across pools, f_i - ith pool's free space, c_i - ith pool's capacity
 		// i.e. x = c_i*pfc -f_i
-		totalBytesToRebal := float64(ps.InitCapacity)*meta.PercentFreeGoal - float64(ps.InitFreeSpace)
+		goal := meta.PercentFreeGoal
+		if ps.TargetFreeGoal > 0 {
+			// Pool rebalances only up to its target watermark.
+			goal = ps.TargetFreeGoal
+		}
+		totalBytesToRebal := float64(ps.InitCapacity)*goal - float64(ps.InitFreeSpace)
 		elapsed := time.Since(ps.Info.StartTime)
 		eta := time.Duration(totalBytesToRebal * float64(elapsed) / float64(ps.Bytes))
 		if !ps.Info.EndTime.IsZero() {
@@ -925,6 +925,10 @@ func serverMain(ctx *cli.Context) {
 		}
 	})
 
+	bootstrapTrace("initDiskCache", func() {
+		initDiskCache(GlobalContext, newObject)
+	})
+
 	for _, n := range globalNodes {
 		nodeName := n.Host
 		if n.IsLocal {
@@ -1042,6 +1046,37 @@ func serverMain(ctx *cli.Context) {
 			initBackgroundExpiry(GlobalContext, newObject)
 		})
 
+		// Initialize background purge of expired soft purge trash entries
+		bootstrapTrace("initSoftPurgeCleanup", func() {
+			initSoftPurgeCleanup(GlobalContext, newObject)
+		})
+
+		// Initialize scheduled config/IAM backup, if configured.
+		bootstrapTrace("initConfigBackup", func() {
+			initConfigBackup(GlobalContext, newObject)
+		})
+
+		// Initialize read shadowing to a second cluster, if configured.
+		bootstrapTrace("initShadowMirror", func() {
+			initShadowMirror(GlobalContext)
+		})
+
+		// Background bitrot verification with sampling, enabled only
+		// when MINIO_BITROT_SAMPLE_PERCENT is set.
+		bootstrapTrace("initBitrotSampler", func() {
+			initBitrotSampler(GlobalContext, newObject)
+		})
+
+		// Load the read-only maintenance mode state and keep it refreshed.
+		bootstrapTrace("initReadOnlyMode", func() {
+			initReadOnlyMode(GlobalContext, newObject)
+		})
+
+		// Load access key last-used timestamps and keep them flushed.
+		bootstrapTrace("initAccessKeyLastUsed", func() {
+			initAccessKeyLastUsed(GlobalContext, newObject)
+		})
+
 		bootstrapTrace("globalTransitionState.Init", func() {
 			globalTransitionState.Init(newObject)
 		})
@@ -0,0 +1,321 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/pkg/v3/env"
+
+	miniogo "github.com/minio/minio-go/v7"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// Read traffic shadowing for migration validation. When a shadow endpoint
+// is configured, a percentage of successful GET requests for the selected
+// buckets is replayed fire-and-forget against a second cluster and the
+// result digests are compared asynchronously. Divergences are counted and
+// the most recent ones retained, retrievable as a report through the admin
+// API - useful to validate a migration target before cutover without
+// affecting client traffic.
+const (
+	envShadowEndpoint  = "MINIO_SHADOW_ENDPOINT"
+	envShadowAccessKey = "MINIO_SHADOW_ACCESS_KEY"
+	envShadowSecretKey = "MINIO_SHADOW_SECRET_KEY"
+	envShadowBuckets   = "MINIO_SHADOW_BUCKETS"
+	envShadowPercent   = "MINIO_SHADOW_PERCENT"
+
+	shadowDefaultPercent   = 1
+	shadowMirrorWorkers    = 4
+	shadowMirrorQueueSize  = 10000
+	shadowReportMaxEntries = 100
+)
+
+// globalShadowMirror is non-nil only when shadowing has been configured.
+var globalShadowMirror *shadowMirror
+
+// shadowTask describes a single sampled read to replay against the shadow
+// cluster.
+type shadowTask struct {
+	bucket    string
+	object    string
+	localETag string
+	localSize int64
+}
+
+// shadowDivergence records one mismatch between the local and the shadow
+// cluster for a sampled read.
+type shadowDivergence struct {
+	Bucket     string    `json:"bucket"`
+	Object     string    `json:"object"`
+	LocalETag  string    `json:"localETag"`
+	RemoteETag string    `json:"remoteETag,omitempty"`
+	LocalSize  int64     `json:"localSize"`
+	RemoteSize int64     `json:"remoteSize,omitempty"`
+	Err        string    `json:"error,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+// shadowReport is the JSON response of the shadow-report admin API.
+type shadowReport struct {
+	Endpoint    string             `json:"endpoint"`
+	Percent     int                `json:"percent"`
+	Sampled     uint64             `json:"sampled"`
+	Compared    uint64             `json:"compared"`
+	Matched     uint64             `json:"matched"`
+	Diverged    uint64             `json:"diverged"`
+	Errors      uint64             `json:"errors"`
+	Dropped     uint64             `json:"dropped"`
+	Divergences []shadowDivergence `json:"divergences,omitempty"`
+}
+
+// shadowMirror samples read traffic and compares the sampled objects
+// against a second cluster in the background.
+type shadowMirror struct {
+	clnt     *miniogo.Client
+	endpoint string
+	buckets  map[string]struct{} // empty means all buckets
+	percent  int
+	tasks    chan shadowTask
+
+	sampled  atomic.Uint64
+	compared atomic.Uint64
+	matched  atomic.Uint64
+	diverged atomic.Uint64
+	errs     atomic.Uint64
+	dropped  atomic.Uint64
+
+	mu     sync.Mutex
+	recent []shadowDivergence
+}
+
+// initShadowMirror starts the shadow mirroring workers if a shadow
+// endpoint has been configured.
+func initShadowMirror(ctx context.Context) {
+	endpoint := env.Get(envShadowEndpoint, "")
+	if endpoint == "" {
+		return
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		bootLogIf(ctx, fmt.Errorf("invalid %s value, shadowing disabled: %w", envShadowEndpoint, err))
+		return
+	}
+	percent, err := strconv.Atoi(env.Get(envShadowPercent, strconv.Itoa(shadowDefaultPercent)))
+	if err != nil || percent <= 0 || percent > 100 {
+		bootLogIf(ctx, fmt.Errorf("invalid %s value, shadowing disabled", envShadowPercent))
+		return
+	}
+	clnt, err := miniogo.New(u.Host, &miniogo.Options{
+		Creds:     credentials.NewStaticV4(env.Get(envShadowAccessKey, ""), env.Get(envShadowSecretKey, ""), ""),
+		Secure:    u.Scheme == "https",
+		Transport: getRemoteInstanceTransport(),
+	})
+	if err != nil {
+		bootLogIf(ctx, fmt.Errorf("unable to initialize shadow client, shadowing disabled: %w", err))
+		return
+	}
+	clnt.SetAppInfo("minio-shadow", ReleaseTag)
+
+	buckets := make(map[string]struct{})
+	for _, bucket := range strings.Split(env.Get(envShadowBuckets, ""), ",") {
+		if bucket = strings.TrimSpace(bucket); bucket != "" {
+			buckets[bucket] = struct{}{}
+		}
+	}
+
+	globalShadowMirror = &shadowMirror{
+		clnt:     clnt,
+		endpoint: endpoint,
+		buckets:  buckets,
+		percent:  percent,
+		tasks:    make(chan shadowTask, shadowMirrorQueueSize),
+	}
+	for i := 0; i < shadowMirrorWorkers; i++ {
+		go globalShadowMirror.worker(ctx)
+	}
+}
+
+// queue samples a successfully served full-object GET for comparison
+// against the shadow cluster. Never blocks the request path - tasks are
+// dropped when the queue is full.
+func (s *shadowMirror) queue(bucket, object string, oi ObjectInfo) {
+	if len(s.buckets) > 0 {
+		if _, ok := s.buckets[bucket]; !ok {
+			return
+		}
+	}
+	if rand.Intn(100) >= s.percent {
+		return
+	}
+	// Encrypted objects are served decrypted while the shadow cluster
+	// returns its own view, digests are not comparable.
+	if _, encrypted := crypto.IsEncrypted(oi.UserDefined); encrypted {
+		return
+	}
+	s.sampled.Add(1)
+	select {
+	case s.tasks <- shadowTask{
+		bucket:    bucket,
+		object:    object,
+		localETag: oi.ETag,
+		localSize: oi.Size,
+	}:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+func (s *shadowMirror) worker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case t := <-s.tasks:
+			s.compare(ctx, t)
+		}
+	}
+}
+
+// compare reads the object from the shadow cluster and compares its
+// digest against the locally served one.
+func (s *shadowMirror) compare(ctx context.Context, t shadowTask) {
+	s.compared.Add(1)
+
+	obj, err := s.clnt.GetObject(ctx, t.bucket, t.object, miniogo.GetObjectOptions{})
+	if err != nil {
+		s.record(t, shadowDivergence{Err: err.Error()})
+		return
+	}
+	defer obj.Close()
+
+	st, err := obj.Stat()
+	if err != nil {
+		s.record(t, shadowDivergence{Err: err.Error()})
+		return
+	}
+	if st.Size != t.localSize {
+		s.record(t, shadowDivergence{RemoteETag: st.ETag, RemoteSize: st.Size})
+		return
+	}
+
+	// A plain (single part, unencrypted) local ETag is the content MD5,
+	// compare it against a digest of the shadow object's content so the
+	// comparison holds even if the target was written in different parts.
+	// Otherwise fall back to comparing ETags verbatim.
+	if len(t.localETag) == 32 && !strings.Contains(t.localETag, "-") {
+		h := md5.New()
+		if _, err := io.Copy(h, obj); err != nil {
+			s.record(t, shadowDivergence{RemoteETag: st.ETag, RemoteSize: st.Size, Err: err.Error()})
+			return
+		}
+		if hex.EncodeToString(h.Sum(nil)) != t.localETag {
+			s.record(t, shadowDivergence{RemoteETag: st.ETag, RemoteSize: st.Size})
+			return
+		}
+	} else if st.ETag != t.localETag {
+		s.record(t, shadowDivergence{RemoteETag: st.ETag, RemoteSize: st.Size})
+		return
+	}
+
+	s.matched.Add(1)
+}
+
+// record stores a divergence (or comparison error) in the bounded recent
+// list for the report.
+func (s *shadowMirror) record(t shadowTask, d shadowDivergence) {
+	d.Bucket = t.bucket
+	d.Object = t.object
+	d.LocalETag = t.localETag
+	d.LocalSize = t.localSize
+	d.Time = UTCNow()
+
+	if d.Err != "" {
+		s.errs.Add(1)
+	} else {
+		s.diverged.Add(1)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.recent) >= shadowReportMaxEntries {
+		copy(s.recent, s.recent[1:])
+		s.recent = s.recent[:len(s.recent)-1]
+	}
+	s.recent = append(s.recent, d)
+}
+
+// report returns a snapshot of the shadowing counters and recent
+// divergences.
+func (s *shadowMirror) report() shadowReport {
+	s.mu.Lock()
+	divergences := make([]shadowDivergence, len(s.recent))
+	copy(divergences, s.recent)
+	s.mu.Unlock()
+
+	return shadowReport{
+		Endpoint:    s.endpoint,
+		Percent:     s.percent,
+		Sampled:     s.sampled.Load(),
+		Compared:    s.compared.Load(),
+		Matched:     s.matched.Load(),
+		Diverged:    s.diverged.Load(),
+		Errors:      s.errs.Load(),
+		Dropped:     s.dropped.Load(),
+		Divergences: divergences,
+	}
+}
+
+// ShadowReportHandler - GET /minio/admin/v3/shadow-report
+// Returns the divergence report of the read shadowing subsystem.
+func (a adminAPIHandlers) ShadowReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if globalShadowMirror == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrMethodNotAllowed), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(globalShadowMirror.report())
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -332,11 +332,22 @@ func doesPresignedSignatureMatch(hashedPayload string, r *http.Request, region s
 
 	// Verify signature.
 	if !compareSignatureV4(req.Form.Get(xhttp.AmzSignature), newSignature) {
-		return ErrSignatureDoesNotMatch
+		// During a rotation grace window requests signed with the previous
+		// secret key continue to verify.
+		if !cred.HasValidPreviousKey() {
+			return ErrSignatureDoesNotMatch
+		}
+		prevSigningKey := getSigningKey(cred.PreviousSecretKey, pSignValues.Credential.scope.date,
+			pSignValues.Credential.scope.region, stype)
+		if !compareSignatureV4(req.Form.Get(xhttp.AmzSignature), getSignature(prevSigningKey, presignedStringToSign)) {
+			return ErrSignatureDoesNotMatch
+		}
 	}
 
 	r.Header.Set("x-amz-signature-age", strconv.FormatInt(UTCNow().Sub(pSignValues.Date).Milliseconds(), 10))
 
+	globalAccessKeyLastUsed.record(cred.AccessKey)
+
 	return ErrNone
 }
 
@@ -400,9 +411,20 @@ func doesSignatureMatch(hashedPayload string, r *http.Request, region string, st
 
 	// Verify if signature match.
 	if !compareSignatureV4(newSignature, signV4Values.Signature) {
-		return ErrSignatureDoesNotMatch
+		// During a rotation grace window requests signed with the previous
+		// secret key continue to verify.
+		if !cred.HasValidPreviousKey() {
+			return ErrSignatureDoesNotMatch
+		}
+		prevSigningKey := getSigningKey(cred.PreviousSecretKey, signV4Values.Credential.scope.date,
+			signV4Values.Credential.scope.region, stype)
+		if !compareSignatureV4(getSignature(prevSigningKey, stringToSign), signV4Values.Signature) {
+			return ErrSignatureDoesNotMatch
+		}
 	}
 
+	globalAccessKeyLastUsed.record(cred.AccessKey)
+
 	// Return error none.
 	return ErrNone
 }
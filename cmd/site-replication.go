@@ -1754,7 +1754,7 @@ func (c *SiteReplicationSys) PeerBucketSSEConfigHandler(ctx context.Context, buc
 }
 
 // PeerBucketQuotaConfigHandler - copies/deletes policy to local cluster.
-func (c *SiteReplicationSys) PeerBucketQuotaConfigHandler(ctx context.Context, bucket string, quota *madmin.BucketQuota, updatedAt time.Time) error {
+func (c *SiteReplicationSys) PeerBucketQuotaConfigHandler(ctx context.Context, bucket string, quota *bucketQuota, updatedAt time.Time) error {
 	// skip overwrite if local update is newer than peer update.
 	if !updatedAt.IsZero() {
 		if _, updateTm, err := globalBucketMetadataSys.GetQuotaConfig(ctx, bucket); err == nil && updateTm.After(updatedAt) {
@@ -2782,8 +2782,151 @@ func (c *SiteReplicationSys) SiteReplicationStatus(ctx context.Context, objAPI O
 
 const (
 	replicationStatus = "ReplicationStatus"
+	bucketUsageDiff   = "BucketUsageDiff"
 )
 
+// srBucketUsageCounts holds one site's data usage cache counts for a bucket.
+type srBucketUsageCounts struct {
+	DeploymentID            string `json:"deploymentID"`
+	HasBucket               bool   `json:"hasBucket"`
+	ObjectsCount            uint64 `json:"objectsCount"`
+	VersionsCount           uint64 `json:"versionsCount"`
+	DeleteMarkersCount      uint64 `json:"deleteMarkersCount"`
+	ReplicationPendingCount uint64 `json:"objectsPendingReplicationCount"`
+	ReplicationFailedCount  uint64 `json:"objectsFailedReplicationCount"`
+}
+
+// srBucketUsageDiff compares a single bucket's counts across sites.
+type srBucketUsageDiff struct {
+	Bucket   string                `json:"bucket"`
+	Mismatch bool                  `json:"mismatch"`
+	Sites    []srBucketUsageCounts `json:"sites"`
+}
+
+// srBucketDiffInfo is the response of the bucket-diff drill-down.
+type srBucketDiffInfo struct {
+	Enabled bool                `json:"enabled"`
+	Buckets []srBucketUsageDiff `json:"buckets,omitempty"`
+}
+
+// SiteReplicationBucketDiff compares per-bucket object/version counts and
+// replication tallies across peer sites using each site's data usage cache.
+// Buckets whose counts disagree are flagged so resync can be targeted at
+// them. Counts are as fresh as the last scanner cycle on each site.
+func (c *SiteReplicationSys) SiteReplicationBucketDiff(ctx context.Context, objAPI ObjectLayer, bucket string) (info srBucketDiffInfo, err error) {
+	c.RLock()
+	defer c.RUnlock()
+	if !c.enabled {
+		return info, nil
+	}
+
+	depIdx := make(map[string]int, len(c.state.Peers))
+	i := 0
+	for d := range c.state.Peers {
+		depIdx[d] = i
+		i++
+	}
+	siteCounts := make([]map[string]srBucketUsageCounts, len(c.state.Peers))
+
+	diffConcErr := c.concDo(
+		func() error {
+			dui, err := loadDataUsageFromBackend(ctx, objAPI)
+			if err != nil {
+				return err
+			}
+			m := make(map[string]srBucketUsageCounts, len(dui.BucketsUsage))
+			for b, u := range dui.BucketsUsage {
+				cnt := srBucketUsageCounts{
+					DeploymentID:       globalDeploymentID(),
+					HasBucket:          true,
+					ObjectsCount:       u.ObjectsCount,
+					VersionsCount:      u.VersionsCount,
+					DeleteMarkersCount: u.DeleteMarkersCount,
+				}
+				for _, t := range u.ReplicationInfo {
+					cnt.ReplicationPendingCount += t.ReplicationPendingCount
+					cnt.ReplicationFailedCount += t.ReplicationFailedCount
+				}
+				m[b] = cnt
+			}
+			siteCounts[depIdx[globalDeploymentID()]] = m
+			return nil
+		},
+		func(deploymentID string, p madmin.PeerInfo) error {
+			admClient, err := c.getAdminClient(ctx, deploymentID)
+			if err != nil {
+				switch err.(type) {
+				case RemoteTargetConnectionErr:
+					siteCounts[depIdx[deploymentID]] = nil
+					return nil
+				default:
+					return err
+				}
+			}
+			dui, err := admClient.DataUsageInfo(ctx)
+			if err != nil {
+				return err
+			}
+			m := make(map[string]srBucketUsageCounts, len(dui.BucketsUsage))
+			for b, u := range dui.BucketsUsage {
+				m[b] = srBucketUsageCounts{
+					DeploymentID:            deploymentID,
+					HasBucket:               true,
+					ObjectsCount:            u.ObjectsCount,
+					VersionsCount:           u.VersionsCount,
+					DeleteMarkersCount:      u.DeleteMarkersCount,
+					ReplicationPendingCount: u.ReplicationPendingCount,
+					ReplicationFailedCount:  u.ReplicationFailedCount,
+				}
+			}
+			siteCounts[depIdx[deploymentID]] = m
+			return nil
+		},
+		bucketUsageDiff,
+	)
+	if err := errors.Unwrap(diffConcErr); err != nil {
+		return info, errSRBackendIssue(err)
+	}
+
+	allBuckets := set.NewStringSet()
+	for _, m := range siteCounts {
+		for b := range m {
+			allBuckets.Add(b)
+		}
+	}
+	info.Enabled = true
+	for _, b := range allBuckets.ToSlice() {
+		if bucket != "" && b != bucket {
+			continue
+		}
+		d := srBucketUsageDiff{Bucket: b, Sites: make([]srBucketUsageCounts, 0, len(siteCounts))}
+		var first srBucketUsageCounts
+		for dID, idx := range depIdx {
+			cnt, ok := siteCounts[idx][b]
+			if !ok {
+				cnt = srBucketUsageCounts{DeploymentID: dID}
+			}
+			if len(d.Sites) == 0 {
+				first = cnt
+			} else if cnt.HasBucket != first.HasBucket ||
+				cnt.ObjectsCount != first.ObjectsCount ||
+				cnt.VersionsCount != first.VersionsCount ||
+				cnt.DeleteMarkersCount != first.DeleteMarkersCount {
+				d.Mismatch = true
+			}
+			d.Sites = append(d.Sites, cnt)
+		}
+		sort.Slice(d.Sites, func(i, j int) bool {
+			return d.Sites[i].DeploymentID < d.Sites[j].DeploymentID
+		})
+		info.Buckets = append(info.Buckets, d)
+	}
+	sort.Slice(info.Buckets, func(i, j int) bool {
+		return info.Buckets[i].Bucket < info.Buckets[j].Bucket
+	})
+	return info, nil
+}
+
 // siteReplicationStatus returns the site replication status across clusters participating in site replication.
 func (c *SiteReplicationSys) siteReplicationStatus(ctx context.Context, objAPI ObjectLayer, opts madmin.SRStatusOptions) (info srStatusInfo, err error) {
 	c.RLock()
@@ -3149,7 +3292,7 @@ func (c *SiteReplicationSys) siteReplicationStatus(ctx context.Context, objAPI O
 			olockConfigSet := set.NewStringSet()
 			policies := make([]*policy.BucketPolicy, numSites)
 			replCfgs := make([]*sreplication.Config, numSites)
-			quotaCfgs := make([]*madmin.BucketQuota, numSites)
+			quotaCfgs := make([]*bucketQuota, numSites)
 			sseCfgSet := set.NewStringSet()
 			versionCfgSet := set.NewStringSet()
 			var tagCount, olockCfgCount, sseCfgCount, versionCfgCount int
@@ -3266,7 +3409,7 @@ func (c *SiteReplicationSys) siteReplicationStatus(ctx context.Context, objAPI O
 					isBucketMarkedDeleted = !bi.DeletedAt.IsZero() && (bi.CreatedAt.IsZero() || bi.DeletedAt.After(bi.CreatedAt))
 					hasBucket = !bi.CreatedAt.IsZero()
 				}
-				quotaCfgSet := hasBucket && quotaCfgs[i] != nil && *quotaCfgs[i] != madmin.BucketQuota{}
+				quotaCfgSet := hasBucket && quotaCfgs[i] != nil && *quotaCfgs[i] != bucketQuota{}
 				ss := madmin.SRBucketStatsSummary{
 					DeploymentID:             s.DeploymentID,
 					HasBucket:                hasBucket,
@@ -3473,7 +3616,7 @@ func isGroupDescReplicated(cntReplicated, total int, gds []madmin.GroupDesc) boo
 	return true
 }
 
-func isBktQuotaCfgReplicated(total int, quotaCfgs []*madmin.BucketQuota) bool {
+func isBktQuotaCfgReplicated(total int, quotaCfgs []*bucketQuota) bool {
 	numquotaCfgs := 0
 	for _, q := range quotaCfgs {
 		if q == nil {
@@ -3487,7 +3630,7 @@ func isBktQuotaCfgReplicated(total int, quotaCfgs []*madmin.BucketQuota) bool {
 	if numquotaCfgs > 0 && numquotaCfgs != total {
 		return false
 	}
-	var prev *madmin.BucketQuota
+	var prev *bucketQuota
 	for i, q := range quotaCfgs {
 		if q == nil {
 			return false
@@ -3496,7 +3639,7 @@ func isBktQuotaCfgReplicated(total int, quotaCfgs []*madmin.BucketQuota) bool {
 			prev = q
 			continue
 		}
-		if prev.Quota != q.Quota || prev.Type != q.Type {
+		if prev.Quota != q.Quota || prev.Type != q.Type || prev.MaxObjects != q.MaxObjects {
 			return false
 		}
 	}
@@ -387,7 +387,7 @@ const (
 // newFileInfo - initializes new FileInfo, allocates a fresh erasure info.
 func newFileInfo(object string, dataBlocks, parityBlocks int) (fi FileInfo) {
 	fi.Erasure = ErasureInfo{
-		Algorithm:    erasureAlgorithm,
+		Algorithm:    selectedErasureAlgorithm(),
 		DataBlocks:   dataBlocks,
 		ParityBlocks: parityBlocks,
 		BlockSize:    blockSizeV2,
@@ -573,6 +573,25 @@ type ReadPartsResp struct {
 	Infos []*ObjectPartInfo `msg:"is"`
 }
 
+// ReadVersionsReq - send multiple object paths to read xl.meta from,
+// VersionIDs is optional and aligned with Paths, an empty entry reads the
+// latest version.
+type ReadVersionsReq struct {
+	Paths      []string `msg:"p"`
+	VersionIDs []string `msg:"v"`
+}
+
+// ReadVersionResult is the per path result of a batched ReadVersions call.
+type ReadVersionResult struct {
+	FileInfo FileInfo `msg:"fi"`
+	Error    string   `msg:"e"` // empty when the read succeeded
+}
+
+// ReadVersionsResp - is the response for ReadVersionsReq
+type ReadVersionsResp struct {
+	Versions []ReadVersionResult `msg:"vs"`
+}
+
 // DeleteBulkReq - send multiple paths in same delete request.
 type DeleteBulkReq struct {
 	Paths []string `msg:"p"`
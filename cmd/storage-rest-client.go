@@ -32,6 +32,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/klauspost/compress/s2"
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio/internal/bpool"
 	"github.com/minio/minio/internal/cachevalue"
@@ -530,6 +531,31 @@ func readMsgpReaderPoolPut(r *msgp.Reader) {
 	}
 }
 
+// decodeStorageRESTResponse undoes the optional s2 compression applied by
+// writeMsgpResponse on the server, the leading marker byte tells whether the
+// payload was compressed.
+func decodeStorageRESTResponse(respBody io.Reader) (io.Reader, error) {
+	var marker [1]byte
+	if _, err := io.ReadFull(respBody, marker[:]); err != nil {
+		return nil, err
+	}
+	switch marker[0] {
+	case storageRESTEncodingMarkerIdentity:
+		return respBody, nil
+	case storageRESTEncodingMarkerS2:
+		compressed, err := io.ReadAll(respBody)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := s2.Decode(nil, compressed)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(payload), nil
+	}
+	return nil, fmt.Errorf("unknown storage response encoding marker %d", marker[0])
+}
+
 func (client *storageRESTClient) ReadVersion(ctx context.Context, origvolume, volume, path, versionID string, opts ReadOptions) (fi FileInfo, err error) {
 	ctx, cancel := context.WithTimeout(ctx, globalDriveConfig.GetMaxTimeout())
 	defer cancel()
@@ -558,6 +584,7 @@ func (client *storageRESTClient) ReadVersion(ctx context.Context, origvolume, vo
 	values.Set(storageRESTVersionID, versionID)
 	values.Set(storageRESTInclFreeVersions, strconv.FormatBool(opts.InclFreeVersions))
 	values.Set(storageRESTHealing, strconv.FormatBool(opts.Healing))
+	values.Set(storageRESTEncoding, storageRESTEncodingS2)
 
 	respBody, err := client.callGet(ctx, storageRESTMethodReadVersion, values, nil, -1)
 	if err != nil {
@@ -565,7 +592,12 @@ func (client *storageRESTClient) ReadVersion(ctx context.Context, origvolume, vo
 	}
 	defer xhttp.DrainBody(respBody)
 
-	dec := msgpNewReader(respBody)
+	rd, err := decodeStorageRESTResponse(respBody)
+	if err != nil {
+		return fi, err
+	}
+
+	dec := msgpNewReader(rd)
 	defer readMsgpReaderPoolPut(dec)
 
 	err = fi.DecodeMsg(dec)
@@ -593,6 +625,7 @@ func (client *storageRESTClient) ReadXL(ctx context.Context, volume string, path
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
+	values.Set(storageRESTEncoding, storageRESTEncodingS2)
 
 	respBody, err := client.callGet(ctx, storageRESTMethodReadXL, values, nil, -1)
 	if err != nil {
@@ -600,7 +633,12 @@ func (client *storageRESTClient) ReadXL(ctx context.Context, volume string, path
 	}
 	defer xhttp.DrainBody(respBody)
 
-	dec := msgpNewReader(respBody)
+	rd, err := decodeStorageRESTResponse(respBody)
+	if err != nil {
+		return rf, err
+	}
+
+	dec := msgpNewReader(rd)
 	defer readMsgpReaderPoolPut(dec)
 
 	err = rf.DecodeMsg(dec)
@@ -809,6 +847,39 @@ func (client *storageRESTClient) ReadParts(ctx context.Context, volume string, p
 	return readPartsResp.Infos, nil
 }
 
+// ReadVersions - reads xl.meta of multiple objects from a drive in one
+// round trip, results are returned in request order with per path errors.
+func (client *storageRESTClient) ReadVersions(ctx context.Context, volume string, req ReadVersionsReq) ([]ReadVersionResult, error) {
+	values := make(url.Values)
+	values.Set(storageRESTVolume, volume)
+
+	buf, err := req.MarshalMsg(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := client.call(ctx, storageRESTMethodReadVersions, values, bytes.NewReader(buf), -1)
+	defer xhttp.DrainBody(respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	respReader, err := waitForHTTPResponse(respBody)
+	if err != nil {
+		return nil, toStorageErr(err)
+	}
+
+	rd := msgpNewReader(respReader)
+	defer readMsgpReaderPoolPut(rd)
+
+	readVersionsResp := &ReadVersionsResp{}
+	if err = readVersionsResp.DecodeMsg(rd); err != nil {
+		return nil, toStorageErr(err)
+	}
+
+	return readVersionsResp.Versions, nil
+}
+
 // RenameFile - renames a file.
 func (client *storageRESTClient) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) (err error) {
 	ctx, cancel := context.WithTimeout(ctx, globalDriveConfig.GetMaxTimeout())
@@ -20,7 +20,7 @@ package cmd
 //go:generate msgp -file $GOFILE -unexported
 
 const (
-	storageRESTVersion       = "v63" // Introduce RenamePart and ReadParts API
+	storageRESTVersion       = "v65" // Introduce batched ReadVersions API
 	storageRESTVersionPrefix = SlashSeparator + storageRESTVersion
 	storageRESTPrefix        = minioReservedBucketPath + "/storage"
 )
@@ -45,6 +45,7 @@ const (
 	storageRESTMethodCleanAbandoned = "/cln"
 	storageRESTMethodDeleteBulk     = "/dblk"
 	storageRESTMethodReadParts      = "/rps"
+	storageRESTMethodReadVersions   = "/rvrs"
 )
 
 const (
@@ -72,6 +73,23 @@ const (
 	storageRESTOrigVolume       = "ovol"
 	storageRESTInclFreeVersions = "incl-fv"
 	storageRESTRange            = "rng"
+	storageRESTEncoding         = "enc"
+)
+
+// Optional response encoding, negotiated via the storageRESTEncoding query
+// parameter. When the client asks for "s2" the server prefixes the msgp
+// payload with a single marker byte and s2 compresses payloads above
+// storageRESTMinCompressSize - directory heavy xl.meta payloads compress
+// very well and dominate east-west traffic.
+const (
+	storageRESTEncodingS2 = "s2"
+
+	storageRESTEncodingMarkerIdentity = 0
+	storageRESTEncodingMarkerS2       = 1
+
+	// storageRESTMinCompressSize is the smallest payload worth compressing,
+	// below this the payload is sent as is behind an identity marker.
+	storageRESTMinCompressSize = 8 << 10
 )
 
 type nsScannerOptions struct {
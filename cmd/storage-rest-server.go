@@ -32,8 +32,10 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/klauspost/compress/s2"
 	"github.com/minio/minio/internal/bpool"
 	"github.com/minio/minio/internal/grid"
 	"github.com/tinylib/msgp/msgp"
@@ -51,6 +53,15 @@ import (
 
 var errDiskStale = errors.New("drive stale")
 
+// storageRESTCompressionStats accumulates the effect of storage RPC response
+// compression, reported via the internode network metrics.
+type storageRESTCompressionStats struct {
+	sentBytes  atomic.Uint64 // payload bytes sent after optional compression
+	savedBytes atomic.Uint64 // bytes shaved off the raw msgp payloads
+}
+
+var globalStorageRESTCompressionStats storageRESTCompressionStats
+
 // To abstract a disk over network.
 type storageRESTServer struct {
 	endpoint Endpoint
@@ -429,7 +440,42 @@ func (s *storageRESTServer) ReadVersionHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	storageLogIf(r.Context(), msgp.Encode(w, &fi))
+	s.writeMsgpResponse(w, r, &fi)
+}
+
+// writeMsgpResponse writes the msgp encoding of v to w. When the client
+// asked for s2 encoding the payload is prefixed with a marker byte and
+// compressed once it crosses storageRESTMinCompressSize.
+func (s *storageRESTServer) writeMsgpResponse(w http.ResponseWriter, r *http.Request, v interface {
+	msgp.Encodable
+	msgp.Marshaler
+}) {
+	if r.Form.Get(storageRESTEncoding) != storageRESTEncodingS2 {
+		storageLogIf(r.Context(), msgp.Encode(w, v))
+		return
+	}
+
+	payload, err := v.MarshalMsg(nil)
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	marker := [1]byte{storageRESTEncodingMarkerIdentity}
+	out := payload
+	if len(payload) >= storageRESTMinCompressSize {
+		if compressed := s2.Encode(nil, payload); len(compressed) < len(payload) {
+			marker[0] = storageRESTEncodingMarkerS2
+			out = compressed
+			globalStorageRESTCompressionStats.savedBytes.Add(uint64(len(payload) - len(compressed)))
+		}
+	}
+	globalStorageRESTCompressionStats.sentBytes.Add(uint64(len(out)))
+
+	if _, err = w.Write(marker[:]); err == nil {
+		_, err = w.Write(out)
+	}
+	storageLogIf(r.Context(), err)
 }
 
 // WriteMetadataHandler rpc handler to write new updated metadata.
@@ -512,7 +558,7 @@ func (s *storageRESTServer) ReadXLHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	storageLogIf(r.Context(), msgp.Encode(w, &rf))
+	s.writeMsgpResponse(w, r, &rf)
 }
 
 // ReadXLHandlerWS - read xl.meta for an object at path.
@@ -556,6 +602,31 @@ func (s *storageRESTServer) ReadPartsHandler(w http.ResponseWriter, r *http.Requ
 	storageLogIf(r.Context(), msgp.Encode(w, presp))
 }
 
+// ReadVersionsHandler - read xl.meta of multiple objects in one call.
+func (s *storageRESTServer) ReadVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		return
+	}
+	volume := r.Form.Get(storageRESTVolume)
+
+	var req ReadVersionsReq
+	if err := msgp.Decode(r.Body, &req); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	done := keepHTTPResponseAlive(w)
+	versions, err := s.getStorage().ReadVersions(r.Context(), volume, req)
+	done(nil)
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	vresp := &ReadVersionsResp{Versions: versions}
+	storageLogIf(r.Context(), msgp.Encode(w, vresp))
+}
+
 // ReadFileHandler - read section of a file.
 func (s *storageRESTServer) ReadFileHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -1367,6 +1438,7 @@ func registerStorageRESTHandlers(router *mux.Router, endpointServerPools Endpoin
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodCleanAbandoned).HandlerFunc(h(server.CleanAbandonedDataHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodDeleteBulk).HandlerFunc(h(server.DeleteBulkHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadParts).HandlerFunc(h(server.ReadPartsHandler))
+			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadVersions).HandlerFunc(h(server.ReadVersionsHandler))
 
 			subrouter.Methods(http.MethodGet).Path(storageRESTVersionPrefix + storageRESTMethodReadFileStream).HandlerFunc(h(server.ReadFileStreamHandler))
 			subrouter.Methods(http.MethodGet).Path(storageRESTVersionPrefix + storageRESTMethodReadVersion).HandlerFunc(h(server.ReadVersionHandler))
@@ -452,6 +452,11 @@ func (sts *stsAPIHandlers) AssumeRoleWithSSO(w http.ResponseWriter, r *http.Requ
 		// should be set and configured on your identity provider as
 		// part of JWT custom claims.
 		policySet, ok := policy.GetPoliciesFromClaims(claims, iamPolicyClaimNameOpenID())
+		if ok {
+			// Translate claim values (e.g. a roles array) through the
+			// claim policy map if one is configured for the provider.
+			policySet = globalIAMSys.OpenIDConfig.MapClaimPolicies(policySet)
+		}
 		policies := strings.Join(policySet.ToSlice(), ",")
 		if ok {
 			policyName = globalIAMSys.CurrentPolicies(policies)
@@ -22,11 +22,14 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/dustin/go-humanize"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio/internal/config/storageclass"
 	"github.com/minio/mux"
+	"github.com/minio/pkg/v3/env"
 	"github.com/minio/pkg/v3/policy"
 )
 
@@ -237,6 +240,40 @@ func (api adminAPIHandlers) VerifyTierHandler(w http.ResponseWriter, r *http.Req
 	writeSuccessNoContent(w)
 }
 
+// tierPricingEnvVar is a comma separated list of <tier-name>=<price> entries,
+// price being the cost in currency units per GiB per month of data held on
+// that tier, e.g. MINIO_TIER_PRICING="WARM-TIER=0.023,COLD-TIER=0.004".
+const tierPricingEnvVar = "MINIO_TIER_PRICING"
+
+// tierPricing parses the optional per-GiB-month pricing configured via
+// environment, unparseable entries are skipped.
+func tierPricing() map[string]float64 {
+	pricing := make(map[string]float64)
+	for _, entry := range strings.Split(env.Get(tierPricingEnvVar, ""), ",") {
+		tier, price, found := strings.Cut(entry, "=")
+		if !found {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(price), 64)
+		if err != nil || v < 0 {
+			continue
+		}
+		pricing[strings.TrimSpace(tier)] = v
+	}
+	return pricing
+}
+
+// tierInfoDetailed extends madmin.TierInfo with a per-bucket breakdown and
+// an estimated monthly cost when pricing is configured.
+type tierInfoDetailed struct {
+	madmin.TierInfo
+	EstimatedMonthlyCost float64                     `json:"estimatedMonthlyCost,omitempty"`
+	Buckets              map[string]madmin.TierStats `json:"buckets,omitempty"`
+	// RestoreThroughput is the observed download throughput from this tier
+	// while restoring transitioned objects, in bytes per second.
+	RestoreThroughput float64 `json:"restoreThroughputBytesPerSec,omitempty"`
+}
+
 func (api adminAPIHandlers) TierStatsHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -255,7 +292,32 @@ func (api adminAPIHandlers) TierStatsHandler(w http.ResponseWriter, r *http.Requ
 	dailyStats := globalNotificationSys.GetLastDayTierStats(ctx)
 	tierStats = dailyStats.addToTierInfo(tierStats)
 
-	data, err := json.Marshal(tierStats)
+	var v interface{} = tierStats
+	if r.Form.Get("detailed") == "true" {
+		pricing := tierPricing()
+		infos := make([]tierInfoDetailed, 0, len(tierStats))
+		for _, info := range tierStats {
+			di := tierInfoDetailed{
+				TierInfo:          info,
+				RestoreThroughput: globalTierMetrics.restoreThroughput(info.Name),
+			}
+			if price, ok := pricing[info.Name]; ok {
+				di.EstimatedMonthlyCost = float64(info.Stats.TotalSize) / humanize.GiByte * price
+			}
+			for bucket, bui := range dui.BucketsUsage {
+				if st, ok := bui.TierStats[info.Name]; ok {
+					if di.Buckets == nil {
+						di.Buckets = make(map[string]madmin.TierStats)
+					}
+					di.Buckets[bucket] = st
+				}
+			}
+			infos = append(infos, di)
+		}
+		v = infos
+	}
+
+	data, err := json.Marshal(v)
 	if err != nil {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
 		return
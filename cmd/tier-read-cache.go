@@ -0,0 +1,159 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/pkg/v3/env"
+)
+
+// Environment variables controlling the tier read cache. When enabled, a GET
+// of a transitioned object additionally warms a restored copy of it back on
+// to local drives, so that subsequent reads within the TTL are served locally
+// instead of streaming from the remote tier on every request.
+const (
+	tierReadCacheEnvVar     = "_MINIO_TIER_READ_CACHE"
+	tierReadCacheSizeEnvVar = "_MINIO_TIER_READ_CACHE_SIZE"
+	tierReadCacheTTLEnvVar  = "_MINIO_TIER_READ_CACHE_TTL_DAYS"
+
+	tierReadCacheDefaultSize = "10GiB"
+	tierReadCacheDefaultTTL  = 1
+)
+
+// tierReadCache warms recently read transitioned objects back on to local
+// drives as restored copies. Restored copies carry the usual x-amz-restore
+// metadata with an expiry, so the scanner removes them once the TTL lapses;
+// the cache only needs to bound how much it warms per TTL window and avoid
+// duplicate in-flight restores.
+type tierReadCache struct {
+	enabled bool
+	maxSize int64
+	ttlDays int
+
+	mu       sync.Mutex
+	inflight map[string]struct{}
+	warmed   map[int]int64 // bytes warmed per pool in the current window
+	windowAt time.Time
+}
+
+// globalTierReadCache is the cluster-wide tier read cache, disabled unless
+// configured via environment.
+var globalTierReadCache = newTierReadCache()
+
+func newTierReadCache() *tierReadCache {
+	c := &tierReadCache{
+		inflight: make(map[string]struct{}),
+		warmed:   make(map[int]int64),
+		ttlDays:  tierReadCacheDefaultTTL,
+		windowAt: time.Now().UTC(),
+	}
+	if env.Get(tierReadCacheEnvVar, config.EnableOff) != config.EnableOn {
+		return c
+	}
+	c.enabled = true
+	sz, err := humanize.ParseBytes(env.Get(tierReadCacheSizeEnvVar, tierReadCacheDefaultSize))
+	if err != nil {
+		sz, _ = humanize.ParseBytes(tierReadCacheDefaultSize)
+	}
+	c.maxSize = int64(sz)
+	if days, err := strconv.Atoi(env.Get(tierReadCacheTTLEnvVar, "")); err == nil && days > 0 {
+		c.ttlDays = days
+	}
+	return c
+}
+
+// admit reserves budget for warming an object of the given size on the given
+// pool, returning false if the cache is over budget or the object is already
+// being warmed.
+func (c *tierReadCache) admit(key string, poolIdx int, size int64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now().UTC()
+	// Restored copies from the previous window have expired by now, reset
+	// the per-pool accounting.
+	if now.Sub(c.windowAt) > time.Duration(c.ttlDays)*24*time.Hour {
+		c.warmed = make(map[int]int64)
+		c.windowAt = now
+	}
+	if _, ok := c.inflight[key]; ok {
+		return false
+	}
+	if c.warmed[poolIdx]+size > c.maxSize {
+		return false
+	}
+	c.inflight[key] = struct{}{}
+	c.warmed[poolIdx] += size
+	return true
+}
+
+func (c *tierReadCache) done(key string, poolIdx int, size int64, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.inflight, key)
+	if failed {
+		c.warmed[poolIdx] -= size
+	}
+}
+
+// transitionedObjectRestorer restores a transitioned object's contents back
+// on to local drives; implemented by erasureObjects.
+type transitionedObjectRestorer interface {
+	RestoreTransitionedObject(ctx context.Context, bucket, object string, opts ObjectOptions) error
+}
+
+// warm restores a transitioned object being read on to local drives in the
+// background, unless disabled, already restored, already being restored or
+// over the configured per-pool budget.
+func (c *tierReadCache) warm(objAPI transitionedObjectRestorer, poolIdx int, bucket, object string, oi ObjectInfo) {
+	if !c.enabled {
+		return
+	}
+	if !oi.IsRemote() || oi.RestoreOngoing || oi.DeleteMarker {
+		return
+	}
+	if oi.Size <= 0 || oi.Size > c.maxSize {
+		return
+	}
+	key := fmt.Sprintf("%d/%s/%s/%s", poolIdx, bucket, object, oi.VersionID)
+	if !c.admit(key, poolIdx, oi.Size) {
+		return
+	}
+
+	go func() {
+		opts := ObjectOptions{
+			Transition: TransitionOptions{
+				RestoreRequest: &RestoreObjectRequest{
+					Days: c.ttlDays,
+				},
+				RestoreExpiry: lifecycle.ExpectedExpiryTime(time.Now().UTC(), c.ttlDays),
+			},
+			VersionID: oi.VersionID,
+		}
+		err := objAPI.RestoreTransitionedObject(GlobalContext, bucket, object, opts)
+		c.done(key, poolIdx, oi.Size, err != nil)
+	}()
+}
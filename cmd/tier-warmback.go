@@ -0,0 +1,207 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/pkg/v3/env"
+)
+
+// Environment variable controlling how many ranged downloads are issued
+// concurrently against the remote tier while restoring a transitioned
+// object. All ranges of an object share the tier target's pooled
+// connections.
+const (
+	tierRestoreWorkersEnvVar  = "_MINIO_TIER_RESTORE_WORKERS"
+	tierRestoreDefaultWorkers = 4
+
+	// tierRestoreChunkSize is the size of each ranged download issued by a
+	// restore worker. Memory overhead of a parallel restore is bounded by
+	// workers x chunk-size.
+	tierRestoreChunkSize = 32 * humanize.MiByte
+
+	// tierRestoreParallelThreshold is the minimum object size for which
+	// ranged parallel downloads are used; smaller objects are restored
+	// with a single stream.
+	tierRestoreParallelThreshold = 128 * humanize.MiByte
+)
+
+func tierRestoreWorkers() int {
+	w, err := strconv.Atoi(env.Get(tierRestoreWorkersEnvVar, strconv.Itoa(tierRestoreDefaultWorkers)))
+	if err != nil || w < 1 {
+		return tierRestoreDefaultWorkers
+	}
+	return w
+}
+
+// getRestoreObjectReader returns a reader for the entire remote copy of a
+// transitioned object being restored, along with the number of bytes the
+// stream yields. Large unencrypted objects are downloaded as concurrent
+// ranges, everything else falls back to a single stream. The returned
+// stream records restore throughput on Close.
+func getRestoreObjectReader(ctx context.Context, bucket, object string, oi ObjectInfo, opts ObjectOptions) (io.ReadCloser, int64, error) {
+	workers := tierRestoreWorkers()
+	// Ranged downloads address the remote copy's byte range directly,
+	// which only matches oi.Size for unencrypted objects.
+	_, encrypted := crypto.IsEncrypted(oi.UserDefined)
+	if workers > 1 && oi.Size >= tierRestoreParallelThreshold && !encrypted {
+		tgtClient, err := globalTierConfigMgr.getDriver(ctx, oi.TransitionedObject.Tier)
+		if err != nil {
+			return nil, 0, err
+		}
+		rc := newParallelWarmBackendReader(ctx, tgtClient, oi, workers)
+		return newRestoreMeterReader(rc, oi.TransitionedObject.Tier), oi.Size, nil
+	}
+
+	var rs *HTTPRangeSpec
+	gr, err := getTransitionedObjectReader(ctx, bucket, object, rs, http.Header{}, oi, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	return newRestoreMeterReader(gr, oi.TransitionedObject.Tier), gr.ObjInfo.Size, nil
+}
+
+type warmBackendChunk struct {
+	buf []byte
+	err error
+}
+
+// newParallelWarmBackendReader streams the remote copy of a transitioned
+// object, fetching fixed-size ranges with the given number of concurrent
+// workers and reassembling them in order.
+func newParallelWarmBackendReader(ctx context.Context, tgtClient WarmBackend, oi ObjectInfo, workers int) io.ReadCloser {
+	pr, pw := io.Pipe()
+	ctx, cancel := context.WithCancel(ctx)
+
+	remoteObj := oi.TransitionedObject.Name
+	rv := remoteVersionID(oi.TransitionedObject.VersionID)
+
+	// Chunks are handed to the consumer in submission order, the channel's
+	// buffer bounds the number of ranges in flight.
+	chunks := make(chan chan warmBackendChunk, workers)
+	go func() {
+		defer close(chunks)
+		for off := int64(0); off < oi.Size; off += tierRestoreChunkSize {
+			length := min(tierRestoreChunkSize, oi.Size-off)
+			ch := make(chan warmBackendChunk, 1)
+			select {
+			case chunks <- ch:
+			case <-ctx.Done():
+				return
+			}
+			go func(off, length int64) {
+				timeTierAction := auditTierActions(ctx, oi.TransitionedObject.Tier, length)
+				r, err := tgtClient.Get(ctx, remoteObj, rv, WarmBackendGetOpts{
+					startOffset: off,
+					length:      length,
+				})
+				if err != nil {
+					timeTierAction(err)
+					ch <- warmBackendChunk{err: err}
+					return
+				}
+				buf := make([]byte, length)
+				_, err = io.ReadFull(r, buf)
+				timeTierAction(err)
+				r.Close()
+				if err != nil {
+					ch <- warmBackendChunk{err: err}
+					return
+				}
+				ch <- warmBackendChunk{buf: buf}
+			}(off, length)
+		}
+	}()
+
+	go func() {
+		for ch := range chunks {
+			var c warmBackendChunk
+			select {
+			case c = <-ch:
+			case <-ctx.Done():
+				cancel()
+				pw.CloseWithError(ctx.Err())
+				return
+			}
+			if c.err != nil {
+				cancel()
+				pw.CloseWithError(c.err)
+				return
+			}
+			if _, err := pw.Write(c.buf); err != nil {
+				// Reader side closed, stop outstanding fetches.
+				cancel()
+				return
+			}
+		}
+		cancel()
+		pw.Close()
+	}()
+
+	return &cancelReadCloser{ReadCloser: pr, cancel: cancel}
+}
+
+// cancelReadCloser cancels outstanding ranged downloads when the stream is
+// closed before being fully consumed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// restoreMeterReader counts bytes streamed from the remote tier during a
+// restore and records the observed throughput on Close.
+type restoreMeterReader struct {
+	rc    io.ReadCloser
+	tier  string
+	start time.Time
+	bytes int64
+}
+
+func newRestoreMeterReader(rc io.ReadCloser, tier string) *restoreMeterReader {
+	return &restoreMeterReader{
+		rc:    rc,
+		tier:  tier,
+		start: time.Now(),
+	}
+}
+
+func (m *restoreMeterReader) Read(p []byte) (int, error) {
+	n, err := m.rc.Read(p)
+	m.bytes += int64(n)
+	return n, err
+}
+
+func (m *restoreMeterReader) Close() error {
+	err := m.rc.Close()
+	if m.bytes > 0 {
+		globalTierMetrics.observeRestore(m.tier, m.bytes, time.Since(m.start))
+	}
+	return err
+}
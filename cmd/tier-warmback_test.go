@@ -0,0 +1,100 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+// rangeWarmBackend serves Get requests from an in-memory byte slice,
+// optionally failing reads past failAfter bytes.
+type rangeWarmBackend struct {
+	data      []byte
+	failAfter int64
+}
+
+func (r *rangeWarmBackend) Put(ctx context.Context, object string, rd io.Reader, length int64) (remoteVersionID, error) {
+	return "", NotImplemented{}
+}
+
+func (r *rangeWarmBackend) PutWithMeta(ctx context.Context, object string, rd io.Reader, length int64, meta map[string]string) (remoteVersionID, error) {
+	return "", NotImplemented{}
+}
+
+func (r *rangeWarmBackend) Get(ctx context.Context, object string, rv remoteVersionID, opts WarmBackendGetOpts) (io.ReadCloser, error) {
+	off, length := opts.startOffset, opts.length
+	if length == 0 {
+		length = int64(len(r.data)) - off
+	}
+	if r.failAfter > 0 && off >= r.failAfter {
+		return nil, errors.New("backend read failed")
+	}
+	if off < 0 || off+length > int64(len(r.data)) {
+		return nil, errors.New("invalid range")
+	}
+	return io.NopCloser(bytes.NewReader(r.data[off : off+length])), nil
+}
+
+func (r *rangeWarmBackend) Remove(ctx context.Context, object string, rv remoteVersionID) error {
+	return NotImplemented{}
+}
+
+func (r *rangeWarmBackend) InUse(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func TestParallelWarmBackendReader(t *testing.T) {
+	// Sized to exercise multiple chunks with an uneven tail.
+	data := make([]byte, 2*tierRestoreChunkSize+tierRestoreChunkSize/3)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	oi := ObjectInfo{
+		Size: int64(len(data)),
+		TransitionedObject: TransitionedObject{
+			Name: "remote-obj",
+			Tier: "WARM-TEST",
+		},
+	}
+
+	rc := newParallelWarmBackendReader(context.Background(), &rangeWarmBackend{data: data}, oi, 3)
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	rc.Close()
+	if !bytes.Equal(got, data) {
+		t.Fatal("reassembled stream differs from source data")
+	}
+
+	// A failing range must surface its error in order.
+	rc = newParallelWarmBackendReader(context.Background(), &rangeWarmBackend{data: data, failAfter: tierRestoreChunkSize}, oi, 3)
+	n, err := io.Copy(io.Discard, rc)
+	rc.Close()
+	if err == nil {
+		t.Fatal("expected error from failing backend read")
+	}
+	if n != tierRestoreChunkSize {
+		t.Fatalf("expected %d bytes before failure, got %d", tierRestoreChunkSize, n)
+	}
+}
@@ -94,11 +94,15 @@ type TierConfigMgr struct {
 }
 
 type tierMetrics struct {
-	sync.RWMutex  // protects requestsCount only
+	sync.RWMutex  // protects requestsCount and restoreStats only
 	requestsCount map[string]struct {
 		success int64
 		failure int64
 	}
+	restoreStats map[string]struct {
+		bytes    int64
+		duration time.Duration
+	}
 	histogram *prometheus.HistogramVec
 }
 
@@ -107,6 +111,10 @@ var globalTierMetrics = tierMetrics{
 		success int64
 		failure int64
 	}),
+	restoreStats: make(map[string]struct {
+		bytes    int64
+		duration time.Duration
+	}),
 	histogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "tier_ttlb_seconds",
 		Help:    "Time taken by requests served by warm tier",
@@ -136,6 +144,31 @@ func (t *tierMetrics) logFailure(tier string) {
 	t.requestsCount[tier] = stat
 }
 
+// observeRestore accumulates bytes downloaded from a tier while restoring
+// transitioned objects and the time spent downloading them.
+func (t *tierMetrics) observeRestore(tier string, bytes int64, dur time.Duration) {
+	t.Lock()
+	defer t.Unlock()
+
+	stat := t.restoreStats[tier]
+	stat.bytes += bytes
+	stat.duration += dur
+	t.restoreStats[tier] = stat
+}
+
+// restoreThroughput returns the observed restore download throughput for a
+// tier in bytes per second, zero if nothing was restored from it yet.
+func (t *tierMetrics) restoreThroughput(tier string) float64 {
+	t.RLock()
+	defer t.RUnlock()
+
+	stat := t.restoreStats[tier]
+	if stat.duration <= 0 {
+		return 0
+	}
+	return float64(stat.bytes) / stat.duration.Seconds()
+}
+
 var (
 	// {minio_node}_{tier}_{ttlb_seconds_distribution}
 	tierTTLBMD = MetricDescription{
@@ -39,6 +39,9 @@ var errDataTooSmall = errors.New("Object size smaller than expected")
 // errServerNotInitialized - server not initialized.
 var errServerNotInitialized = errors.New("Server not initialized, please try again")
 
+// errReadOnlyMode - write request rejected during read-only maintenance mode.
+var errReadOnlyMode = errors.New("Server is in read-only maintenance mode")
+
 // errRPCAPIVersionUnsupported - unsupported rpc API version.
 var errRPCAPIVersionUnsupported = errors.New("Unsupported rpc API version")
 
@@ -92,6 +95,10 @@ var errGroupNotEmpty = errors.New("Specified group is not empty - cannot remove
 // error returned in IAM subsystem when a group is disabled
 var errGroupDisabled = errors.New("Specified group is disabled")
 
+// error returned in IAM subsystem when adding a group as a member of another
+// group would create a membership cycle.
+var errGroupMembershipCycle = errors.New("Specified group membership would create a cycle")
+
 // error returned in IAM subsystem when policy doesn't exist.
 var errNoSuchPolicy = errors.New("Specified canned policy does not exist")
 
@@ -103,6 +103,38 @@ func (az *warmBackendAzure) Remove(ctx context.Context, object string, rv remote
 	return azureToObjectError(err, az.Bucket, az.getDest(object))
 }
 
+func (az *warmBackendAzure) blobClient(object string) *blob.Client {
+	return az.clnt.ServiceClient().NewContainerClient(az.Bucket).NewBlobClient(az.getDest(object))
+}
+
+// Rehydrate moves an archive access tier blob to the hot tier so that its
+// contents can be read back, Azure rehydrates the blob asynchronously. Note
+// that unlike S3 Glacier restores the remote blob remains on the hot tier
+// afterwards.
+func (az *warmBackendAzure) Rehydrate(ctx context.Context, object string, rv remoteVersionID) error {
+	rehydratePriority := blob.RehydratePriorityStandard
+	_, err := az.blobClient(object).SetTier(ctx, blob.AccessTierHot, &blob.SetTierOptions{
+		RehydratePriority: &rehydratePriority,
+	})
+	return azureToObjectError(err, az.Bucket, az.getDest(object))
+}
+
+// RehydrateStatus reports whether the blob is still on the archive access
+// tier, being rehydrated or readable.
+func (az *warmBackendAzure) RehydrateStatus(ctx context.Context, object string, rv remoteVersionID) (rehydrateStatus, error) {
+	resp, err := az.blobClient(object).GetProperties(ctx, nil)
+	if err != nil {
+		return rehydrateNone, azureToObjectError(err, az.Bucket, az.getDest(object))
+	}
+	if resp.ArchiveStatus != nil {
+		return rehydratePending, nil
+	}
+	if resp.AccessTier != nil && strings.EqualFold(*resp.AccessTier, string(blob.AccessTierArchive)) {
+		return rehydrateRequired, nil
+	}
+	return rehydrateNone, nil
+}
+
 func (az *warmBackendAzure) InUse(ctx context.Context) (bool, error) {
 	maxResults := int32(1)
 	pager := az.clnt.NewListBlobsFlatPager(az.Bucket, &azblob.ListBlobsFlatOptions{
@@ -142,6 +174,19 @@ func (conf azureConf) Validate() error {
 		return errors.New("no bucket name was provided")
 	}
 
+	if conf.StorageClass != "" {
+		valid := false
+		for _, t := range blob.PossibleAccessTierValues() {
+			if strings.EqualFold(conf.StorageClass, string(t)) {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("unsupported storage class %s, expected one of %v", conf.StorageClass, blob.PossibleAccessTierValues())
+		}
+	}
+
 	return nil
 }
 
@@ -44,6 +44,28 @@ type WarmBackend interface {
 	InUse(ctx context.Context) (bool, error)
 }
 
+// rehydrateStatus describes the archived state of an object on a remote tier.
+type rehydrateStatus int
+
+const (
+	// object contents are readable, no rehydration is needed
+	rehydrateNone rehydrateStatus = iota
+	// object is archived and rehydration hasn't been initiated yet
+	rehydrateRequired
+	// rehydration is in progress
+	rehydratePending
+)
+
+// WarmBackendRehydrater is implemented by tier backends whose target storage
+// class may keep objects in an archived state that must be rehydrated before
+// their contents can be read back, e.g Azure archive access tier.
+type WarmBackendRehydrater interface {
+	// Rehydrate initiates rehydration of the archived object.
+	Rehydrate(ctx context.Context, object string, rv remoteVersionID) error
+	// RehydrateStatus reports the rehydration state of the object.
+	RehydrateStatus(ctx context.Context, object string, rv remoteVersionID) (rehydrateStatus, error)
+}
+
 const probeObject = "probeobject"
 
 // checkWarmBackend checks if tier config credentials have sufficient privileges
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"path"
 	"runtime"
@@ -74,6 +75,7 @@ const (
 	storageMetricDeleteBulk
 	storageMetricRenamePart
 	storageMetricReadParts
+	storageMetricReadVersions
 
 	// .... add more
 
@@ -89,13 +91,16 @@ type xlStorageDiskIDCheck struct {
 
 	// apiCalls should be placed first so alignment is guaranteed for atomic operations.
 	apiCalls     [storageMetricLast]uint64
+	apiErrs      [storageMetricLast]uint64
 	apiLatencies [storageMetricLast]*lockedLastMinuteLatency
+	readLatency  readLatencyHistogram
 	diskID       atomic.Pointer[string]
 	storage      *xlStorage
 	health       *diskHealthTracker
 	healthCheck  bool
 
 	metricsCache *cachevalue.Cache[DiskMetrics]
+	slowCache    *cachevalue.Cache[time.Duration]
 	diskCtx      context.Context
 	diskCancel   context.CancelFunc
 }
@@ -180,12 +185,100 @@ func (e *lockedLastMinuteLatency) total() AccElem {
 	return e.lastMinuteLatency.getTotal()
 }
 
+// readLatencyBuckets are the upper bounds of the per-drive read latency
+// histogram, used to estimate read latency percentiles.
+var readLatencyBuckets = [...]time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// readLatencyHistogram keeps last minute read latencies in coarse buckets,
+// the last bucket collects everything above the largest bound.
+type readLatencyHistogram [len(readLatencyBuckets) + 1]lockedLastMinuteLatency
+
+func (h *readLatencyHistogram) add(value time.Duration) {
+	for i, bound := range readLatencyBuckets {
+		if value <= bound {
+			h[i].add(value)
+			return
+		}
+	}
+	h[len(readLatencyBuckets)].add(value)
+}
+
+// percentile estimates the given read latency percentile (0 < q <= 1) over
+// the last minute. The second return value is the number of samples the
+// estimate is based on.
+func (h *readLatencyHistogram) percentile(q float64) (time.Duration, int64) {
+	var totals [len(readLatencyBuckets) + 1]AccElem
+	var n int64
+	for i := range h {
+		totals[i] = h[i].total()
+		n += totals[i].N
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	want := int64(math.Ceil(q * float64(n)))
+	var seen int64
+	for i, t := range totals {
+		seen += t.N
+		if seen >= want {
+			if i < len(readLatencyBuckets) {
+				return readLatencyBuckets[i], n
+			}
+			// Overflow bucket - report the average of its samples.
+			return time.Duration(t.Total / t.N), n
+		}
+	}
+	return readLatencyBuckets[len(readLatencyBuckets)-1], n
+}
+
+// slowDriveMinSamples is the minimum number of last minute read samples
+// required before a drive may be classified as slow.
+const slowDriveMinSamples = 10
+
+// readMedianLatency returns the median read latency of the drive over the
+// last minute. Returns zero when there are not enough samples.
+func (p *xlStorageDiskIDCheck) readMedianLatency() time.Duration {
+	p.slowCache.InitOnce(5*time.Second,
+		cachevalue.Opts{},
+		func(ctx context.Context) (time.Duration, error) {
+			median, n := p.readLatency.percentile(0.5)
+			if n < slowDriveMinSamples {
+				return 0, nil
+			}
+			return median, nil
+		},
+	)
+
+	latency, _ := p.slowCache.Get()
+	return latency
+}
+
+// isSlow reports whether the drive has been consistently slow serving reads
+// over the last minute compared to the given threshold.
+func (p *xlStorageDiskIDCheck) isSlow(threshold time.Duration) bool {
+	return threshold > 0 && p.readMedianLatency() > threshold
+}
+
 func newXLStorageDiskIDCheck(storage *xlStorage, healthCheck bool) *xlStorageDiskIDCheck {
 	xl := xlStorageDiskIDCheck{
 		storage:      storage,
 		health:       newDiskHealthTracker(),
 		healthCheck:  healthCheck && globalDriveMonitoring,
 		metricsCache: cachevalue.New[DiskMetrics](),
+		slowCache:    cachevalue.New[time.Duration](),
 	}
 	xl.SetDiskID(emptyDiskID)
 
@@ -721,6 +814,20 @@ func (p *xlStorageDiskIDCheck) ReadParts(ctx context.Context, volume string, par
 	return p.storage.ReadParts(ctx, volume, partMetaPaths...)
 }
 
+func (p *xlStorageDiskIDCheck) ReadVersions(ctx context.Context, volume string, req ReadVersionsReq) ([]ReadVersionResult, error) {
+	var path string
+	if len(req.Paths) > 0 {
+		path = req.Paths[0]
+	}
+	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricReadVersions, volume, path)
+	if err != nil {
+		return nil, err
+	}
+	defer done(0, &err)
+
+	return p.storage.ReadVersions(ctx, volume, req)
+}
+
 // ReadMultiple will read multiple files and send each files as response.
 // Files are read and returned in the given order.
 // The resp channel is closed before the call returns.
@@ -799,8 +906,27 @@ func (p *xlStorageDiskIDCheck) updateStorageMetrics(s storageMetric, paths ...st
 			}
 		}
 
+		// Count failed calls, routine lookup misses and canceled
+		// requests are not drive errors.
+		if err != nil && !IsErr(err, []error{
+			errFileNotFound,
+			errVolumeNotFound,
+			errFileVersionNotFound,
+			errPathNotFound,
+			context.Canceled,
+		}...) {
+			atomic.AddUint64(&p.apiErrs[s], 1)
+		}
+
 		p.apiLatencies[s].add(duration)
 
+		switch s {
+		case storageMetricReadFile, storageMetricReadFileStream, storageMetricReadXL,
+			storageMetricReadVersion, storageMetricReadAll, storageMetricReadParts,
+			storageMetricReadVersions:
+			p.readLatency.add(duration)
+		}
+
 		if trace {
 			custom := make(map[string]string, 2)
 			paths = append([]string{p.String()}, paths...)
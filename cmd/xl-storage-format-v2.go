@@ -124,7 +124,10 @@ type ErasureAlgo uint8
 const (
 	invalidErasureAlgo ErasureAlgo = 0
 	ReedSolomon        ErasureAlgo = 1
-	lastErasureAlgo    ErasureAlgo = 2
+	// ReedSolomonCauchy uses a Cauchy coding matrix, the layout produced
+	// by ISA-L based encoders, allowing shard interchange with them.
+	ReedSolomonCauchy ErasureAlgo = 2
+	lastErasureAlgo   ErasureAlgo = 3
 )
 
 func (e ErasureAlgo) valid() bool {
@@ -1635,7 +1638,7 @@ func (x *xlMetaV2) AddVersion(fi FileInfo) error {
 			DataDir:            dd,
 			Size:               fi.Size,
 			ModTime:            fi.ModTime.UnixNano(),
-			ErasureAlgorithm:   ReedSolomon,
+			ErasureAlgorithm:   erasureAlgoFromFileInfo(fi),
 			ErasureM:           fi.Erasure.DataBlocks,
 			ErasureN:           fi.Erasure.ParityBlocks,
 			ErasureBlockSize:   fi.Erasure.BlockSize,
@@ -1740,6 +1743,14 @@ func (x *xlMetaV2) AddVersion(fi FileInfo) error {
 			// Allowing delete marker to replaced with proper
 			// object data type as well, this is not S3 complaint
 			// behavior but kept here for future flexibility.
+			if globalAPIConfig.isStrictS3Compat() && uv != (uuid.UUID{}) {
+				// Strict S3 compatibility does not allow a specific
+				// delete marker version to be overwritten with object
+				// data. Replacing the `null` delete marker stays
+				// allowed - S3 itself overwrites it on a PUT when
+				// versioning is suspended.
+				return errMethodNotAllowed
+			}
 			return x.setIdx(i, ventry)
 		}
 	}
@@ -20,10 +20,11 @@ const _VersionType_name = "invalidVersionTypeObjectTypeDeleteTypeLegacyTypelastV
 var _VersionType_index = [...]uint8{0, 18, 28, 38, 48, 63}
 
 func (i VersionType) String() string {
-	if i >= VersionType(len(_VersionType_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_VersionType_index)-1 {
 		return "VersionType(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _VersionType_name[_VersionType_index[i]:_VersionType_index[i+1]]
+	return _VersionType_name[_VersionType_index[idx]:_VersionType_index[idx+1]]
 }
 func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
@@ -31,16 +32,18 @@ func _() {
 	var x [1]struct{}
 	_ = x[invalidErasureAlgo-0]
 	_ = x[ReedSolomon-1]
-	_ = x[lastErasureAlgo-2]
+	_ = x[ReedSolomonCauchy-2]
+	_ = x[lastErasureAlgo-3]
 }
 
-const _ErasureAlgo_name = "invalidErasureAlgoReedSolomonlastErasureAlgo"
+const _ErasureAlgo_name = "invalidErasureAlgoReedSolomonReedSolomonCauchylastErasureAlgo"
 
-var _ErasureAlgo_index = [...]uint8{0, 18, 29, 44}
+var _ErasureAlgo_index = [...]uint8{0, 18, 29, 46, 61}
 
 func (i ErasureAlgo) String() string {
-	if i >= ErasureAlgo(len(_ErasureAlgo_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_ErasureAlgo_index)-1 {
 		return "ErasureAlgo(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _ErasureAlgo_name[_ErasureAlgo_index[i]:_ErasureAlgo_index[i+1]]
+	return _ErasureAlgo_name[_ErasureAlgo_index[idx]:_ErasureAlgo_index[idx+1]]
 }
@@ -122,6 +122,13 @@ type Credentials struct {
 	Name         string                 `xml:"-" json:"name,omitempty"`
 	Description  string                 `xml:"-" json:"description,omitempty"`
 
+	// PreviousSecretKey together with RotatedAt and PreviousKeyValidTill
+	// implement secret key rotation with a grace window: requests signed
+	// with the previous secret continue to verify until the window expires.
+	PreviousSecretKey    string    `xml:"-" json:"previousSecretKey,omitempty" yaml:"-"`
+	RotatedAt            time.Time `xml:"-" json:"rotatedAt,omitempty" yaml:"-"`
+	PreviousKeyValidTill time.Time `xml:"-" json:"previousKeyValidTill,omitempty" yaml:"-"`
+
 	// Deprecated: In favor of Description - when reading credentials from
 	// storage the value of this field is placed in the Description field above
 	// if the existing Description from storage is empty.
@@ -153,6 +160,15 @@ func (cred Credentials) IsExpired() bool {
 	return cred.Expiration.Before(time.Now().UTC())
 }
 
+// HasValidPreviousKey - returns whether the previous secret key is still
+// inside its rotation grace window.
+func (cred Credentials) HasValidPreviousKey() bool {
+	if cred.PreviousSecretKey == "" || cred.PreviousKeyValidTill.IsZero() {
+		return false
+	}
+	return time.Now().UTC().Before(cred.PreviousKeyValidTill)
+}
+
 // IsTemp - returns whether credential is temporary or not.
 func (cred Credentials) IsTemp() bool {
 	return cred.SessionToken != "" && !cred.Expiration.IsZero() && !cred.Expiration.Equal(timeSentinel)
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bandwidth
+
+import (
+	"context"
+	"io"
+	"math"
+)
+
+// MonitoredWriter represents a throttled writer subject to bandwidth monitoring,
+// used to limit egress traffic written back to the client.
+type MonitoredWriter struct {
+	w        io.Writer
+	throttle *bucketThrottle
+	ctx      context.Context // request context
+	lastErr  error           // last error reported, if this non-nil all writes will fail.
+	m        *Monitor
+	opts     *MonitorWriterOptions
+}
+
+// MonitorWriterOptions provides configurable options for monitor writer implementation.
+type MonitorWriterOptions struct {
+	BucketOptions
+}
+
+// Write implements a throttled write
+func (w *MonitoredWriter) Write(buf []byte) (n int, err error) {
+	if w.throttle == nil {
+		return w.w.Write(buf)
+	}
+	if w.lastErr != nil {
+		err = w.lastErr
+		return
+	}
+	for len(buf) > n {
+		// request tokens for the remaining payload, up to the
+		// maximum burst supported by the throttle.
+		tokens := int(math.Min(float64(w.throttle.Burst()), float64(len(buf)-n)))
+		if err = w.throttle.WaitN(w.ctx, tokens); err != nil {
+			w.lastErr = err
+			return
+		}
+		var written int
+		written, err = w.w.Write(buf[n : n+tokens])
+		n += written
+		if err != nil {
+			w.lastErr = err
+			return
+		}
+		w.m.updateMeasurement(w.opts.BucketOptions, uint64(written))
+	}
+	return
+}
+
+// NewMonitoredWriter returns reference to a monitored writer that throttles writes
+// to the configured bandwidth for the bucket.
+func NewMonitoredWriter(ctx context.Context, m *Monitor, w io.Writer, opts *MonitorWriterOptions) *MonitoredWriter {
+	writer := MonitoredWriter{
+		w:        w,
+		throttle: m.throttle(opts.BucketOptions),
+		m:        m,
+		opts:     opts,
+		ctx:      ctx,
+	}
+	writer.m.init(opts.BucketOptions)
+	return &writer
+}
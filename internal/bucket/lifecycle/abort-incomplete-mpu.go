@@ -0,0 +1,63 @@
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lifecycle
+
+import (
+	"encoding/xml"
+)
+
+var errInvalidDaysAbortMPU = Errorf("DaysAfterInitiation must be a positive integer with AbortIncompleteMultipartUpload")
+
+// AbortIncompleteMultipartUpload used to xml encode/decode ILM action by the same name
+type AbortIncompleteMultipartUpload struct {
+	XMLName             xml.Name `xml:"AbortIncompleteMultipartUpload"`
+	DaysAfterInitiation int      `xml:"DaysAfterInitiation,omitempty"`
+}
+
+// Empty returns if an AbortIncompleteMultipartUpload XML element is empty.
+// Used to detect if lifecycle.Rule contained an AbortIncompleteMultipartUpload element.
+func (am AbortIncompleteMultipartUpload) Empty() bool {
+	return am.DaysAfterInitiation == 0
+}
+
+// UnmarshalXML decodes a single XML element into an AbortIncompleteMultipartUpload value
+func (am *AbortIncompleteMultipartUpload) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	type abortIncompleteMultipartUpload AbortIncompleteMultipartUpload
+	var ambu abortIncompleteMultipartUpload
+	err := dec.DecodeElement(&ambu, &start)
+	if err != nil {
+		return err
+	}
+
+	if ambu.DaysAfterInitiation <= 0 {
+		return errInvalidDaysAbortMPU
+	}
+
+	*am = AbortIncompleteMultipartUpload(ambu)
+	return nil
+}
+
+// MarshalXML encodes an AbortIncompleteMultipartUpload value into an XML element
+func (am AbortIncompleteMultipartUpload) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if am.Empty() {
+		return nil
+	}
+
+	type abortIncompleteMultipartUpload AbortIncompleteMultipartUpload
+	return enc.EncodeElement(abortIncompleteMultipartUpload(am), start)
+}
@@ -207,10 +207,35 @@ func (lc Lifecycle) HasActiveRules(prefix string) bool {
 		if !rule.Transition.IsNull() { // this allows for Transition.Days to be zero.
 			return true
 		}
+		if !rule.AbortIncompleteMultipartUpload.Empty() {
+			return true
+		}
 	}
 	return false
 }
 
+// AbortIncompleteMultipartUploadDays returns the smallest configured
+// DaysAfterInitiation among enabled rules whose prefix matches object.
+// Returns zero when no matching rule configures AbortIncompleteMultipartUpload.
+func (lc Lifecycle) AbortIncompleteMultipartUploadDays(object string) int {
+	days := 0
+	for _, rule := range lc.Rules {
+		if rule.Status == Disabled {
+			continue
+		}
+		if rule.AbortIncompleteMultipartUpload.Empty() {
+			continue
+		}
+		if !strings.HasPrefix(object, rule.GetPrefix()) {
+			continue
+		}
+		if days == 0 || rule.AbortIncompleteMultipartUpload.DaysAfterInitiation < days {
+			days = rule.AbortIncompleteMultipartUpload.DaysAfterInitiation
+		}
+	}
+	return days
+}
+
 // ParseLifecycleConfigWithID - parses for a Lifecycle config and assigns
 // unique id to rules with empty ID.
 func ParseLifecycleConfigWithID(r io.Reader) (*Lifecycle, error) {
@@ -424,6 +449,12 @@ func (lc Lifecycle) eval(obj ObjectOpts, now time.Time) Event {
 		}
 
 		if !obj.IsLatest && !rule.NoncurrentVersionTransition.IsNull() {
+			// Rules with a NewerNoncurrentVersions limit need the full list
+			// of versions to decide which ones overflow the limit, they are
+			// evaluated via NoncurrentVersionsTransitionLimit by the scanner.
+			if rule.NoncurrentVersionTransition.NewerNoncurrentVersions > 0 {
+				continue
+			}
 			if !obj.DeleteMarker && obj.TransitionStatus != TransitionComplete {
 				// Non current versions should be transitioned if their age exceeds non current days configuration
 				// https://docs.aws.amazon.com/AmazonS3/latest/dev/intro-lifecycle-rules.html#intro-lifecycle-rules-actions
@@ -558,3 +589,21 @@ func (lc Lifecycle) NoncurrentVersionsExpirationLimit(obj ObjectOpts) Event {
 	}
 	return Event{}
 }
+
+// NoncurrentVersionsTransitionLimit returns the number of noncurrent versions
+// to be retained untransitioned from the first applicable rule per S3 behavior.
+func (lc Lifecycle) NoncurrentVersionsTransitionLimit(obj ObjectOpts) Event {
+	for _, rule := range lc.FilterRules(obj) {
+		if rule.NoncurrentVersionTransition.NewerNoncurrentVersions == 0 {
+			continue
+		}
+		return Event{
+			Action:                  TransitionVersionAction,
+			RuleID:                  rule.ID,
+			NoncurrentDays:          int(rule.NoncurrentVersionTransition.NoncurrentDays),
+			NewerNoncurrentVersions: rule.NoncurrentVersionTransition.NewerNoncurrentVersions,
+			StorageClass:            rule.NoncurrentVersionTransition.StorageClass,
+		}
+	}
+	return Event{}
+}
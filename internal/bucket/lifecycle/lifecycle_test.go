@@ -1083,6 +1083,42 @@ func TestNoncurrentVersionsLimit(t *testing.T) {
 	}
 }
 
+func TestNoncurrentVersionsTransitionLimit(t *testing.T) {
+	lc := Lifecycle{
+		Rules: []Rule{
+			{
+				ID:     "1",
+				Status: "Enabled",
+				NoncurrentVersionTransition: NoncurrentVersionTransition{
+					NoncurrentDays:          2,
+					NewerNoncurrentVersions: 3,
+					StorageClass:            "TIER-1",
+					set:                     true,
+				},
+			},
+		},
+	}
+
+	event := lc.NoncurrentVersionsTransitionLimit(ObjectOpts{Name: "obj"})
+	if event.RuleID != "1" || event.NoncurrentDays != 2 || event.NewerNoncurrentVersions != 3 || event.StorageClass != "TIER-1" {
+		t.Fatalf("Expected (ruleID, days, lim, sc) to be (\"1\", 2, 3, \"TIER-1\") but got (%s, %d, %d, %s)",
+			event.RuleID, event.NoncurrentDays, event.NewerNoncurrentVersions, event.StorageClass)
+	}
+
+	// Rules with a NewerNoncurrentVersions limit must not emit a per-version
+	// transition event, the scanner applies them version-count aware.
+	obj := ObjectOpts{
+		Name:             "obj",
+		VersionID:        uuid.NewString(),
+		ModTime:          time.Now().UTC().Add(-10 * 24 * time.Hour),
+		SuccessorModTime: time.Now().UTC().Add(-10 * 24 * time.Hour),
+		IsLatest:         false,
+	}
+	if evt := lc.eval(obj, time.Now().UTC()); evt.Action != NoneAction {
+		t.Fatalf("Expected action: %s but got %s", NoneAction, evt.Action)
+	}
+}
+
 func TestMaxNoncurrentBackwardCompat(t *testing.T) {
 	testCases := []struct {
 		xml      string
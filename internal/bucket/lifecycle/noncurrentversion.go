@@ -97,9 +97,10 @@ func (n NoncurrentVersionExpiration) Validate() error {
 
 // NoncurrentVersionTransition - an action for lifecycle configuration rule.
 type NoncurrentVersionTransition struct {
-	NoncurrentDays TransitionDays `xml:"NoncurrentDays"`
-	StorageClass   string         `xml:"StorageClass"`
-	set            bool
+	NoncurrentDays          TransitionDays `xml:"NoncurrentDays"`
+	NewerNoncurrentVersions int            `xml:"NewerNoncurrentVersions,omitempty"`
+	StorageClass            string         `xml:"StorageClass"`
+	set                     bool
 }
 
 // MarshalXML is extended to leave out
@@ -139,6 +140,9 @@ func (n NoncurrentVersionTransition) Validate() error {
 	if n.StorageClass == "" {
 		return errXMLNotWellFormed
 	}
+	if n.NewerNoncurrentVersions < 0 {
+		return errXMLNotWellFormed
+	}
 	return nil
 }
 
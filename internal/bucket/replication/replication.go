@@ -173,6 +173,36 @@ func (c Config) HasExistingObjectReplication(arn string) (hasARN, isEnabled bool
 	return hasARN, false
 }
 
+// BatchedExistingDeletes returns true if historical delete markers and
+// versioned deletes for the given target ARN (or any target when arn is
+// empty) are configured to be replayed in batches during resync.
+func (c Config) BatchedExistingDeletes(arn string) bool {
+	for _, rule := range c.Rules {
+		if rule.Status == Disabled {
+			continue
+		}
+		if arn != "" && rule.Destination.ARN != arn && c.RoleArn != arn {
+			continue
+		}
+		if rule.ExistingObjectReplication.Status == Enabled && rule.ExistingObjectReplication.Batch == Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleClass returns the priority class of the highest priority enabled rule
+// matching the object name, the realtime class when unset.
+func (c Config) RuleClass(name string) PriorityClass {
+	for _, rule := range c.FilterActionableRules(ObjectOpts{Name: name}) {
+		if rule.PriorityClass == "" {
+			return PriorityClassRealtime
+		}
+		return rule.PriorityClass
+	}
+	return PriorityClassRealtime
+}
+
 // FilterActionableRules returns the rules actions that need to be executed
 // after evaluating prefix/tag filtering
 func (c Config) FilterActionableRules(obj ObjectOpts) []Rule {
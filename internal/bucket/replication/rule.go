@@ -93,6 +93,11 @@ func (d *DeleteReplication) UnmarshalXML(dec *xml.Decoder, start xml.StartElemen
 // ExistingObjectReplication - whether existing object replication is enabled
 type ExistingObjectReplication struct {
 	Status Status `xml:"Status"` // should be set to "Disabled" by default
+	// Batch is a MinIO only extension - when enabled, historical delete
+	// markers and versioned deletes are replayed to a newly added remote
+	// target in batches ordered by modification time instead of being
+	// queued one heal task per version.
+	Batch Status `xml:"Batch,omitempty" json:"Batch,omitempty"`
 }
 
 // IsEmpty returns true if ExistingObjectReplication is not set
@@ -108,6 +113,9 @@ func (e ExistingObjectReplication) Validate() error {
 	if e.Status != Disabled && e.Status != Enabled {
 		return errInvalidExistingObjectReplicationStatus
 	}
+	if len(e.Batch) > 0 && e.Batch != Disabled && e.Batch != Enabled {
+		return errInvalidExistingObjectReplicationStatus
+	}
 	return nil
 }
 
@@ -124,9 +132,34 @@ func (e *ExistingObjectReplication) UnmarshalXML(dec *xml.Decoder, start xml.Sta
 		erep.Status = Disabled
 	}
 	e.Status = erep.Status
+	e.Batch = erep.Batch
 	return nil
 }
 
+// PriorityClass - a MinIO only extension classifying a replication rule for
+// worker pool and bandwidth scheduling.
+type PriorityClass string
+
+// Supported priority classes
+const (
+	// PriorityClassRealtime is the default class for latency sensitive
+	// replication of incoming changes.
+	PriorityClassRealtime PriorityClass = "realtime"
+	// PriorityClassBulk is meant for large backfill rules which are served
+	// by a dedicated smaller worker pool and a reduced bandwidth share.
+	PriorityClassBulk PriorityClass = "bulk"
+)
+
+// Validate validates whether the priority class is supported, an empty class
+// defaults to realtime.
+func (pc PriorityClass) Validate() error {
+	switch pc {
+	case "", PriorityClassRealtime, PriorityClassBulk:
+		return nil
+	}
+	return errInvalidRulePriorityClass
+}
+
 // Rule - a rule for replication configuration.
 type Rule struct {
 	XMLName                 xml.Name                `xml:"Rule" json:"Rule"`
@@ -140,6 +173,8 @@ type Rule struct {
 	SourceSelectionCriteria   SourceSelectionCriteria   `xml:"SourceSelectionCriteria" json:"SourceSelectionCriteria"`
 	Filter                    Filter                    `xml:"Filter" json:"Filter"`
 	ExistingObjectReplication ExistingObjectReplication `xml:"ExistingObjectReplication,omitempty" json:"ExistingObjectReplication,omitempty"`
+	// MinIO extension to assign the rule to a priority class
+	PriorityClass PriorityClass `xml:"PriorityClass,omitempty" json:"PriorityClass,omitempty"`
 }
 
 var (
@@ -154,6 +189,7 @@ var (
 	errInvalidDeleteReplicationStatus         = Errorf("Delete replication is either enable|disable")
 	errInvalidExistingObjectReplicationStatus = Errorf("Existing object replication status is invalid")
 	errTagsDeleteMarkerReplicationDisallowed  = Errorf("Delete marker replication is not supported if any Tag filter is specified")
+	errInvalidRulePriorityClass               = Errorf("PriorityClass must be set to either realtime or bulk")
 )
 
 // validateID - checks if ID is valid or not.
@@ -243,6 +279,9 @@ func (r Rule) Validate(bucket string, sameTarget bool) error {
 	if !r.Filter.Tag.IsEmpty() && (r.DeleteMarkerReplication.Status == Enabled) {
 		return errTagsDeleteMarkerReplicationDisallowed
 	}
+	if err := r.PriorityClass.Validate(); err != nil {
+		return err
+	}
 	return r.ExistingObjectReplication.Validate()
 }
 
@@ -69,3 +69,57 @@ func TestMetadataReplicate(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchedExistingDeletes(t *testing.T) {
+	testCases := []struct {
+		inputConfig    string
+		arn            string
+		expectedResult bool
+	}{
+		// case 1 - existing object replication with batched deletes enabled
+		{
+			inputConfig:    `<ReplicationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Role>arn:aws:iam::AcctID:role/role-name</Role><Rule><Status>Enabled</Status><DeleteMarkerReplication><Status>Enabled</Status></DeleteMarkerReplication><DeleteReplication><Status>Enabled</Status></DeleteReplication><Prefix></Prefix><Destination><Bucket>arn:aws:s3:::destinationbucket</Bucket></Destination><ExistingObjectReplication><Status>Enabled</Status><Batch>Enabled</Batch></ExistingObjectReplication></Rule></ReplicationConfiguration>`,
+			arn:            "arn:aws:s3:::destinationbucket",
+			expectedResult: true,
+		},
+		// case 2 - existing object replication without batched deletes
+		{
+			inputConfig:    `<ReplicationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Role>arn:aws:iam::AcctID:role/role-name</Role><Rule><Status>Enabled</Status><DeleteMarkerReplication><Status>Enabled</Status></DeleteMarkerReplication><DeleteReplication><Status>Enabled</Status></DeleteReplication><Prefix></Prefix><Destination><Bucket>arn:aws:s3:::destinationbucket</Bucket></Destination><ExistingObjectReplication><Status>Enabled</Status></ExistingObjectReplication></Rule></ReplicationConfiguration>`,
+			arn:            "arn:aws:s3:::destinationbucket",
+			expectedResult: false,
+		},
+		// case 3 - batched deletes enabled for a different target ARN
+		{
+			inputConfig:    `<ReplicationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Role>arn:aws:iam::AcctID:role/role-name</Role><Rule><Status>Enabled</Status><DeleteMarkerReplication><Status>Enabled</Status></DeleteMarkerReplication><DeleteReplication><Status>Enabled</Status></DeleteReplication><Prefix></Prefix><Destination><Bucket>arn:aws:s3:::destinationbucket</Bucket></Destination><ExistingObjectReplication><Status>Enabled</Status><Batch>Enabled</Batch></ExistingObjectReplication></Rule></ReplicationConfiguration>`,
+			arn:            "arn:aws:s3:::otherbucket",
+			expectedResult: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("Test_%d", i+1), func(t *testing.T) {
+			cfg, err := ParseConfig(bytes.NewReader([]byte(tc.inputConfig)))
+			if err != nil {
+				t.Fatalf("Got unexpected error: %v", err)
+			}
+			if got := cfg.BatchedExistingDeletes(tc.arn); got != tc.expectedResult {
+				t.Fatalf("Expected result: `%v`, got: `%v`", tc.expectedResult, got)
+			}
+		})
+	}
+}
+
+func TestRuleClass(t *testing.T) {
+	inputConfig := `<ReplicationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Role>arn:aws:iam::AcctID:role/role-name</Role><Rule><Status>Enabled</Status><Priority>2</Priority><DeleteMarkerReplication><Status>Enabled</Status></DeleteMarkerReplication><DeleteReplication><Status>Enabled</Status></DeleteReplication><Filter><Prefix>backfill/</Prefix></Filter><Destination><Bucket>arn:aws:s3:::destinationbucket</Bucket></Destination><PriorityClass>bulk</PriorityClass></Rule><Rule><Status>Enabled</Status><Priority>1</Priority><DeleteMarkerReplication><Status>Enabled</Status></DeleteMarkerReplication><DeleteReplication><Status>Enabled</Status></DeleteReplication><Filter><Prefix></Prefix></Filter><Destination><Bucket>arn:aws:s3:::destinationbucket</Bucket></Destination></Rule></ReplicationConfiguration>`
+	cfg, err := ParseConfig(bytes.NewReader([]byte(inputConfig)))
+	if err != nil {
+		t.Fatalf("Got unexpected error: %v", err)
+	}
+	if got := cfg.RuleClass("backfill/object"); got != PriorityClassBulk {
+		t.Fatalf("Expected class %s, got %s", PriorityClassBulk, got)
+	}
+	if got := cfg.RuleClass("hot/object"); got != PriorityClassRealtime {
+		t.Fatalf("Expected class %s, got %s", PriorityClassRealtime, got)
+	}
+}
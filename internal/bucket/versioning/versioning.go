@@ -39,6 +39,9 @@ const (
 var (
 	errExcludedPrefixNotSupported = Errorf("excluded prefixes extension supported only when versioning is enabled")
 	errTooManyExcludedPrefixes    = Errorf("too many excluded prefixes")
+	errExcludedTagNotSupported    = Errorf("excluded tags extension supported only when versioning is enabled")
+	errTooManyExcludedTags        = Errorf("too many excluded tags")
+	errEmptyExcludedTagKey        = Errorf("excluded tag key cannot be empty")
 )
 
 // ExcludedPrefix - holds individual prefixes excluded from being versioned.
@@ -46,6 +49,35 @@ type ExcludedPrefix struct {
 	Prefix string
 }
 
+// ExcludedTag - holds a tag excluding objects from being versioned. An
+// empty Value matches any value of the tag key. A non-empty Prefix
+// restricts the rule to objects under that prefix, both conditions must
+// hold for the object to be excluded.
+type ExcludedTag struct {
+	Prefix string `xml:",omitempty"`
+	Key    string
+	Value  string
+}
+
+// String returns the rule in `key=value` form as it appears on objects,
+// prefixed with `prefix:` when the rule is restricted to a prefix.
+func (t ExcludedTag) String() string {
+	if t.Prefix != "" {
+		return t.Prefix + ":" + t.Key + "=" + t.Value
+	}
+	return t.Key + "=" + t.Value
+}
+
+// matches returns true when an object with the given name and tags falls
+// under the rule.
+func (t ExcludedTag) matches(prefix string, tags map[string]string) bool {
+	if t.Prefix != "" && !wildcard.MatchSimple(t.Prefix+"*", prefix) {
+		return false
+	}
+	value, ok := tags[t.Key]
+	return ok && (t.Value == "" || t.Value == value)
+}
+
 // Versioning - Configuration for bucket versioning.
 type Versioning struct {
 	XMLNS   string   `xml:"xmlns,attr,omitempty"`
@@ -56,6 +88,9 @@ type Versioning struct {
 	// Requires versioning to be enabled
 	ExcludedPrefixes []ExcludedPrefix `xml:",omitempty"`
 	ExcludeFolders   bool             `xml:",omitempty"`
+	// MinIO extension - excludes objects carrying a matching tag from
+	// being versioned. Requires versioning to be enabled
+	ExcludedTags []ExcludedTag `xml:",omitempty"`
 }
 
 // Validate - validates the versioning configuration
@@ -72,11 +107,23 @@ func (v Versioning) Validate() error {
 		if len(v.ExcludedPrefixes) > maxExcludedPrefixes {
 			return errTooManyExcludedPrefixes
 		}
+		const maxExcludedTags = 10
+		if len(v.ExcludedTags) > maxExcludedTags {
+			return errTooManyExcludedTags
+		}
+		for _, tag := range v.ExcludedTags {
+			if tag.Key == "" {
+				return errEmptyExcludedTagKey
+			}
+		}
 
 	case Suspended:
 		if len(v.ExcludedPrefixes) > 0 {
 			return errExcludedPrefixNotSupported
 		}
+		if len(v.ExcludedTags) > 0 {
+			return errExcludedTagNotSupported
+		}
 	default:
 		return Errorf("unsupported Versioning status %s", v.Status)
 	}
@@ -154,6 +201,50 @@ func (v Versioning) PrefixesExcluded() bool {
 	return len(v.ExcludedPrefixes) > 0 || v.ExcludeFolders
 }
 
+// TagsExcluded returns true if v contains one or more excluded object
+// tags.
+func (v Versioning) TagsExcluded() bool {
+	return len(v.ExcludedTags) > 0
+}
+
+// TagsSuspended returns true if an object with the given name and tags
+// matches an excluded tag rule while versioning is enabled on the
+// bucket. This is part of a MinIO versioning configuration extension.
+func (v Versioning) TagsSuspended(prefix string, tags map[string]string) bool {
+	if v.Status != Enabled {
+		return false
+	}
+	for _, tag := range v.ExcludedTags {
+		if tag.matches(prefix, tags) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludedRule returns a description of the first exclusion rule
+// suppressing versioning for an object with the given name and tags,
+// empty when versioning applies to the object.
+func (v Versioning) ExcludedRule(prefix string, tags map[string]string) string {
+	if v.Status != Enabled {
+		return ""
+	}
+	if v.ExcludeFolders && strings.HasSuffix(prefix, "/") {
+		return "ExcludeFolders"
+	}
+	for _, sprefix := range v.ExcludedPrefixes {
+		if wildcard.MatchSimple(sprefix.Prefix+"*", prefix) {
+			return "ExcludedPrefix=" + sprefix.Prefix
+		}
+	}
+	for _, tag := range v.ExcludedTags {
+		if tag.matches(prefix, tags) {
+			return "ExcludedTag=" + tag.String()
+		}
+	}
+	return ""
+}
+
 // ParseConfig - parses data in given reader to VersioningConfiguration.
 func ParseConfig(reader io.Reader) (*Versioning, error) {
 	var v Versioning
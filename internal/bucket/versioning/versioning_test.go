@@ -239,3 +239,87 @@ func TestExcludedPrefixesMatch(t *testing.T) {
 		}
 	}
 }
+
+func TestExcludedTagsMatch(t *testing.T) {
+	v := Versioning{
+		Status: Enabled,
+		ExcludedTags: []ExcludedTag{
+			{Key: "lifecycle", Value: "scratch"},
+			{Key: "temporary"},
+			{Prefix: "tmp/", Key: "stage", Value: "dev"},
+		},
+	}
+
+	if err := v.Validate(); err != nil {
+		t.Fatalf("Invalid test versioning config %v: %v", v, err)
+	}
+	tests := []struct {
+		prefix   string
+		tags     map[string]string
+		excluded bool
+	}{
+		{
+			prefix:   "obj",
+			tags:     map[string]string{"lifecycle": "scratch"},
+			excluded: true,
+		},
+		{
+			prefix:   "obj",
+			tags:     map[string]string{"lifecycle": "keep"},
+			excluded: false,
+		},
+		{
+			// Empty excluded tag value matches any value of the key.
+			prefix:   "obj",
+			tags:     map[string]string{"temporary": "yes"},
+			excluded: true,
+		},
+		{
+			prefix:   "obj",
+			tags:     nil,
+			excluded: false,
+		},
+		{
+			// Combined rule, prefix and tag must both match.
+			prefix:   "tmp/obj",
+			tags:     map[string]string{"stage": "dev"},
+			excluded: true,
+		},
+		{
+			prefix:   "other/obj",
+			tags:     map[string]string{"stage": "dev"},
+			excluded: false,
+		},
+		{
+			prefix:   "tmp/obj",
+			tags:     map[string]string{"stage": "prod"},
+			excluded: false,
+		},
+	}
+
+	for i, test := range tests {
+		if v.TagsSuspended(test.prefix, test.tags) != test.excluded {
+			if test.excluded {
+				t.Fatalf("Test %d: Expected tags %v to be excluded from versioning", i+1, test.tags)
+			} else {
+				t.Fatalf("Test %d: Expected tags %v to have versioning enabled", i+1, test.tags)
+			}
+		}
+	}
+
+	if rule := v.ExcludedRule("obj", map[string]string{"lifecycle": "scratch"}); rule != "ExcludedTag=lifecycle=scratch" {
+		t.Fatalf("Expected matching tag rule, got %q", rule)
+	}
+	if rule := v.ExcludedRule("tmp/obj", map[string]string{"stage": "dev"}); rule != "ExcludedTag=tmp/:stage=dev" {
+		t.Fatalf("Expected matching combined rule, got %q", rule)
+	}
+	if rule := v.ExcludedRule("obj", nil); rule != "" {
+		t.Fatalf("Expected no matching rule, got %q", rule)
+	}
+
+	// Excluded tags are rejected when versioning is suspended.
+	v.Status = Suspended
+	if err := v.Validate(); err != errExcludedTagNotSupported {
+		t.Fatalf("Expected %v, got %v", errExcludedTagNotSupported, err)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/minio/minio/internal/config"
 	"github.com/minio/pkg/v3/env"
 )
@@ -47,10 +48,13 @@ const (
 	apiDeleteCleanupInterval       = "delete_cleanup_interval"
 	apiDisableODirect              = "disable_odirect"
 	apiODirect                     = "odirect"
+	apiODirectMinSize              = "odirect_min_size"
+	apiODirectMaxQueue             = "odirect_max_queue"
 	apiGzipObjects                 = "gzip_objects"
 	apiRootAccess                  = "root_access"
 	apiSyncEvents                  = "sync_events"
 	apiObjectMaxVersions           = "object_max_versions"
+	apiStrictS3Compat              = "strict_s3_compat"
 
 	EnvAPIRequestsMax             = "MINIO_API_REQUESTS_MAX"
 	EnvAPIRequestsDeadline        = "MINIO_API_REQUESTS_DEADLINE"
@@ -70,11 +74,14 @@ const (
 	EnvDeleteCleanupInterval          = "MINIO_DELETE_CLEANUP_INTERVAL"
 	EnvAPIODirect                     = "MINIO_API_ODIRECT"
 	EnvAPIDisableODirect              = "MINIO_API_DISABLE_ODIRECT"
+	EnvAPIODirectMinSize              = "MINIO_API_ODIRECT_MIN_SIZE"
+	EnvAPIODirectMaxQueue             = "MINIO_API_ODIRECT_MAX_QUEUE"
 	EnvAPIGzipObjects                 = "MINIO_API_GZIP_OBJECTS"
 	EnvAPIRootAccess                  = "MINIO_API_ROOT_ACCESS" // default config.EnableOn
 	EnvAPISyncEvents                  = "MINIO_API_SYNC_EVENTS" // default "off"
 	EnvAPIObjectMaxVersions           = "MINIO_API_OBJECT_MAX_VERSIONS"
 	EnvAPIObjectMaxVersionsLegacy     = "_MINIO_OBJECT_MAX_VERSIONS"
+	EnvAPIStrictS3Compat              = "MINIO_API_STRICT_S3_COMPAT" // default "off"
 )
 
 // Deprecated key and ENVs
@@ -145,6 +152,14 @@ var (
 			Key:   apiODirect,
 			Value: config.EnableOn,
 		},
+		config.KV{
+			Key:   apiODirectMinSize,
+			Value: "1MiB",
+		},
+		config.KV{
+			Key:   apiODirectMaxQueue,
+			Value: "64",
+		},
 		config.KV{
 			Key:   apiGzipObjects,
 			Value: config.EnableOff,
@@ -161,6 +176,10 @@ var (
 			Key:   apiObjectMaxVersions,
 			Value: "9223372036854775807",
 		},
+		config.KV{
+			Key:   apiStrictS3Compat,
+			Value: config.EnableOff,
+		},
 	}
 )
 
@@ -179,10 +198,13 @@ type Config struct {
 	StaleUploadsExpiry          time.Duration `json:"stale_uploads_expiry"`
 	DeleteCleanupInterval       time.Duration `json:"delete_cleanup_interval"`
 	EnableODirect               bool          `json:"enable_odirect"`
+	ODirectMinSize              int64         `json:"odirect_min_size"`
+	ODirectMaxQueue             int           `json:"odirect_max_queue"`
 	GzipObjects                 bool          `json:"gzip_objects"`
 	RootAccess                  bool          `json:"root_access"`
 	SyncEvents                  bool          `json:"sync_events"`
 	ObjectMaxVersions           int64         `json:"object_max_versions"`
+	StrictS3Compat              bool          `json:"strict_s3_compat"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -322,8 +344,25 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 	}
 	cfg.StaleUploadsExpiry = staleUploadsExpiry
 
+	odirectMinSize, err := humanize.ParseBytes(env.Get(EnvAPIODirectMinSize, kvs.GetWithDefault(apiODirectMinSize, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	cfg.ODirectMinSize = int64(odirectMinSize)
+
+	odirectMaxQueue, err := strconv.Atoi(env.Get(EnvAPIODirectMaxQueue, kvs.GetWithDefault(apiODirectMaxQueue, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+	if odirectMaxQueue < 0 {
+		return cfg, fmt.Errorf("invalid value %v for odirect_max_queue", odirectMaxQueue)
+	}
+	cfg.ODirectMaxQueue = odirectMaxQueue
+
 	cfg.SyncEvents = env.Get(EnvAPISyncEvents, kvs.Get(apiSyncEvents)) == config.EnableOn
 
+	cfg.StrictS3Compat = env.Get(EnvAPIStrictS3Compat, kvs.Get(apiStrictS3Compat)) == config.EnableOn
+
 	maxVerStr := env.Get(EnvAPIObjectMaxVersions, "")
 	if maxVerStr == "" {
 		maxVerStr = env.Get(EnvAPIObjectMaxVersionsLegacy, kvs.Get(apiObjectMaxVersions))
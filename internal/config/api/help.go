@@ -98,6 +98,18 @@ var (
 			Optional:    true,
 			Type:        "boolean",
 		},
+		config.HelpKV{
+			Key:         apiODirectMinSize,
+			Description: "use buffered I/O instead of O_DIRECT for writes below this size" + defaultHelpPostfix(apiODirectMinSize),
+			Optional:    true,
+			Type:        "size",
+		},
+		config.HelpKV{
+			Key:         apiODirectMaxQueue,
+			Description: "use buffered I/O instead of O_DIRECT when more writes than this are in-flight per drive, '0' to disable the limit" + defaultHelpPostfix(apiODirectMaxQueue),
+			Optional:    true,
+			Type:        "number",
+		},
 		config.HelpKV{
 			Key:         apiRootAccess,
 			Description: "turn 'off' root credential access for all API calls including s3, admin operations" + defaultHelpPostfix(apiRootAccess),
@@ -116,5 +128,11 @@ var (
 			Optional:    true,
 			Type:        "number",
 		},
+		config.HelpKV{
+			Key:         apiStrictS3Compat,
+			Description: "set to disable MinIO specific behaviors and extension headers that diverge from AWS S3" + defaultHelpPostfix(apiStrictS3Compat),
+			Optional:    true,
+			Type:        "boolean",
+		},
 	}
 )
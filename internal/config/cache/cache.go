@@ -0,0 +1,143 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package cache holds the configuration of the read-through disk cache.
+// The cache is configured through environment variables only, cache drives
+// have to be mounted before the server starts and cannot be reconfigured
+// at runtime.
+package cache
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/pkg/v3/env"
+)
+
+// Cache environment variables
+const (
+	EnvCacheDrives        = "MINIO_CACHE_DRIVES"
+	EnvCacheExclude       = "MINIO_CACHE_EXCLUDE"
+	EnvCacheAfter         = "MINIO_CACHE_AFTER"
+	EnvCacheWatermarkLow  = "MINIO_CACHE_WATERMARK_LOW"
+	EnvCacheWatermarkHigh = "MINIO_CACHE_WATERMARK_HIGH"
+
+	// DefaultAfter - cache an object only after it has been read this
+	// many times.
+	DefaultAfter = 0
+
+	// DefaultWatermarkLow - stop evicting once cache drive usage drops
+	// below this percentage.
+	DefaultWatermarkLow = 70
+
+	// DefaultWatermarkHigh - start evicting once cache drive usage
+	// crosses this percentage.
+	DefaultWatermarkHigh = 80
+)
+
+// Config represents the read-through disk cache settings.
+type Config struct {
+	// Enabled is implied by one or more cache drives being configured.
+	Enabled bool `json:"enabled"`
+
+	// Drives are local directories on dedicated cache drives.
+	Drives []string `json:"drives"`
+
+	// Exclude are wildcard patterns of the form bucket/prefix* that are
+	// never cached.
+	Exclude []string `json:"exclude"`
+
+	// After is the minimum number of reads of an object before it is
+	// admitted into the cache, zero caches on first read.
+	After int `json:"after"`
+
+	// WatermarkLow and WatermarkHigh bound the eviction cycle as
+	// percentages of cache drive usage.
+	WatermarkLow  int `json:"watermark_low"`
+	WatermarkHigh int `json:"watermark_high"`
+}
+
+func splitList(s string) []string {
+	var entries []string
+	for _, entry := range strings.Split(s, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func lookupPercent(envName string, def int) (int, error) {
+	v := env.Get(envName, "")
+	if v == "" {
+		return def, nil
+	}
+	pct, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, config.ErrInvalidCacheWatermarkValue(err)
+	}
+	if pct <= 0 || pct > 100 {
+		return 0, config.ErrInvalidCacheWatermarkValue(nil).Msgf("%s expects a percentage between 1 and 100, got %d", envName, pct)
+	}
+	return pct, nil
+}
+
+// LookupConfig - reads the disk cache settings from the environment.
+func LookupConfig() (Config, error) {
+	cfg := Config{
+		After:         DefaultAfter,
+		WatermarkLow:  DefaultWatermarkLow,
+		WatermarkHigh: DefaultWatermarkHigh,
+	}
+
+	cfg.Drives = splitList(env.Get(EnvCacheDrives, ""))
+	if len(cfg.Drives) == 0 {
+		return cfg, nil
+	}
+	for _, drive := range cfg.Drives {
+		if !filepath.IsAbs(drive) {
+			return cfg, config.ErrInvalidCacheDrivesValue(nil).Msgf("cache drive `%s` is not an absolute path", drive)
+		}
+	}
+	cfg.Enabled = true
+
+	cfg.Exclude = splitList(env.Get(EnvCacheExclude, ""))
+
+	if v := env.Get(EnvCacheAfter, ""); v != "" {
+		after, err := strconv.Atoi(v)
+		if err != nil || after < 0 {
+			return cfg, config.ErrInvalidCacheAfterValue(err)
+		}
+		cfg.After = after
+	}
+
+	var err error
+	if cfg.WatermarkLow, err = lookupPercent(EnvCacheWatermarkLow, DefaultWatermarkLow); err != nil {
+		return cfg, err
+	}
+	if cfg.WatermarkHigh, err = lookupPercent(EnvCacheWatermarkHigh, DefaultWatermarkHigh); err != nil {
+		return cfg, err
+	}
+	if cfg.WatermarkLow > cfg.WatermarkHigh {
+		return cfg, config.ErrInvalidCacheWatermarkValue(nil).Msgf("low watermark %d cannot exceed high watermark %d",
+			cfg.WatermarkLow, cfg.WatermarkHigh)
+	}
+
+	return cfg, nil
+}
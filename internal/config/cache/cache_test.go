@@ -0,0 +1,124 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookupConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		envs    map[string]string
+		cfg     Config
+		success bool
+	}{
+		{
+			name: "disabled without drives",
+			envs: map[string]string{},
+			cfg: Config{
+				WatermarkLow:  DefaultWatermarkLow,
+				WatermarkHigh: DefaultWatermarkHigh,
+			},
+			success: true,
+		},
+		{
+			name: "drives with defaults",
+			envs: map[string]string{
+				EnvCacheDrives: "/mnt/cache1, /mnt/cache2",
+			},
+			cfg: Config{
+				Enabled:       true,
+				Drives:        []string{"/mnt/cache1", "/mnt/cache2"},
+				WatermarkLow:  DefaultWatermarkLow,
+				WatermarkHigh: DefaultWatermarkHigh,
+			},
+			success: true,
+		},
+		{
+			name: "full configuration",
+			envs: map[string]string{
+				EnvCacheDrives:        "/mnt/cache1",
+				EnvCacheExclude:       "bucket1/*,*.tmp",
+				EnvCacheAfter:         "3",
+				EnvCacheWatermarkLow:  "60",
+				EnvCacheWatermarkHigh: "90",
+			},
+			cfg: Config{
+				Enabled:       true,
+				Drives:        []string{"/mnt/cache1"},
+				Exclude:       []string{"bucket1/*", "*.tmp"},
+				After:         3,
+				WatermarkLow:  60,
+				WatermarkHigh: 90,
+			},
+			success: true,
+		},
+		{
+			name: "relative drive path",
+			envs: map[string]string{
+				EnvCacheDrives: "cache1",
+			},
+			success: false,
+		},
+		{
+			name: "negative after",
+			envs: map[string]string{
+				EnvCacheDrives: "/mnt/cache1",
+				EnvCacheAfter:  "-1",
+			},
+			success: false,
+		},
+		{
+			name: "watermark out of range",
+			envs: map[string]string{
+				EnvCacheDrives:        "/mnt/cache1",
+				EnvCacheWatermarkHigh: "101",
+			},
+			success: false,
+		},
+		{
+			name: "low watermark above high",
+			envs: map[string]string{
+				EnvCacheDrives:        "/mnt/cache1",
+				EnvCacheWatermarkLow:  "90",
+				EnvCacheWatermarkHigh: "80",
+			},
+			success: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for k, v := range testCase.envs {
+				t.Setenv(k, v)
+			}
+			cfg, err := LookupConfig()
+			if !testCase.success && err == nil {
+				t.Error("expected failure but success instead")
+			}
+			if testCase.success && err != nil {
+				t.Errorf("expected success but failed instead %s", err)
+			}
+			if testCase.success && !reflect.DeepEqual(cfg, testCase.cfg) {
+				t.Errorf("expected config %+v but got %+v", testCase.cfg, cfg)
+			}
+		})
+	}
+}
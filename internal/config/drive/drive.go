@@ -30,6 +30,8 @@ const (
 	EnvMaxDriveTimeout       = "MINIO_DRIVE_MAX_TIMEOUT"
 	EnvMaxDriveTimeoutLegacy = "_MINIO_DRIVE_MAX_TIMEOUT"
 	EnvMaxDiskTimeoutLegacy  = "_MINIO_DISK_MAX_TIMEOUT"
+	EnvSlowDriveThreshold    = "MINIO_DRIVE_SLOW_THRESHOLD"
+	EnvHedgeReadThreshold    = "MINIO_DRIVE_HEDGE_THRESHOLD"
 )
 
 // DefaultKVS - default KVS for drive
@@ -38,6 +40,14 @@ var DefaultKVS = config.KVS{
 		Key:   MaxTimeout,
 		Value: "30s",
 	},
+	config.KV{
+		Key:   SlowThreshold,
+		Value: "0s",
+	},
+	config.KV{
+		Key:   HedgeThreshold,
+		Value: "0s",
+	},
 }
 
 var configLk sync.RWMutex
@@ -46,6 +56,15 @@ var configLk sync.RWMutex
 type Config struct {
 	// MaxTimeout - maximum timeout for a drive operation
 	MaxTimeout time.Duration `json:"maxTimeout"`
+
+	// SlowThreshold - median last minute read latency beyond which a
+	// drive is considered slow, zero disables slow drive detection.
+	SlowThreshold time.Duration `json:"slowThreshold"`
+
+	// HedgeThreshold - time after which an erasure read that has not
+	// completed is hedged with an extra shard read, zero disables
+	// hedged reads.
+	HedgeThreshold time.Duration `json:"hedgeThreshold"`
 }
 
 // Update - updates the config with latest values
@@ -53,6 +72,8 @@ func (c *Config) Update(updated Config) error {
 	configLk.Lock()
 	defer configLk.Unlock()
 	c.MaxTimeout = getMaxTimeout(updated.MaxTimeout)
+	c.SlowThreshold = updated.SlowThreshold
+	c.HedgeThreshold = updated.HedgeThreshold
 	return nil
 }
 
@@ -69,6 +90,24 @@ func (c *Config) GetOPTimeout() time.Duration {
 	return getMaxTimeout(c.MaxTimeout)
 }
 
+// GetSlowThreshold - returns the slow drive latency threshold,
+// zero when slow drive detection is disabled.
+func (c *Config) GetSlowThreshold() time.Duration {
+	configLk.RLock()
+	defer configLk.RUnlock()
+
+	return c.SlowThreshold
+}
+
+// GetHedgeThreshold - returns the hedged read latency threshold,
+// zero when hedged reads are disabled.
+func (c *Config) GetHedgeThreshold() time.Duration {
+	configLk.RLock()
+	defer configLk.RUnlock()
+
+	return c.HedgeThreshold
+}
+
 // LookupConfig - lookup config and override with valid environment settings if any.
 func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 	cfg = Config{
@@ -90,6 +129,20 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 			cfg.MaxTimeout = getMaxTimeout(dur)
 		}
 	}
+
+	st := env.Get(EnvSlowDriveThreshold, kvs.GetWithDefault(SlowThreshold, DefaultKVS))
+	if st != "" {
+		if dur, _ := time.ParseDuration(st); dur > 0 {
+			cfg.SlowThreshold = dur
+		}
+	}
+
+	ht := env.Get(EnvHedgeReadThreshold, kvs.GetWithDefault(HedgeThreshold, DefaultKVS))
+	if ht != "" {
+		if dur, _ := time.ParseDuration(ht); dur > 0 {
+			cfg.HedgeThreshold = dur
+		}
+	}
 	return cfg, err
 }
 
@@ -23,6 +23,12 @@ var (
 	// MaxTimeout is the max timeout for drive
 	MaxTimeout = "max_timeout"
 
+	// SlowThreshold is the latency threshold for slow drive detection
+	SlowThreshold = "slow_threshold"
+
+	// HedgeThreshold is the latency threshold for hedged erasure reads
+	HedgeThreshold = "hedge_threshold"
+
 	// HelpDrive is help for drive
 	HelpDrive = config.HelpKVS{
 		config.HelpKV{
@@ -31,5 +37,17 @@ var (
 			Description: "set per call max_timeout for the drive, defaults to 30 seconds",
 			Optional:    true,
 		},
+		config.HelpKV{
+			Key:         SlowThreshold,
+			Type:        "string",
+			Description: "median last minute read latency beyond which a drive is deprioritized for reads, defaults to 0s (disabled)",
+			Optional:    true,
+		},
+		config.HelpKV{
+			Key:         HedgeThreshold,
+			Type:        "string",
+			Description: "time after which an incomplete erasure read is hedged with an extra shard read, defaults to 0s (disabled)",
+			Optional:    true,
+		},
 	}
 )
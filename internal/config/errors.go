@@ -219,6 +219,24 @@ Examples:
 		"Compress extensions/mime-types are delimited by `,`. For eg, MINIO_COMPRESS_MIME_TYPES=\"A,B,C\"",
 	)
 
+	ErrInvalidCacheDrivesValue = newErrFn(
+		"Invalid cache drive value",
+		"Please check the value in this ENV variable",
+		"MINIO_CACHE_DRIVES: Mounted drives or directories are delimited by `,`",
+	)
+
+	ErrInvalidCacheAfterValue = newErrFn(
+		"Invalid cache after value",
+		"Please check the passed value",
+		"MINIO_CACHE_AFTER: Valid cache after value must be 0 or greater",
+	)
+
+	ErrInvalidCacheWatermarkValue = newErrFn(
+		"Invalid cache watermark value",
+		"Please check the passed value",
+		"MINIO_CACHE_WATERMARK_LOW: Valid cache low watermark value must be between 1-100",
+	)
+
 	ErrInvalidReplicationWorkersValue = newErrFn(
 		"Invalid value for replication workers",
 		"",
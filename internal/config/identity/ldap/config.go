@@ -21,7 +21,10 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/minio/madmin-go/v3"
@@ -35,6 +38,13 @@ const (
 
 	minLDAPExpiry time.Duration = 15 * time.Minute
 	maxLDAPExpiry time.Duration = 365 * 24 * time.Hour
+
+	minGroupRefreshInterval = time.Minute
+	maxGroupRefreshInterval = 24 * time.Hour
+
+	// maxNestedGroupSearchDepth caps how many levels of parent groups are
+	// chased when nested group resolution is enabled.
+	maxNestedGroupSearchDepth = 10
 )
 
 // Config contains AD/LDAP server connectivity information.
@@ -42,6 +52,23 @@ type Config struct {
 	LDAP ldap.Config
 
 	stsExpiryDuration time.Duration // contains converted value
+
+	// All configured server addresses - the pkg level config carries only
+	// the primary one. Connections fail over across these in health-checked
+	// order.
+	serverAddrs []string
+
+	// How often group memberships of LDAP users with live credentials are
+	// re-evaluated. Zero leaves the refresh to the hourly IAM purge
+	// routines.
+	groupRefreshInterval time.Duration
+
+	// Number of levels of parent groups to resolve for each group the user
+	// is a direct member of. Zero disables nested group resolution.
+	nestedGroupSearchDepth int
+	nestedGroupCache       *nestedGroupCache
+
+	connPool *connPool
 }
 
 // Enabled returns if LDAP is enabled.
@@ -49,14 +76,25 @@ func (l *Config) Enabled() bool {
 	return l.LDAP.Enabled
 }
 
+// GetGroupRefreshInterval returns the configured group membership refresh
+// interval - zero means no dedicated refresh schedule is configured.
+func (l *Config) GetGroupRefreshInterval() time.Duration {
+	return l.groupRefreshInterval
+}
+
 // Clone returns a cloned copy of LDAP config.
 func (l *Config) Clone() Config {
 	if l == nil {
 		return Config{}
 	}
 	cfg := Config{
-		LDAP:              l.LDAP.Clone(),
-		stsExpiryDuration: l.stsExpiryDuration,
+		LDAP:                   l.LDAP.Clone(),
+		stsExpiryDuration:      l.stsExpiryDuration,
+		serverAddrs:            l.serverAddrs,
+		groupRefreshInterval:   l.groupRefreshInterval,
+		nestedGroupSearchDepth: l.nestedGroupSearchDepth,
+		nestedGroupCache:       l.nestedGroupCache,
+		connPool:               l.connPool,
 	}
 	return cfg
 }
@@ -72,6 +110,10 @@ const (
 	UserDNAttributes   = "user_dn_attributes"
 	GroupSearchFilter  = "group_search_filter"
 	GroupSearchBaseDN  = "group_search_base_dn"
+	NestedGroupDepth   = "nested_group_search_depth"
+	MaxIdleConns       = "max_idle_connections"
+	ConnMaxLifetime    = "connection_max_lifetime"
+	GroupRefresh       = "group_membership_refresh_interval"
 	TLSSkipVerify      = "tls_skip_verify"
 	ServerInsecure     = "server_insecure"
 	ServerStartTLS     = "server_starttls"
@@ -87,6 +129,10 @@ const (
 	EnvUserDNAttributes   = "MINIO_IDENTITY_LDAP_USER_DN_ATTRIBUTES"
 	EnvGroupSearchFilter  = "MINIO_IDENTITY_LDAP_GROUP_SEARCH_FILTER"
 	EnvGroupSearchBaseDN  = "MINIO_IDENTITY_LDAP_GROUP_SEARCH_BASE_DN"
+	EnvNestedGroupDepth   = "MINIO_IDENTITY_LDAP_NESTED_GROUP_SEARCH_DEPTH"
+	EnvMaxIdleConns       = "MINIO_IDENTITY_LDAP_MAX_IDLE_CONNECTIONS"
+	EnvConnMaxLifetime    = "MINIO_IDENTITY_LDAP_CONNECTION_MAX_LIFETIME"
+	EnvGroupRefresh       = "MINIO_IDENTITY_LDAP_GROUP_MEMBERSHIP_REFRESH_INTERVAL"
 	EnvLookupBindDN       = "MINIO_IDENTITY_LDAP_LOOKUP_BIND_DN"
 	EnvLookupBindPassword = "MINIO_IDENTITY_LDAP_LOOKUP_BIND_PASSWORD"
 )
@@ -134,6 +180,22 @@ var (
 			Key:   GroupSearchBaseDN,
 			Value: "",
 		},
+		config.KV{
+			Key:   NestedGroupDepth,
+			Value: "0",
+		},
+		config.KV{
+			Key:   MaxIdleConns,
+			Value: "0",
+		},
+		config.KV{
+			Key:   ConnMaxLifetime,
+			Value: "1m",
+		},
+		config.KV{
+			Key:   GroupRefresh,
+			Value: "0s",
+		},
 		config.KV{
 			Key:   TLSSkipVerify,
 			Value: config.EnableOff,
@@ -190,8 +252,18 @@ func Lookup(s config.Config, rootCAs *x509.CertPool) (l Config, err error) {
 	if ldapServer == "" {
 		return l, nil
 	}
+	// Multiple comma separated addresses may be given for failover - the
+	// first one is the primary and is the one validated below.
+	for _, addr := range strings.Split(ldapServer, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			l.serverAddrs = append(l.serverAddrs, addr)
+		}
+	}
+	if len(l.serverAddrs) == 0 {
+		return l, nil
+	}
 	l.LDAP = ldap.Config{
-		ServerAddr:    ldapServer,
+		ServerAddr:    l.serverAddrs[0],
 		SRVRecordName: getCfgVal(SRVRecordName),
 		TLS: &tls.Config{
 			MinVersion:         tls.VersionTLS12,
@@ -246,6 +318,44 @@ func Lookup(s config.Config, rootCAs *x509.CertPool) (l Config, err error) {
 	// Group search params configuration
 	l.LDAP.GroupSearchFilter = getCfgVal(GroupSearchFilter)
 	l.LDAP.GroupSearchBaseDistName = getCfgVal(GroupSearchBaseDN)
+	if v := getCfgVal(NestedGroupDepth); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil || d < 0 || d > maxNestedGroupSearchDepth {
+			return l, fmt.Errorf("invalid %s value: %s (must be an integer between 0 and %d)",
+				NestedGroupDepth, v, maxNestedGroupSearchDepth)
+		}
+		l.nestedGroupSearchDepth = d
+	}
+	l.nestedGroupCache = newNestedGroupCache()
+
+	// Connection pool configuration
+	maxIdleConns := 0
+	if v := getCfgVal(MaxIdleConns); v != "" {
+		maxIdleConns, err = strconv.Atoi(v)
+		if err != nil || maxIdleConns < 0 || maxIdleConns > maxIdleConnsLimit {
+			return l, fmt.Errorf("invalid %s value: %s (must be an integer between 0 and %d)",
+				MaxIdleConns, v, maxIdleConnsLimit)
+		}
+	}
+	connMaxLifetime := defaultConnMaxLifetime
+	if v := getCfgVal(ConnMaxLifetime); v != "" {
+		connMaxLifetime, err = time.ParseDuration(v)
+		if err != nil || connMaxLifetime <= 0 {
+			return l, fmt.Errorf("invalid %s value: %s (must be a positive duration)",
+				ConnMaxLifetime, v)
+		}
+	}
+	l.connPool = newConnPool(maxIdleConns, connMaxLifetime)
+
+	// Group membership refresh configuration
+	if v := getCfgVal(GroupRefresh); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 || (d > 0 && (d < minGroupRefreshInterval || d > maxGroupRefreshInterval)) {
+			return l, fmt.Errorf("invalid %s value: %s (must be 0 to disable, or between %s and %s)",
+				GroupRefresh, v, minGroupRefreshInterval, maxGroupRefreshInterval)
+		}
+		l.groupRefreshInterval = d
+	}
 
 	// If enable flag was not explicitly set, we treat it as implicitly set at
 	// this point as necessary configuration is available.
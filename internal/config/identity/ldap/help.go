@@ -28,7 +28,7 @@ var (
 	Help = config.HelpKVS{
 		config.HelpKV{
 			Key:         ServerAddr,
-			Description: `AD/LDAP server address e.g. "myldap.com" or "myldapserver.com:636"` + defaultHelpPostfix(ServerAddr),
+			Description: `AD/LDAP server address e.g. "myldap.com" or "myldapserver.com:636", may be a "," separated list for failover` + defaultHelpPostfix(ServerAddr),
 			Type:        "address",
 			Sensitive:   true,
 		},
@@ -84,6 +84,30 @@ var (
 			Optional:    true,
 			Type:        "list",
 		},
+		config.HelpKV{
+			Key:         NestedGroupDepth,
+			Description: `number of levels of parent groups to resolve for nested group membership, requires "%d" in the group search filter, "0" disables nested lookup` + defaultHelpPostfix(NestedGroupDepth),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         MaxIdleConns,
+			Description: `maximum number of idle LDAP connections kept for reuse, "0" disables connection pooling` + defaultHelpPostfix(MaxIdleConns),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         ConnMaxLifetime,
+			Description: `maximum lifetime of a pooled LDAP connection e.g. "1m"` + defaultHelpPostfix(ConnMaxLifetime),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         GroupRefresh,
+			Description: `how often group memberships of users with live credentials are re-evaluated e.g. "5m", "0" leaves the refresh to the hourly IAM purge routines` + defaultHelpPostfix(GroupRefresh),
+			Optional:    true,
+			Type:        "duration",
+		},
 		config.HelpKV{
 			Key:         TLSSkipVerify,
 			Description: `trust server TLS without verification` + defaultHelpPostfix(TLSSkipVerify),
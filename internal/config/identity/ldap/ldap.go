@@ -33,16 +33,11 @@ import (
 // LookupUserDN searches for the full DN and groups of a given short/login
 // username.
 func (l *Config) LookupUserDN(username string) (*xldap.DNSearchResult, []string, error) {
-	conn, err := l.LDAP.Connect()
+	conn, release, err := l.connect()
 	if err != nil {
 		return nil, nil, err
 	}
-	defer conn.Close()
-
-	// Bind to the lookup user account
-	if err = l.LDAP.LookupBind(conn); err != nil {
-		return nil, nil, err
-	}
+	defer release()
 
 	// Lookup user DN
 	lookupRes, err := l.LDAP.LookupUsername(conn, username)
@@ -55,6 +50,10 @@ func (l *Config) LookupUserDN(username string) (*xldap.DNSearchResult, []string,
 	if err != nil {
 		return nil, nil, err
 	}
+	groups, err = l.expandNestedGroups(conn, groups)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	return lookupRes, groups, nil
 }
@@ -69,16 +68,11 @@ func (l *Config) LookupUserDN(username string) (*xldap.DNSearchResult, []string,
 //
 // If the user is not found, err = nil, otherwise, err != nil.
 func (l *Config) GetValidatedDNForUsername(username string) (*xldap.DNSearchResult, error) {
-	conn, err := l.LDAP.Connect()
+	conn, release, err := l.connect()
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
-
-	// Bind to the lookup user account
-	if err = l.LDAP.LookupBind(conn); err != nil {
-		return nil, err
-	}
+	defer release()
 
 	// Check if the passed in username is a valid DN.
 	if !l.ParsesAsDN(username) {
@@ -116,17 +110,13 @@ func (l *Config) GetValidatedUserDN(conn *ldap.Conn, userDN string) (*xldap.DNSe
 // of the configured LDAP base DNs.
 func (l *Config) GetValidatedGroupDN(conn *ldap.Conn, groupDN string) (*xldap.DNSearchResult, bool, error) {
 	if conn == nil {
+		var release func()
 		var err error
-		conn, err = l.LDAP.Connect()
+		conn, release, err = l.connect()
 		if err != nil {
 			return nil, false, err
 		}
-		defer conn.Close()
-
-		// Bind to the lookup user account
-		if err = l.LDAP.LookupBind(conn); err != nil {
-			return nil, false, err
-		}
+		defer release()
 	}
 
 	return l.GetValidatedDNUnderBaseDN(conn, groupDN,
@@ -185,16 +175,11 @@ func (l *Config) GetValidatedDNUnderBaseDN(conn *ldap.Conn, dn string, baseDNLis
 // If username is required in group search but a DN is passed, no groups are
 // returned.
 func (l *Config) GetValidatedDNWithGroups(username string) (*xldap.DNSearchResult, []string, error) {
-	conn, err := l.LDAP.Connect()
+	conn, release, err := l.connect()
 	if err != nil {
 		return nil, nil, err
 	}
-	defer conn.Close()
-
-	// Bind to the lookup user account
-	if err = l.LDAP.LookupBind(conn); err != nil {
-		return nil, nil, err
-	}
+	defer release()
 
 	var lookupRes *xldap.DNSearchResult
 	shortUsername := ""
@@ -224,22 +209,21 @@ func (l *Config) GetValidatedDNWithGroups(username string) (*xldap.DNSearchResul
 	if err != nil {
 		return nil, nil, err
 	}
+	groups, err = l.expandNestedGroups(conn, groups)
+	if err != nil {
+		return nil, nil, err
+	}
 	return lookupRes, groups, nil
 }
 
 // Bind - binds to ldap, searches LDAP and returns the distinguished name of the
 // user and the list of groups.
 func (l *Config) Bind(username, password string) (*xldap.DNSearchResult, []string, error) {
-	conn, err := l.LDAP.Connect()
+	conn, release, err := l.connect()
 	if err != nil {
 		return nil, nil, err
 	}
-	defer conn.Close()
-
-	// Bind to the lookup user account
-	if err = l.LDAP.LookupBind(conn); err != nil {
-		return nil, nil, err
-	}
+	defer release()
 
 	// Lookup user DN
 	lookupResult, err := l.LDAP.LookupUsername(conn, username)
@@ -265,6 +249,10 @@ func (l *Config) Bind(username, password string) (*xldap.DNSearchResult, []strin
 	if err != nil {
 		return nil, nil, err
 	}
+	groups, err = l.expandNestedGroups(conn, groups)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	return lookupResult, groups, nil
 }
@@ -326,16 +314,11 @@ func (l Config) IsLDAPGroupDN(group string) bool {
 // GetNonEligibleUserDistNames - find user accounts (DNs) that are no longer
 // present in the LDAP server or do not meet filter criteria anymore
 func (l *Config) GetNonEligibleUserDistNames(userDistNames []string) ([]string, error) {
-	conn, err := l.LDAP.Connect()
+	conn, release, err := l.connect()
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
-
-	// Bind to the lookup user account
-	if err = l.LDAP.LookupBind(conn); err != nil {
-		return nil, err
-	}
+	defer release()
 
 	// Evaluate the filter again with generic wildcard instead of specific values
 	filter := strings.ReplaceAll(l.LDAP.UserDNSearchFilter, "%s", "*")
@@ -379,16 +362,11 @@ func (l *Config) GetNonEligibleUserDistNames(userDistNames []string) ([]string,
 // LookupGroupMemberships - for each DN finds the set of LDAP groups they are a
 // member of.
 func (l *Config) LookupGroupMemberships(userDistNames []string, userDNToUsernameMap map[string]string) (map[string]set.StringSet, error) {
-	conn, err := l.LDAP.Connect()
+	conn, release, err := l.connect()
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
-
-	// Bind to the lookup user account
-	if err = l.LDAP.LookupBind(conn); err != nil {
-		return nil, err
-	}
+	defer release()
 
 	res := make(map[string]set.StringSet, len(userDistNames))
 	for _, userDistName := range userDistNames {
@@ -397,6 +375,10 @@ func (l *Config) LookupGroupMemberships(userDistNames []string, userDNToUsername
 		if err != nil {
 			return nil, err
 		}
+		groups, err = l.expandNestedGroups(conn, groups)
+		if err != nil {
+			return nil, err
+		}
 		res[userDistName] = set.CreateStringSet(groups...)
 	}
 
@@ -0,0 +1,148 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ldap
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	ldap "github.com/go-ldap/ldap/v3"
+	"github.com/minio/minio-go/v7/pkg/set"
+)
+
+// nestedGroupCacheTTL is how long the parent groups of a group are cached.
+// Group membership changes in the directory are picked up after at most this
+// interval without having to query the server on every login.
+const nestedGroupCacheTTL = time.Minute
+
+type nestedGroupCacheEntry struct {
+	parents   []string
+	expiresAt time.Time
+}
+
+// nestedGroupCache caches the direct parent groups of each group DN. It is
+// shared across clones of the LDAP configuration.
+type nestedGroupCache struct {
+	mu      sync.Mutex
+	entries map[string]nestedGroupCacheEntry
+}
+
+func newNestedGroupCache() *nestedGroupCache {
+	return &nestedGroupCache{
+		entries: make(map[string]nestedGroupCacheEntry),
+	}
+}
+
+func (c *nestedGroupCache) get(groupDN string) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[groupDN]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.parents, true
+}
+
+func (c *nestedGroupCache) put(groupDN string, parents []string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[groupDN] = nestedGroupCacheEntry{
+		parents:   parents,
+		expiresAt: time.Now().Add(nestedGroupCacheTTL),
+	}
+}
+
+// expandNestedGroups resolves parent groups of the given (direct) groups up to
+// the configured nesting depth, so that policies attached to a parent group
+// apply to members of its subgroups. Groups already seen are not searched
+// again, which also protects against membership cycles in the directory. The
+// returned slice starts with the direct groups, followed by parent groups in
+// breadth-first order.
+func (l *Config) expandNestedGroups(conn *ldap.Conn, groups []string) ([]string, error) {
+	if l.nestedGroupSearchDepth <= 0 || len(groups) == 0 {
+		return groups, nil
+	}
+
+	seen := set.CreateStringSet(groups...)
+	frontier := groups
+	for depth := 0; depth < l.nestedGroupSearchDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, groupDN := range frontier {
+			parents, err := l.parentGroups(conn, groupDN)
+			if err != nil {
+				return nil, err
+			}
+			for _, parent := range parents {
+				if seen.Contains(parent) {
+					continue
+				}
+				seen.Add(parent)
+				next = append(next, parent)
+			}
+		}
+		groups = append(groups, next...)
+		frontier = next
+	}
+	return groups, nil
+}
+
+// parentGroups finds the groups the given group DN is a direct member of, by
+// evaluating the group search filter with the group DN substituted for the %d
+// placeholder. Nested resolution requires a filter using %d - if the filter
+// only matches on the short username (%s) there is no way to search by member
+// DN and no parents are returned.
+func (l *Config) parentGroups(conn *ldap.Conn, groupDN string) ([]string, error) {
+	if !strings.Contains(l.LDAP.GroupSearchFilter, "%d") {
+		return nil, nil
+	}
+	if parents, ok := l.nestedGroupCache.get(groupDN); ok {
+		return parents, nil
+	}
+
+	filter := strings.ReplaceAll(l.LDAP.GroupSearchFilter, "%s", "")
+	filter = strings.ReplaceAll(filter, "%d", ldap.EscapeFilter(groupDN))
+
+	var parents []string
+	for _, baseDN := range l.LDAP.GetGroupSearchBaseDistNames() {
+		searchRequest := ldap.NewSearchRequest(
+			baseDN.ServerDN,
+			ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+			filter,
+			[]string{}, // only need DN, so pass no attributes here
+			nil,
+		)
+
+		searchResult, err := conn.Search(searchRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range searchResult.Entries {
+			parents = append(parents, entry.DN)
+		}
+	}
+
+	l.nestedGroupCache.put(groupDN, parents)
+	return parents, nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ldap
+
+import (
+	"sync"
+	"time"
+
+	ldap "github.com/go-ldap/ldap/v3"
+)
+
+const (
+	// defaultConnMaxLifetime is how long a pooled connection may be reused
+	// before it is closed and a fresh one is dialed.
+	defaultConnMaxLifetime = time.Minute
+
+	// serverRetryInterval is how long an address is skipped after a failed
+	// connection attempt. If every configured address is failing, all of
+	// them are retried regardless.
+	serverRetryInterval = 30 * time.Second
+
+	// maxIdleConnsLimit caps the configurable idle connection count.
+	maxIdleConnsLimit = 64
+)
+
+type pooledConn struct {
+	conn      *ldap.Conn
+	createdAt time.Time
+}
+
+// connPool keeps a bounded set of idle LDAP connections for reuse and tracks
+// per-address health for failover. It is shared across clones of the LDAP
+// configuration.
+type connPool struct {
+	mu          sync.Mutex
+	idle        []pooledConn
+	maxIdle     int
+	maxLifetime time.Duration
+	downSince   map[string]time.Time
+}
+
+func newConnPool(maxIdle int, maxLifetime time.Duration) *connPool {
+	if maxLifetime <= 0 {
+		maxLifetime = defaultConnMaxLifetime
+	}
+	return &connPool{
+		maxIdle:     maxIdle,
+		maxLifetime: maxLifetime,
+		downSince:   make(map[string]time.Time),
+	}
+}
+
+// get returns an idle connection from the pool if one is available and has not
+// exceeded its lifetime.
+func (p *connPool) get() (*ldap.Conn, time.Time, bool) {
+	if p == nil {
+		return nil, time.Time{}, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for len(p.idle) > 0 {
+		pc := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if pc.conn.IsClosing() || time.Since(pc.createdAt) > p.maxLifetime {
+			pc.conn.Close()
+			continue
+		}
+		return pc.conn, pc.createdAt, true
+	}
+	return nil, time.Time{}, false
+}
+
+// put returns a connection to the pool, closing it instead if the pool is full
+// or the connection has exceeded its lifetime.
+func (p *connPool) put(conn *ldap.Conn, createdAt time.Time) {
+	if p == nil || p.maxIdle <= 0 || conn.IsClosing() || time.Since(createdAt) > p.maxLifetime {
+		conn.Close()
+		return
+	}
+	p.mu.Lock()
+	if len(p.idle) >= p.maxIdle {
+		p.mu.Unlock()
+		conn.Close()
+		return
+	}
+	p.idle = append(p.idle, pooledConn{conn: conn, createdAt: createdAt})
+	p.mu.Unlock()
+}
+
+func (p *connPool) markDown(addr string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.downSince[addr] = time.Now()
+}
+
+func (p *connPool) markUp(addr string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.downSince, addr)
+}
+
+// orderAddrs returns the given addresses with healthy ones first, preserving
+// configuration order within each class. An address is considered unhealthy
+// for serverRetryInterval after a failed connection attempt.
+func (p *connPool) orderAddrs(addrs []string) []string {
+	if p == nil {
+		return addrs
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	healthy := make([]string, 0, len(addrs))
+	var down []string
+	for _, addr := range addrs {
+		if t, ok := p.downSince[addr]; ok && time.Since(t) < serverRetryInterval {
+			down = append(down, addr)
+			continue
+		}
+		healthy = append(healthy, addr)
+	}
+	return append(healthy, down...)
+}
+
+// connect returns an LDAP connection bound to the lookup user account along
+// with a release function the caller must invoke when done. The connection is
+// taken from the pool when possible; otherwise a new one is dialed, failing
+// over across the configured server addresses.
+func (l *Config) connect() (conn *ldap.Conn, release func(), err error) {
+	if conn, createdAt, ok := l.connPool.get(); ok {
+		// A pooled connection may have been left bound to a different
+		// account (e.g. after Bind), so always rebind to the lookup user.
+		if err = l.LDAP.LookupBind(conn); err == nil {
+			return conn, func() { l.connPool.put(conn, createdAt) }, nil
+		}
+		conn.Close()
+	}
+
+	conn, err = l.dial()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = l.LDAP.LookupBind(conn); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	createdAt := time.Now()
+	return conn, func() { l.connPool.put(conn, createdAt) }, nil
+}
+
+// dial creates a new LDAP connection, trying the configured server addresses
+// in health-checked order until one succeeds.
+func (l *Config) dial() (*ldap.Conn, error) {
+	if len(l.serverAddrs) <= 1 {
+		return l.LDAP.Connect()
+	}
+	var firstErr error
+	for _, addr := range l.connPool.orderAddrs(l.serverAddrs) {
+		cfg := l.LDAP.Clone()
+		cfg.ServerAddr = addr
+		conn, err := cfg.Connect()
+		if err != nil {
+			l.connPool.markDown(addr)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		l.connPool.markUp(addr)
+		return conn, nil
+	}
+	return nil, firstErr
+}
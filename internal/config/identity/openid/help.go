@@ -61,6 +61,18 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         ClaimPolicyMap,
+			Description: `";" separated list of <claim-value>=<policy>[,<policy>...] entries mapping policy claim values (e.g. a roles array) to IAM policies, earlier entries take precedence e.g. "admin=consoleAdmin;developer=readwrite,diagnostics"` + defaultHelpPostfix(ClaimPolicyMap),
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         ExtraAudiences,
+			Description: `Comma separated list of additional audience values accepted in the token "aud" claim besides the client ID` + defaultHelpPostfix(ExtraAudiences),
+			Optional:    true,
+			Type:        "csv",
+		},
 		config.HelpKV{
 			Key:         Scopes,
 			Description: `Comma separated list of OpenID scopes for server, defaults to advertised scopes from discovery document e.g. "email,admin"` + defaultHelpPostfix(Scopes),
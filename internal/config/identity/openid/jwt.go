@@ -199,7 +199,7 @@ func (r *Config) Validate(ctx context.Context, arn arn.ARN, token, accessToken,
 	if !ok {
 		return errors.New("STS JWT Token has `aud` claim invalid, `aud` must match configured OpenID Client ID")
 	}
-	if !audValues.Contains(pCfg.ClientID) {
+	if !pCfg.matchesAudience(audValues) {
 		// if audience claims is missing, look for "azp" claims.
 		// OPTIONAL. Authorized party - the party to which the ID
 		// Token was issued. If present, it MUST contain the OAuth
@@ -51,6 +51,9 @@ const (
 	RolePolicy    = "role_policy"
 	DisplayName   = "display_name"
 
+	ClaimPolicyMap = "claim_policy_map"
+	ExtraAudiences = "extra_audiences"
+
 	Scopes             = "scopes"
 	RedirectURI        = "redirect_uri"
 	RedirectURIDynamic = "redirect_uri_dynamic"
@@ -100,6 +103,14 @@ var (
 			Key:   RolePolicy,
 			Value: "",
 		},
+		config.KV{
+			Key:   ClaimPolicyMap,
+			Value: "",
+		},
+		config.KV{
+			Key:   ExtraAudiences,
+			Value: "",
+		},
 		config.KV{
 			Key:           ClaimPrefix,
 			Value:         "",
@@ -309,6 +320,24 @@ func LookupConfig(s config.Config, transport http.RoundTripper, closeRespFn func
 			p.DiscoveryDoc.ScopesSupported = scopes
 		}
 
+		if v := getCfgVal(ExtraAudiences); v != "" {
+			for _, aud := range strings.Split(v, ",") {
+				if aud = strings.TrimSpace(aud); aud != "" {
+					p.extraAudiences = append(p.extraAudiences, aud)
+				}
+			}
+		}
+
+		if v := getCfgVal(ClaimPolicyMap); v != "" {
+			if p.RolePolicy != "" {
+				return c, config.Errorf("Role Policy (=`%s`) and Claim Policy Map cannot both be set", p.RolePolicy)
+			}
+			p.claimPolicyMap, err = parseClaimPolicyMap(v)
+			if err != nil {
+				return c, config.Errorf("invalid %s value: %v", ClaimPolicyMap, err)
+			}
+		}
+
 		// Check if claim name is the non-default value and role policy is set.
 		if p.ClaimName != policy.PolicyName && p.RolePolicy != "" {
 			// In the unlikely event that the user specifies
@@ -549,6 +578,24 @@ func (r *Config) GetIAMPolicyClaimName() string {
 	return pCfg.ClaimPrefix + pCfg.ClaimName
 }
 
+// MapClaimPolicies translates values of the policy claim through the claim
+// policy map of the claim-based provider, if one is configured. The first map
+// entry whose claim value is present in the token takes precedence and its
+// policies are returned. Without a configured map the values are returned
+// unchanged, i.e. they are treated as policy names directly.
+func (r *Config) MapClaimPolicies(values set.StringSet) set.StringSet {
+	pCfg, ok := r.arnProviderCfgsMap[DummyRoleARN]
+	if !ok || len(pCfg.claimPolicyMap) == 0 {
+		return values
+	}
+	for _, e := range pCfg.claimPolicyMap {
+		if values.Contains(e.claimValue) {
+			return set.CreateStringSet(e.policies...)
+		}
+	}
+	return set.NewStringSet()
+}
+
 // LookupUser lookup userid for the provider
 func (r Config) LookupUser(roleArn, userid string) (provider.User, error) {
 	// Can safely ignore error here as empty or invalid ARNs will not be
@@ -23,7 +23,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio/internal/arn"
 	"github.com/minio/minio/internal/config"
 	"github.com/minio/minio/internal/config/identity/openid/provider"
@@ -49,10 +51,78 @@ type providerCfg struct {
 	ClientSecret       string
 	RolePolicy         string
 
+	// Ordered mapping of policy claim values to policy names. When
+	// non-empty, values of the policy claim are looked up here instead of
+	// being treated as policy names directly. Earlier entries take
+	// precedence.
+	claimPolicyMap []claimPolicyMapEntry
+
+	// Additional audience values accepted in the token `aud` claim besides
+	// the client ID.
+	extraAudiences []string
+
 	roleArn  arn.ARN
 	provider provider.Provider
 }
 
+type claimPolicyMapEntry struct {
+	claimValue string
+	policies   []string
+}
+
+// parseClaimPolicyMap parses a claim policy map specified as ";" separated
+// `<claim-value>=<policy>[,<policy>...]` entries.
+func parseClaimPolicyMap(s string) ([]claimPolicyMapEntry, error) {
+	var entries []claimPolicyMapEntry
+	seen := set.NewStringSet()
+	for _, item := range strings.Split(s, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		claimValue, policiesStr, found := strings.Cut(item, "=")
+		claimValue = strings.TrimSpace(claimValue)
+		if !found || claimValue == "" {
+			return nil, fmt.Errorf("entry `%s` must be of the form <claim-value>=<policy>[,<policy>...]", item)
+		}
+		if seen.Contains(claimValue) {
+			return nil, fmt.Errorf("claim value `%s` is mapped more than once", claimValue)
+		}
+		seen.Add(claimValue)
+		var policies []string
+		for _, p := range strings.Split(policiesStr, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				policies = append(policies, p)
+			}
+		}
+		if len(policies) == 0 {
+			return nil, fmt.Errorf("entry `%s` does not map to any policy", item)
+		}
+		entries = append(entries, claimPolicyMapEntry{
+			claimValue: claimValue,
+			policies:   policies,
+		})
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no valid entries found")
+	}
+	return entries, nil
+}
+
+// matchesAudience returns true if the given token audience values contain the
+// client ID or any of the configured extra audiences.
+func (p *providerCfg) matchesAudience(audValues set.StringSet) bool {
+	if audValues.Contains(p.ClientID) {
+		return true
+	}
+	for _, aud := range p.extraAudiences {
+		if audValues.Contains(aud) {
+			return true
+		}
+	}
+	return false
+}
+
 func newProviderCfgFromConfig(getCfgVal func(cfgName string) string) providerCfg {
 	return providerCfg{
 		DisplayName:        getCfgVal(DisplayName),
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package openid
+
+import (
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+)
+
+func TestParseClaimPolicyMap(t *testing.T) {
+	testCases := []struct {
+		value   string
+		entries int
+		wantErr bool
+	}{
+		{"admin=consoleAdmin", 1, false},
+		{"admin=consoleAdmin;developer=readwrite,diagnostics", 2, false},
+		{" admin = consoleAdmin ; ", 1, false},
+		{"", 0, true},
+		{"admin", 0, true},
+		{"=consoleAdmin", 0, true},
+		{"admin=", 0, true},
+		{"admin=consoleAdmin;admin=readonly", 0, true},
+	}
+	for _, testCase := range testCases {
+		entries, err := parseClaimPolicyMap(testCase.value)
+		if testCase.wantErr {
+			if err == nil {
+				t.Errorf("parseClaimPolicyMap(%q): expected error, got none", testCase.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseClaimPolicyMap(%q): unexpected error: %v", testCase.value, err)
+			continue
+		}
+		if len(entries) != testCase.entries {
+			t.Errorf("parseClaimPolicyMap(%q): expected %d entries, got %d", testCase.value, testCase.entries, len(entries))
+		}
+	}
+}
+
+func TestMatchesAudience(t *testing.T) {
+	p := providerCfg{
+		ClientID:       "client-1",
+		extraAudiences: []string{"https://api.example.com"},
+	}
+	if !p.matchesAudience(set.CreateStringSet("client-1", "other")) {
+		t.Error("expected client ID in aud list to match")
+	}
+	if !p.matchesAudience(set.CreateStringSet("https://api.example.com")) {
+		t.Error("expected extra audience to match")
+	}
+	if p.matchesAudience(set.CreateStringSet("other")) {
+		t.Error("expected no match for unknown audience")
+	}
+}
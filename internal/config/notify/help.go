@@ -333,6 +333,18 @@ var (
 			Optional:    true,
 			Type:        "duration",
 		},
+		config.HelpKV{
+			Key:         target.MqttVersion,
+			Description: "MQTT protocol version '4' (default) or '5'; '5' enables message expiry and lets subscribers use shared subscriptions",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.MqttMessageExpiry,
+			Description: "expiry set on published messages in s,m,h,d; requires version '5'",
+			Optional:    true,
+			Type:        "duration",
+		},
 		config.HelpKV{
 			Key:         target.MqttQueueDir,
 			Description: queueDirComment,
@@ -520,6 +532,12 @@ var (
 			Optional:    true,
 			Type:        "on|off",
 		},
+		config.HelpKV{
+			Key:         target.NATSJetStreamStream,
+			Description: "JetStream stream to publish into, created with the configured subject if missing",
+			Optional:    true,
+			Type:        "string",
+		},
 		config.HelpKV{
 			Key:         target.NATSQueueDir,
 			Description: queueDirComment,
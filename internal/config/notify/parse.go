@@ -597,6 +597,14 @@ var (
 			Key:   target.MqttQueueLimit,
 			Value: "0",
 		},
+		config.KV{
+			Key:   target.MqttVersion,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.MqttMessageExpiry,
+			Value: "0s",
+		},
 	}
 )
 
@@ -687,6 +695,21 @@ func GetNotifyMQTT(mqttKVS map[string]config.KVS, rootCAs *x509.CertPool) (map[s
 			queueDirEnv = queueDirEnv + config.Default + k
 		}
 
+		versionEnv := target.EnvMQTTVersion
+		if k != config.Default {
+			versionEnv = versionEnv + config.Default + k
+		}
+
+		messageExpiryEnv := target.EnvMQTTMessageExpiry
+		if k != config.Default {
+			messageExpiryEnv = messageExpiryEnv + config.Default + k
+		}
+		messageExpiry, err := time.ParseDuration(env.Get(messageExpiryEnv,
+			kv.GetWithDefault(target.MqttMessageExpiry, DefaultMQTTKVS)))
+		if err != nil {
+			return nil, err
+		}
+
 		mqttArgs := target.MQTTArgs{
 			Enable:               enabled,
 			Broker:               *brokerURL,
@@ -699,6 +722,8 @@ func GetNotifyMQTT(mqttKVS map[string]config.KVS, rootCAs *x509.CertPool) (map[s
 			RootCAs:              rootCAs,
 			QueueDir:             env.Get(queueDirEnv, kv.Get(target.MqttQueueDir)),
 			QueueLimit:           queueLimit,
+			Version:              env.Get(versionEnv, kv.Get(target.MqttVersion)),
+			MessageExpiry:        messageExpiry,
 		}
 
 		if err = mqttArgs.Validate(); err != nil {
@@ -871,6 +896,10 @@ var (
 			Key:   target.NATSJetStream,
 			Value: config.EnableOff,
 		},
+		config.KV{
+			Key:   target.NATSJetStreamStream,
+			Value: "",
+		},
 		config.KV{
 			Key:           target.NATSStreaming,
 			Value:         config.EnableOff,
@@ -1029,6 +1058,12 @@ func GetNotifyNATS(natsKVS map[string]config.KVS, rootCAs *x509.CertPool) (map[s
 		}
 		natsArgs.JetStream.Enable = env.Get(jetStreamEnableEnv, kv.Get(target.NATSJetStream)) == config.EnableOn
 
+		jetStreamStreamEnv := target.EnvNATSJetStreamStream
+		if k != config.Default {
+			jetStreamStreamEnv = jetStreamStreamEnv + config.Default + k
+		}
+		natsArgs.JetStream.Stream = env.Get(jetStreamStreamEnv, kv.Get(target.NATSJetStreamStream))
+
 		streamingEnableEnv := target.EnvNATSStreaming
 		if k != config.Default {
 			streamingEnableEnv = streamingEnableEnv + config.Default + k
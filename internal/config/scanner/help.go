@@ -28,10 +28,16 @@ var (
 	Help = config.HelpKVS{
 		config.HelpKV{
 			Key:         Speed,
-			Description: `customize scanner speed (default|slowest|slow|fast|fastest)` + defaultHelpPostfix(Speed),
+			Description: `customize scanner speed (default|slowest|slow|fast|fastest|adaptive)` + defaultHelpPostfix(Speed),
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         TargetLatency,
+			Description: `p99 S3 request latency the "adaptive" speed stays under e.g. "500ms"` + defaultHelpPostfix(TargetLatency),
+			Optional:    true,
+			Type:        "duration",
+		},
 		config.HelpKV{
 			Key:         ExcessVersions,
 			Description: `alert per object beyond this many versions` + defaultHelpPostfix(ExcessVersions),
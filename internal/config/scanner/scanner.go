@@ -34,6 +34,9 @@ const (
 	IdleSpeed    = "idle_speed"
 	EnvIdleSpeed = "MINIO_SCANNER_IDLE_SPEED"
 
+	TargetLatency    = "target_latency"
+	EnvTargetLatency = "MINIO_SCANNER_TARGET_LATENCY"
+
 	ExcessVersions    = "alert_excess_versions"
 	EnvExcessVersions = "MINIO_SCANNER_ALERT_EXCESS_VERSIONS"
 
@@ -70,6 +73,13 @@ type Config struct {
 	MaxWait time.Duration
 	// Cycle is the time.Duration between each scanner cycles
 	Cycle time.Duration
+
+	// Adaptive speed adjusts scanner aggressiveness continuously based on
+	// observed S3 request latency instead of a fixed delay/max-wait pair.
+	Adaptive bool
+	// TargetLatency is the p99 S3 time-to-first-byte the adaptive speed
+	// tries to stay under.
+	TargetLatency time.Duration
 }
 
 // DefaultKVS - default KV config for heal settings
@@ -83,6 +93,11 @@ var DefaultKVS = config.KVS{
 		Value:         "",
 		HiddenIfEmpty: true,
 	},
+	config.KV{
+		Key:           TargetLatency,
+		Value:         "",
+		HiddenIfEmpty: true,
+	},
 	config.KV{
 		Key:   ExcessVersions,
 		Value: "100",
@@ -155,7 +170,21 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		}
 	}
 
+	if target := env.Get(EnvTargetLatency, kvs.GetWithDefault(TargetLatency, DefaultKVS)); target != "" {
+		cfg.TargetLatency, err = time.ParseDuration(target)
+		if err != nil {
+			return cfg, err
+		}
+	} else {
+		cfg.TargetLatency = 500 * time.Millisecond
+	}
+
 	switch speed := env.Get(EnvSpeed, kvs.GetWithDefault(Speed, DefaultKVS)); speed {
+	case "adaptive":
+		// Starting point only, the scanner continuously adjusts between
+		// the "fastest" and "slowest" presets based on request latency.
+		cfg.Adaptive = true
+		cfg.Delay, cfg.MaxWait, cfg.Cycle = 2, time.Second, time.Minute
 	case "fastest":
 		cfg.Delay, cfg.MaxWait, cfg.Cycle = 0, 0, time.Second
 	case "fast":
@@ -38,6 +38,18 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         ClassAuto,
+			Description: `set the reduced parity count used for AUTO storage class objects at or above the auto threshold` + defaultHelpPostfix(ClassAuto),
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         AutoThreshold,
+			Description: `set the object size at which AUTO storage class objects switch to the reduced parity e.g. "1GiB"` + defaultHelpPostfix(AutoThreshold),
+			Optional:    true,
+			Type:        "string",
+		},
 		config.HelpKV{
 			Key:         Optimize,
 			Description: `optimize parity calculation for standard storage class, set 'capacity' for capacity optimized (no additional parity)` + defaultHelpPostfix(Optimize),
@@ -37,12 +37,17 @@ const (
 	RRS = "REDUCED_REDUNDANCY"
 	// Standard storage class
 	STANDARD = "STANDARD"
+	// Auto storage class selects the parity from the object size,
+	// large objects are written with the reduced "auto" parity.
+	AUTO = "AUTO"
 )
 
 // Standard constants for config info storage class
 const (
 	ClassStandard = "standard"
 	ClassRRS      = "rrs"
+	ClassAuto     = "auto"
+	AutoThreshold = "auto_threshold"
 	Optimize      = "optimize"
 	InlineBlock   = "inline_block"
 
@@ -50,6 +55,12 @@ const (
 	RRSEnv = "MINIO_STORAGE_CLASS_RRS"
 	// Standard storage class environment variable
 	StandardEnv = "MINIO_STORAGE_CLASS_STANDARD"
+	// Auto storage class environment variable, the parity written for
+	// AUTO objects at or above the auto threshold.
+	AutoEnv = "MINIO_STORAGE_CLASS_AUTO"
+	// Auto threshold environment variable, the object size at which
+	// AUTO objects switch to the reduced auto parity.
+	AutoThresholdEnv = "MINIO_STORAGE_CLASS_AUTO_THRESHOLD"
 	// Optimize storage class environment variable
 	OptimizeEnv = "MINIO_STORAGE_CLASS_OPTIMIZE"
 	// Inline block indicates the size of the shard
@@ -69,6 +80,10 @@ const (
 
 	// Default RRS parity is always minimum parity.
 	defaultRRSParity = 1
+
+	// Default size at which AUTO objects switch to the reduced
+	// auto parity.
+	defaultAutoThreshold = int64(humanize.GiByte)
 )
 
 // DefaultKVS - default storage class config
@@ -82,6 +97,16 @@ var (
 			Key:   ClassRRS,
 			Value: "EC:1",
 		},
+		config.KV{
+			Key:           ClassAuto,
+			Value:         "",
+			HiddenIfEmpty: true,
+		},
+		config.KV{
+			Key:           AutoThreshold,
+			Value:         "",
+			HiddenIfEmpty: true,
+		},
 		config.KV{
 			Key:   Optimize,
 			Value: "availability",
@@ -104,10 +129,12 @@ var ConfigLock sync.RWMutex
 
 // Config storage class configuration
 type Config struct {
-	Standard    StorageClass `json:"standard"`
-	RRS         StorageClass `json:"rrs"`
-	Optimize    string       `json:"optimize"`
-	inlineBlock int64
+	Standard      StorageClass `json:"standard"`
+	RRS           StorageClass `json:"rrs"`
+	Auto          StorageClass `json:"auto"`
+	Optimize      string       `json:"optimize"`
+	inlineBlock   int64
+	autoThreshold int64
 
 	initialized bool
 }
@@ -126,7 +153,7 @@ func (sCfg *Config) UnmarshalJSON(data []byte) error {
 // IsValid - returns true if input string is a valid
 // storage class kind supported.
 func IsValid(sc string) bool {
-	return sc == RRS || sc == STANDARD
+	return sc == RRS || sc == STANDARD || sc == AUTO
 }
 
 // UnmarshalText unmarshals storage class from its textual form into
@@ -207,7 +234,7 @@ func ValidateParity(ssParity, setDriveCount int) error {
 }
 
 // Validates the parity drives.
-func validateParity(ssParity, rrsParity, setDriveCount int) (err error) {
+func validateParity(ssParity, rrsParity, autoParity, setDriveCount int) (err error) {
 	// SS parity drives should be greater than or equal to minParityDrives.
 	// Parity below minParityDrives is not supported.
 	if ssParity > 0 && ssParity < minParityDrives {
@@ -229,6 +256,10 @@ func validateParity(ssParity, rrsParity, setDriveCount int) (err error) {
 		if rrsParity > setDriveCount/2 {
 			return fmt.Errorf("Reduced redundancy storage class parity %d should be less than or equal to %d", rrsParity, setDriveCount/2)
 		}
+
+		if autoParity > setDriveCount/2 {
+			return fmt.Errorf("Auto storage class parity %d should be less than or equal to %d", autoParity, setDriveCount/2)
+		}
 	}
 
 	if ssParity > 0 && rrsParity > 0 {
@@ -236,6 +267,14 @@ func validateParity(ssParity, rrsParity, setDriveCount int) (err error) {
 			return fmt.Errorf("Standard storage class parity drives %d should be greater than or equal to Reduced redundancy storage class parity drives %d", ssParity, rrsParity)
 		}
 	}
+
+	// Auto parity is a reduced parity for large objects, it should never
+	// exceed the standard parity.
+	if ssParity > 0 && autoParity > 0 {
+		if ssParity < autoParity {
+			return fmt.Errorf("Standard storage class parity drives %d should be greater than or equal to Auto storage class parity drives %d", ssParity, autoParity)
+		}
+	}
 	return nil
 }
 
@@ -265,6 +304,8 @@ func (sCfg *Config) GetParityForSC(sc string) (parity int) {
 		}
 		return sCfg.RRS.Parity
 	default:
+		// AUTO without a known object size behaves as standard,
+		// size aware callers use GetParityForAuto() instead.
 		if !sCfg.initialized {
 			return -1
 		}
@@ -272,6 +313,24 @@ func (sCfg *Config) GetParityForSC(sc string) (parity int) {
 	}
 }
 
+// GetParityForAuto returns the parity drive count for an object of the
+// given size written with the AUTO storage class. Objects at or above the
+// configured auto threshold are written with the reduced auto parity,
+// smaller objects with the standard parity. A negative size means the
+// object size is not known up front - multipart and streaming uploads -
+// these are used for large objects and get the reduced parity as well.
+func (sCfg *Config) GetParityForAuto(size int64) (parity int) {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	if !sCfg.initialized {
+		return -1
+	}
+	if sCfg.Auto.Parity > 0 && (size < 0 || size >= sCfg.autoThreshold) {
+		return sCfg.Auto.Parity
+	}
+	return sCfg.Standard.Parity
+}
+
 // ShouldInline returns true if the shardSize is worthy of inline
 // if versioned is true then we chosen 1/8th inline block size
 // to satisfy the same constraints.
@@ -339,8 +398,10 @@ func (sCfg *Config) Update(newCfg Config) {
 	defer ConfigLock.Unlock()
 	sCfg.RRS = newCfg.RRS
 	sCfg.Standard = newCfg.Standard
+	sCfg.Auto = newCfg.Auto
 	sCfg.Optimize = newCfg.Optimize
 	sCfg.inlineBlock = newCfg.inlineBlock
+	sCfg.autoThreshold = newCfg.autoThreshold
 	sCfg.initialized = true
 }
 
@@ -403,14 +464,32 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 		}
 	}
 
+	if asc := env.Get(AutoEnv, kvs.Get(ClassAuto)); asc != "" {
+		cfg.Auto, err = parseStorageClass(asc)
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
 	// Validation is done after parsing both the storage classes. This is needed because we need one
 	// storage class value to deduce the correct value of the other storage class.
-	if err = validateParity(cfg.Standard.Parity, cfg.RRS.Parity, setDriveCount); err != nil {
+	if err = validateParity(cfg.Standard.Parity, cfg.RRS.Parity, cfg.Auto.Parity, setDriveCount); err != nil {
 		return Config{}, err
 	}
 
 	cfg.Optimize = env.Get(OptimizeEnv, kvs.Get(Optimize))
 
+	autoThresholdStr := env.Get(AutoThresholdEnv, kvs.Get(AutoThreshold))
+	if autoThresholdStr != "" {
+		autoThreshold, err := humanize.ParseBytes(autoThresholdStr)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.autoThreshold = int64(autoThreshold)
+	} else {
+		cfg.autoThreshold = defaultAutoThreshold
+	}
+
 	inlineBlockStr := env.Get(InlineBlockEnv, kvs.Get(InlineBlock))
 	if inlineBlockStr != "" {
 		inlineBlock, err := humanize.ParseBytes(inlineBlockStr)
@@ -96,22 +96,27 @@ func TestValidateParity(t *testing.T) {
 	tests := []struct {
 		rrsParity     int
 		ssParity      int
+		autoParity    int
 		success       bool
 		setDriveCount int
 	}{
-		{2, 4, true, 16},
-		{3, 3, true, 16},
-		{0, 0, true, 16},
-		{1, 4, true, 16},
-		{0, 4, true, 16},
-		{7, 6, false, 16},
-		{9, 0, false, 16},
-		{9, 9, false, 16},
-		{2, 9, false, 16},
-		{9, 2, false, 16},
+		{2, 4, 0, true, 16},
+		{3, 3, 0, true, 16},
+		{0, 0, 0, true, 16},
+		{1, 4, 0, true, 16},
+		{0, 4, 0, true, 16},
+		{7, 6, 0, false, 16},
+		{9, 0, 0, false, 16},
+		{9, 9, 0, false, 16},
+		{2, 9, 0, false, 16},
+		{9, 2, 0, false, 16},
+		{2, 4, 2, true, 16},
+		{2, 4, 4, true, 16},
+		{2, 4, 6, false, 16},
+		{0, 4, 9, false, 16},
 	}
 	for i, tt := range tests {
-		err := validateParity(tt.ssParity, tt.rrsParity, tt.setDriveCount)
+		err := validateParity(tt.ssParity, tt.rrsParity, tt.autoParity, tt.setDriveCount)
 		if err != nil && tt.success {
 			t.Errorf("Test %d, Expected success, got %s", i+1, err)
 		}
@@ -168,6 +173,41 @@ func TestParityCount(t *testing.T) {
 	}
 }
 
+func TestParityCountAuto(t *testing.T) {
+	scfg := Config{
+		Standard: StorageClass{
+			Parity: 4,
+		},
+		Auto: StorageClass{
+			Parity: 2,
+		},
+		autoThreshold: 1024,
+		initialized:   true,
+	}
+	tests := []struct {
+		size           int64
+		expectedParity int
+	}{
+		{0, 4},
+		{1023, 4},
+		{1024, 2},
+		{2048, 2},
+		// Unknown size is assumed to be large.
+		{-1, 2},
+	}
+	for i, tt := range tests {
+		if parity := scfg.GetParityForAuto(tt.size); parity != tt.expectedParity {
+			t.Errorf("Test %d, Expected parity drives %d, got %d", i+1, tt.expectedParity, parity)
+		}
+	}
+
+	// Without an auto parity configured AUTO behaves as standard.
+	scfg.Auto.Parity = 0
+	if parity := scfg.GetParityForAuto(2048); parity != 4 {
+		t.Errorf("Expected standard parity drives 4, got %d", parity)
+	}
+}
+
 // Test IsValid method with valid and invalid inputs
 func TestIsValidStorageClassKind(t *testing.T) {
 	tests := []struct {
@@ -176,6 +216,7 @@ func TestIsValidStorageClassKind(t *testing.T) {
 	}{
 		{"STANDARD", true},
 		{"REDUCED_REDUNDANCY", true},
+		{"AUTO", true},
 		{"", false},
 		{"INVALID", false},
 		{"123", false},
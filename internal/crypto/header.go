@@ -32,8 +32,12 @@ import (
 func RemoveSensitiveHeaders(h http.Header) {
 	h.Del(xhttp.AmzServerSideEncryptionCustomerKey)
 	h.Del(xhttp.AmzServerSideEncryptionCopyCustomerKey)
-	h.Del(xhttp.AmzMetaUnencryptedContentLength)
-	h.Del(xhttp.AmzMetaUnencryptedContentMD5)
+	if h.Get(cseClientKey) == "" && h.Get(cseClientKeyV2) == "" {
+		// Objects carrying a client-side encryption envelope keep
+		// these entries - encryption clients need them to decrypt.
+		h.Del(xhttp.AmzMetaUnencryptedContentLength)
+		h.Del(xhttp.AmzMetaUnencryptedContentMD5)
+	}
 }
 
 // SSECopy represents AWS SSE-C for copy requests. It provides
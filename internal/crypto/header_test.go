@@ -486,6 +486,22 @@ var removeSensitiveHeadersTests = []struct {
 			"X-Amz-Meta-Test-1": []string{"Test-1"},
 		},
 	},
+	{ // Client-side encryption envelope - keep the envelope bookkeeping entries
+		Header: http.Header{
+			"X-Amz-Meta-X-Amz-Key-V2":                     []string{"a2V5"},
+			"X-Amz-Meta-X-Amz-Iv":                         []string{"aXY="},
+			"X-Amz-Meta-X-Amz-Matdesc":                    []string{"{}"},
+			"X-Amz-Meta-X-Amz-Unencrypted-Content-Md5":    []string{"value"},
+			"X-Amz-Meta-X-Amz-Unencrypted-Content-Length": []string{"42"},
+		},
+		ExpectedHeader: http.Header{
+			"X-Amz-Meta-X-Amz-Key-V2":                     []string{"a2V5"},
+			"X-Amz-Meta-X-Amz-Iv":                         []string{"aXY="},
+			"X-Amz-Meta-X-Amz-Matdesc":                    []string{"{}"},
+			"X-Amz-Meta-X-Amz-Unencrypted-Content-Md5":    []string{"value"},
+			"X-Amz-Meta-X-Amz-Unencrypted-Content-Length": []string{"42"},
+		},
+	},
 }
 
 func TestRemoveSensitiveHeaders(t *testing.T) {
@@ -18,6 +18,8 @@
 package crypto
 
 import (
+	"strings"
+
 	xhttp "github.com/minio/minio/internal/http"
 )
 
@@ -64,6 +66,24 @@ const (
 	ARNPrefix = "arn:aws:kms:"
 )
 
+// Client-side encryption envelope metadata keys written by S3
+// encryption clients. The envelope is opaque to the server.
+const (
+	cseClientKey   = "X-Amz-Meta-X-Amz-Key"
+	cseClientKeyV2 = "X-Amz-Meta-X-Amz-Key-V2"
+)
+
+// IsCSEncrypted returns true if the object metadata contains
+// a client-side encryption envelope.
+func IsCSEncrypted(metadata map[string]string) bool {
+	for k := range metadata {
+		if strings.EqualFold(k, cseClientKey) || strings.EqualFold(k, cseClientKeyV2) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsMultiPart returns true if the object metadata indicates
 // that it was uploaded using some form of server-side-encryption
 // and the S3 multipart API.
@@ -80,8 +100,12 @@ func IsMultiPart(metadata map[string]string) bool {
 func RemoveSensitiveEntries(metadata map[string]string) { // The functions is tested in TestRemoveSensitiveHeaders for compatibility reasons
 	delete(metadata, xhttp.AmzServerSideEncryptionCustomerKey)
 	delete(metadata, xhttp.AmzServerSideEncryptionCopyCustomerKey)
-	delete(metadata, xhttp.AmzMetaUnencryptedContentLength)
-	delete(metadata, xhttp.AmzMetaUnencryptedContentMD5)
+	if !IsCSEncrypted(metadata) {
+		// Objects carrying a client-side encryption envelope keep
+		// these entries - encryption clients need them to decrypt.
+		delete(metadata, xhttp.AmzMetaUnencryptedContentLength)
+		delete(metadata, xhttp.AmzMetaUnencryptedContentMD5)
+	}
 }
 
 // RemoveSSEHeaders removes all crypto-specific SSE
@@ -82,6 +82,11 @@ const (
 	// dRWMutexRefreshInterval - default the interval between two refresh calls
 	drwMutexRefreshInterval = 10 * time.Second
 
+	// dRWMutexLeaseDuration - lease requested with each lock and renewed by
+	// each refresh, the lock server reclaims the lock when the lease expires.
+	// Allows for a few missed refresh calls before the lease is lost.
+	drwMutexLeaseDuration = 3 * drwMutexRefreshInterval
+
 	drwMutexInfinite = 1<<63 - 1
 )
 
@@ -344,7 +349,8 @@ func refreshLock(ctx context.Context, ds *Dsync, id, source string, quorum int)
 	var wg sync.WaitGroup
 
 	args := LockArgs{
-		UID: id,
+		UID:   id,
+		Lease: drwMutexLeaseDuration,
 	}
 
 	for index, c := range restClnts {
@@ -434,6 +440,7 @@ func lock(ctx context.Context, ds *Dsync, locks *[]string, id, source string, is
 		Resources: names,
 		Source:    source,
 		Quorum:    &quorum,
+		Lease:     drwMutexLeaseDuration,
 	}
 
 	// Combined timeout for the lock attempt.
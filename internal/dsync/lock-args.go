@@ -17,6 +17,8 @@
 
 package dsync
 
+import "time"
+
 //go:generate msgp -file $GOFILE
 
 // LockArgs is minimal required values for any dsync compatible lock operation.
@@ -37,6 +39,11 @@ type LockArgs struct {
 
 	// Quorum represents the expected quorum for this lock type.
 	Quorum *int `msgp:"omitempty"`
+
+	// Lease is the duration the lock is held without being renewed by the
+	// client. The lock server reclaims the lock once the lease expires.
+	// Zero means the server falls back to refresh timeout based expiry.
+	Lease time.Duration `msgp:"omitempty"`
 }
 
 // ResponseCode is the response code for a locking request.
@@ -79,6 +79,12 @@ func (z *LockArgs) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "Lease":
+			z.Lease, err = dc.ReadDuration()
+			if err != nil {
+				err = msgp.WrapError(err, "Lease")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -92,9 +98,9 @@ func (z *LockArgs) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *LockArgs) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 5
+	// map header, size 6
 	// write "UID"
-	err = en.Append(0x85, 0xa3, 0x55, 0x49, 0x44)
+	err = en.Append(0x86, 0xa3, 0x55, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -157,15 +163,25 @@ func (z *LockArgs) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "Lease"
+	err = en.Append(0xa5, 0x4c, 0x65, 0x61, 0x73, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteDuration(z.Lease)
+	if err != nil {
+		err = msgp.WrapError(err, "Lease")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *LockArgs) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 5
+	// map header, size 6
 	// string "UID"
-	o = append(o, 0x85, 0xa3, 0x55, 0x49, 0x44)
+	o = append(o, 0x86, 0xa3, 0x55, 0x49, 0x44)
 	o = msgp.AppendString(o, z.UID)
 	// string "Resources"
 	o = append(o, 0xa9, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73)
@@ -186,6 +202,9 @@ func (z *LockArgs) MarshalMsg(b []byte) (o []byte, err error) {
 	} else {
 		o = msgp.AppendInt(o, *z.Quorum)
 	}
+	// string "Lease"
+	o = append(o, 0xa5, 0x4c, 0x65, 0x61, 0x73, 0x65)
+	o = msgp.AppendDuration(o, z.Lease)
 	return
 }
 
@@ -261,6 +280,12 @@ func (z *LockArgs) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "Lease":
+			z.Lease, bts, err = msgp.ReadDurationBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Lease")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -285,6 +310,7 @@ func (z *LockArgs) Msgsize() (s int) {
 	} else {
 		s += msgp.IntSize
 	}
+	s += 6 + msgp.DurationSize
 	return
 }
 
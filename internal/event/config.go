@@ -71,14 +71,20 @@ func (filter *FilterRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) e
 		return err
 	}
 
-	if rule.Name != "prefix" && rule.Name != "suffix" {
+	switch {
+	case rule.Name == "prefix", rule.Name == "suffix":
+		if err := ValidateFilterRuleValue(filter.Value); err != nil {
+			return err
+		}
+	case isConditionFilterName(rule.Name):
+		// MinIO extension: tag:<key> and metadata:<key> equality filters.
+		if rule.Value == "" || strings.ContainsAny(rule.Name+rule.Value, "\n") {
+			return &ErrInvalidFilterValue{rule.Value}
+		}
+	default:
 		return &ErrInvalidFilterName{rule.Name}
 	}
 
-	if err := ValidateFilterRuleValue(filter.Value); err != nil {
-		return err
-	}
-
 	*filter = FilterRule(rule)
 
 	return nil
@@ -102,11 +108,14 @@ func (ruleList *FilterRuleList) UnmarshalXML(d *xml.Decoder, start xml.StartElem
 	nameSet := set.NewStringSet()
 	for _, rule := range rules.Rules {
 		if nameSet.Contains(rule.Name) {
-			if rule.Name == "prefix" {
+			switch rule.Name {
+			case "prefix":
 				return &ErrFilterNamePrefix{}
+			case "suffix":
+				return &ErrFilterNameSuffix{}
+			default:
+				return &ErrInvalidFilterName{rule.Name}
 			}
-
-			return &ErrFilterNameSuffix{}
 		}
 
 		nameSet.Add(rule.Name)
@@ -120,10 +129,11 @@ func (ruleList FilterRuleList) isEmpty() bool {
 	return len(ruleList.Rules) == 0
 }
 
-// Pattern - returns pattern using prefix and suffix values.
+// Pattern - returns pattern using prefix, suffix and condition values.
 func (ruleList FilterRuleList) Pattern() string {
 	var prefix string
 	var suffix string
+	var conditions []string
 
 	for _, rule := range ruleList.Rules {
 		switch rule.Name {
@@ -131,10 +141,12 @@ func (ruleList FilterRuleList) Pattern() string {
 			prefix = rule.Value
 		case "suffix":
 			suffix = rule.Value
+		default:
+			conditions = append(conditions, rule.Name+"="+rule.Value)
 		}
 	}
 
-	return NewPattern(prefix, suffix)
+	return NewConditionsPattern(NewPattern(prefix, suffix), conditions)
 }
 
 // S3Key - represents elements inside <S3Key>...</S3Key>
@@ -163,6 +175,11 @@ type common struct {
 type Queue struct {
 	common
 	ARN ARN `xml:"Queue"`
+
+	// Sync - MinIO extension; when enabled, the object operation waits
+	// for the target to acknowledge events matching this rule before
+	// responding to the client.
+	Sync bool `xml:"Sync,omitempty" json:"Sync,omitempty"`
 }
 
 // UnmarshalXML - decodes XML data.
@@ -294,6 +311,20 @@ func (conf *Config) SetRegion(region string) {
 	}
 }
 
+// ToSyncRulesMap - converts queue configurations marked for synchronous
+// delivery to RulesMap.
+func (conf *Config) ToSyncRulesMap() RulesMap {
+	rulesMap := make(RulesMap)
+
+	for _, queue := range conf.QueueList {
+		if queue.Sync {
+			rulesMap.Add(queue.ToRulesMap())
+		}
+	}
+
+	return rulesMap
+}
+
 // ToRulesMap - converts all queue configuration to RulesMap.
 func (conf *Config) ToRulesMap() RulesMap {
 	rulesMap := make(RulesMap)
@@ -64,6 +64,10 @@ const (
 	ObjectLargeVersions
 	PrefixManyFolders
 	ILMDelMarkerExpirationDelete
+	BucketQuotaThreshold
+	BucketQuotaExceeded
+	ReplicationTargetOffline
+	ReplicationTargetOnline
 
 	objectSingleTypesEnd
 	// Start Compound types that require expansion:
@@ -232,6 +236,14 @@ func (name Name) String() string {
 
 	case PrefixManyFolders:
 		return "s3:Scanner:BigPrefix"
+	case BucketQuotaThreshold:
+		return "s3:BucketQuota:Threshold"
+	case BucketQuotaExceeded:
+		return "s3:BucketQuota:Exceeded"
+	case ReplicationTargetOffline:
+		return "s3:Replication:TargetOffline"
+	case ReplicationTargetOnline:
+		return "s3:Replication:TargetOnline"
 	}
 
 	return ""
@@ -358,6 +370,14 @@ func ParseName(s string) (Name, error) {
 		return ObjectLargeVersions, nil
 	case "s3:Scanner:BigPrefix":
 		return PrefixManyFolders, nil
+	case "s3:BucketQuota:Threshold":
+		return BucketQuotaThreshold, nil
+	case "s3:BucketQuota:Exceeded":
+		return BucketQuotaExceeded, nil
+	case "s3:Replication:TargetOffline":
+		return ReplicationTargetOffline, nil
+	case "s3:Replication:TargetOnline":
+		return ReplicationTargetOnline, nil
 	default:
 		return 0, &ErrInvalidEventName{s}
 	}
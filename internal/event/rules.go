@@ -18,11 +18,83 @@
 package event
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/minio/pkg/v3/wildcard"
 )
 
+// Filter rule names accepted in addition to the standard prefix/suffix,
+// as a MinIO extension. "tag:<key>" and "metadata:<key>" rules require
+// the object tag or user metadata entry to equal the rule value.
+const (
+	tagConditionPrefix      = "tag:"
+	metadataConditionPrefix = "metadata:"
+)
+
+// isConditionFilterName - returns true for "tag:<key>" and
+// "metadata:<key>" filter rule names carrying a non-empty key.
+func isConditionFilterName(name string) bool {
+	for _, prefix := range []string{tagConditionPrefix, metadataConditionPrefix} {
+		if strings.HasPrefix(name, prefix) {
+			key := strings.TrimPrefix(name, prefix)
+			return key != "" && !strings.Contains(key, "=")
+		}
+	}
+	return false
+}
+
+// conditionsSeparator separates the object name pattern from the encoded
+// equality conditions inside a composite rule pattern. Filter values are
+// validated to never contain it.
+const conditionsSeparator = "\n"
+
+// NewConditionsPattern - appends sorted tag/metadata equality conditions
+// (e.g. "tag:env=prod") to a prefix/suffix pattern, forming a composite
+// rule pattern usable as Rules key.
+func NewConditionsPattern(pattern string, conditions []string) string {
+	if len(conditions) == 0 {
+		return pattern
+	}
+
+	// Conditions alone must still match any object name.
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	sort.Strings(conditions)
+	return pattern + conditionsSeparator + strings.Join(conditions, conditionsSeparator)
+}
+
+// splitConditionsPattern - splits a composite rule pattern back into the
+// object name pattern and its equality conditions.
+func splitConditionsPattern(pattern string) (objectPattern string, conditions []string) {
+	tokens := strings.Split(pattern, conditionsSeparator)
+	return tokens[0], tokens[1:]
+}
+
+// matchConditions - returns true if every tag/metadata equality condition
+// is satisfied by the given object tags and user metadata.
+func matchConditions(conditions []string, tags, metadata map[string]string) bool {
+	for _, condition := range conditions {
+		name, value, _ := strings.Cut(condition, "=")
+		switch {
+		case strings.HasPrefix(name, tagConditionPrefix):
+			if tags[strings.TrimPrefix(name, tagConditionPrefix)] != value {
+				return false
+			}
+		case strings.HasPrefix(name, metadataConditionPrefix):
+			if metadata[strings.ToLower(strings.TrimPrefix(name, metadataConditionPrefix))] != value {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 // NewPattern - create new pattern for prefix/suffix.
 func NewPattern(prefix, suffix string) (pattern string) {
 	if prefix != "" {
@@ -55,9 +127,11 @@ func (rules Rules) Add(pattern string, targetID TargetID) {
 }
 
 // MatchSimple - returns true one of the matching object name in rules.
+// Rules carrying tag/metadata conditions never match here.
 func (rules Rules) MatchSimple(objectName string) bool {
 	for pattern := range rules {
-		if wildcard.MatchSimple(pattern, objectName) {
+		objectPattern, conditions := splitConditionsPattern(pattern)
+		if len(conditions) == 0 && wildcard.MatchSimple(objectPattern, objectName) {
 			return true
 		}
 	}
@@ -66,10 +140,17 @@ func (rules Rules) MatchSimple(objectName string) bool {
 
 // Match - returns TargetIDSet matching object name in rules.
 func (rules Rules) Match(objectName string) TargetIDSet {
+	return rules.MatchWith(objectName, nil, nil)
+}
+
+// MatchWith - returns TargetIDSet matching object name, object tags and
+// user metadata in rules.
+func (rules Rules) MatchWith(objectName string, tags, metadata map[string]string) TargetIDSet {
 	targetIDs := NewTargetIDSet()
 
 	for pattern, targetIDSet := range rules {
-		if wildcard.MatchSimple(pattern, objectName) {
+		objectPattern, conditions := splitConditionsPattern(pattern)
+		if wildcard.MatchSimple(objectPattern, objectName) && matchConditions(conditions, tags, metadata) {
 			targetIDs = targetIDs.Union(targetIDSet)
 		}
 	}
@@ -133,6 +133,41 @@ func TestRulesMatch(t *testing.T) {
 	}
 }
 
+func TestRulesMatchWith(t *testing.T) {
+	rulesCase1 := make(Rules)
+	rulesCase1.Add(NewConditionsPattern(NewPattern("2010*", ""), []string{"tag:env=prod"}), TargetID{"1", "webhook"})
+
+	rulesCase2 := make(Rules)
+	rulesCase2.Add(NewConditionsPattern("", []string{"tag:env=prod", "metadata:project=minio"}), TargetID{"1", "webhook"})
+
+	rulesCase3 := make(Rules)
+	rulesCase3.Add(NewPattern("2010*", ""), TargetID{"1", "webhook"})
+
+	testCases := []struct {
+		rules          Rules
+		objectName     string
+		tags           map[string]string
+		metadata       map[string]string
+		expectedResult TargetIDSet
+	}{
+		{rulesCase1, "2010/photos.jpg", map[string]string{"env": "prod"}, nil, NewTargetIDSet(TargetID{"1", "webhook"})},
+		{rulesCase1, "2010/photos.jpg", map[string]string{"env": "dev"}, nil, NewTargetIDSet()},
+		{rulesCase1, "2010/photos.jpg", nil, nil, NewTargetIDSet()},
+		{rulesCase1, "2000/photos.jpg", map[string]string{"env": "prod"}, nil, NewTargetIDSet()},
+		{rulesCase2, "photos.jpg", map[string]string{"env": "prod"}, map[string]string{"project": "minio"}, NewTargetIDSet(TargetID{"1", "webhook"})},
+		{rulesCase2, "photos.jpg", map[string]string{"env": "prod"}, nil, NewTargetIDSet()},
+		{rulesCase3, "2010/photos.jpg", nil, nil, NewTargetIDSet(TargetID{"1", "webhook"})},
+	}
+
+	for i, testCase := range testCases {
+		result := testCase.rules.MatchWith(testCase.objectName, testCase.tags, testCase.metadata)
+
+		if !reflect.DeepEqual(testCase.expectedResult, result) {
+			t.Fatalf("test %v: result: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
 func TestRulesClone(t *testing.T) {
 	rulesCase1 := make(Rules)
 
@@ -71,6 +71,12 @@ func (rulesMap RulesMap) Match(eventName Name, objectName string) TargetIDSet {
 	return rulesMap[eventName].Match(objectName)
 }
 
+// MatchWith - returns TargetIDSet matching object name, object tags and
+// user metadata for event name in rules map.
+func (rulesMap RulesMap) MatchWith(eventName Name, objectName string, tags, metadata map[string]string) TargetIDSet {
+	return rulesMap[eventName].MatchWith(objectName, tags, metadata)
+}
+
 // NewRulesMap - creates new rules map with given values.
 func NewRulesMap(eventNames []Name, pattern string, targetID TargetID) RulesMap {
 	// If pattern is empty, add '*' wildcard to match all.
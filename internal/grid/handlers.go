@@ -116,6 +116,8 @@ const (
 	HandlerRenamePart
 	HandlerClearUploadID
 	HandlerCheckParts3
+	HandlerSetBucketBandwidthLimit
+	HandlerGetObjectAgeStats
 
 	// Add more above here ^^^
 	// If all handlers are used, the type of Handler can be changed.
@@ -200,6 +202,8 @@ var handlerPrefixes = [handlerLast]string{
 	HandlerCheckParts3:                 storagePrefix,
 	HandlerRenamePart:                  storagePrefix,
 	HandlerClearUploadID:               peerPrefix,
+	HandlerSetBucketBandwidthLimit:     peerPrefix,
+	HandlerGetObjectAgeStats:           peerPrefix,
 }
 
 const (
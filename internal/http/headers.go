@@ -19,27 +19,30 @@ package http
 
 // Standard S3 HTTP response constants
 const (
-	LastModified       = "Last-Modified"
-	Date               = "Date"
-	ETag               = "ETag"
-	ContentType        = "Content-Type"
-	ContentMD5         = "Content-Md5"
-	ContentEncoding    = "Content-Encoding"
-	Expires            = "Expires"
-	ContentLength      = "Content-Length"
-	ContentLanguage    = "Content-Language"
-	ContentRange       = "Content-Range"
-	Connection         = "Connection"
-	AcceptRanges       = "Accept-Ranges"
-	AmzBucketRegion    = "X-Amz-Bucket-Region"
-	ServerInfo         = "Server"
-	RetryAfter         = "Retry-After"
-	Location           = "Location"
-	CacheControl       = "Cache-Control"
-	ContentDisposition = "Content-Disposition"
-	Authorization      = "Authorization"
-	Action             = "Action"
-	Range              = "Range"
+	LastModified    = "Last-Modified"
+	Date            = "Date"
+	ETag            = "ETag"
+	ContentType     = "Content-Type"
+	ContentMD5      = "Content-Md5"
+	ContentEncoding = "Content-Encoding"
+	Expires         = "Expires"
+	ContentLength   = "Content-Length"
+	ContentLanguage = "Content-Language"
+	ContentRange    = "Content-Range"
+	Connection      = "Connection"
+	AcceptRanges    = "Accept-Ranges"
+	AmzBucketRegion = "X-Amz-Bucket-Region"
+	// AmzExpectedBucketOwner is the account ID the client expects to own
+	// the bucket, requests are denied when it does not match.
+	AmzExpectedBucketOwner = "X-Amz-Expected-Bucket-Owner"
+	ServerInfo             = "Server"
+	RetryAfter             = "Retry-After"
+	Location               = "Location"
+	CacheControl           = "Cache-Control"
+	ContentDisposition     = "Content-Disposition"
+	Authorization          = "Authorization"
+	Action                 = "Action"
+	Range                  = "Range"
 )
 
 // Non standard S3 HTTP response constants
@@ -188,6 +191,9 @@ const (
 	// Delete special flag to force delete a bucket or a prefix
 	MinIOForceDelete = "x-minio-force-delete"
 
+	// Confirmation token accompanying a force delete of a non-empty bucket
+	MinIOForceDeleteToken = "x-minio-force-delete-token"
+
 	// Create special flag to force create a bucket
 	MinIOForceCreate = "x-minio-force-create"
 
@@ -197,6 +203,28 @@ const (
 	// Header indicates if the etag should be preserved by client
 	MinIOSourceETag = "x-minio-source-etag"
 
+	// Header carries the source bucket/object (or prefix) of a
+	// server-side rename request
+	MinIORenameSource = "x-minio-rename-source"
+
+	// Header carries metadata key patterns stripped from the copied
+	// metadata during CopyObject
+	MinIOCopyStripMetadata = "x-minio-copy-strip-metadata"
+
+	// Header carries metadata keys replaced in the copied metadata
+	// during CopyObject
+	MinIOCopyReplaceMetadata = "x-minio-copy-replace-metadata"
+
+	// Header carries tag keys stripped from the copied tags during
+	// CopyObject
+	MinIOCopyStripTagging = "x-minio-copy-strip-tagging"
+
+	// Header carries tags replaced in the copied tags during CopyObject
+	MinIOCopyReplaceTagging = "x-minio-copy-replace-tagging"
+
+	// Header reports the durable offset of a resumable PUT
+	MinIOResumeOffset = "x-minio-resume-offset"
+
 	// Writes expected write quorum
 	MinIOWriteQuorum = "x-minio-write-quorum"
 
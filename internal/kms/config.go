@@ -25,6 +25,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -59,6 +60,16 @@ const (
 	EnvKESClientPassword = "MINIO_KMS_KES_KEY_PASSWORD" // Optional password to decrypt an encrypt TLS private key
 )
 
+// Environment variables for Hashicorp Vault Transit.
+const (
+	EnvVaultEndpoint   = "MINIO_KMS_VAULT_ENDPOINT"    // Vault server endpoint, e.g. https://vault:8200
+	EnvVaultToken      = "MINIO_KMS_VAULT_TOKEN"       // Vault token used to authenticate to the Transit engine
+	EnvVaultDefaultKey = "MINIO_KMS_VAULT_KEY_NAME"    // The default Transit key used for IAM data and when no key ID is specified on a bucket
+	EnvVaultNamespace  = "MINIO_KMS_VAULT_NAMESPACE"   // Optional Vault enterprise namespace
+	EnvVaultEnginePath = "MINIO_KMS_VAULT_ENGINE_PATH" // Optional mount path of the Transit engine. Defaults to "transit"
+	EnvVaultCAPath     = "MINIO_KMS_VAULT_CAPATH"      // Path to file/directory containing CA certificates to verify the Vault server certificate
+)
+
 // Environment variables for static KMS key.
 const (
 	EnvKMSSecretKey     = "MINIO_KMS_SECRET_KEY"      // Static KMS key in the form "<key-name>:<base64-32byte-key>". Implements a subset of KMS/KES APIs
@@ -102,6 +113,8 @@ type ConnectionOptions struct {
 //
 //   - connection to MinIO KMS if the "MINIO_KMS_SERVER" variable is present.
 //   - connection to MinIO KES if the "MINIO_KMS_KES_ENDPOINT" is present.
+//   - connection to Hashicorp Vault Transit if the "MINIO_KMS_VAULT_ENDPOINT"
+//     is present.
 //   - connection to a "local" KMS implementation using a static key if the
 //     "MINIO_KMS_SECRET_KEY" or "MINIO_KMS_SECRET_KEY_FILE" is present.
 //
@@ -274,6 +287,42 @@ func Connect(ctx context.Context, opts *ConnectionOptions) (*KMS, error) {
 			latencyBuckets: defaultLatencyBuckets,
 			latency:        make([]atomic.Uint64, len(defaultLatencyBuckets)),
 		}, nil
+	case lookup(EnvVaultEndpoint):
+		endpoint := strings.TrimSuffix(env.Get(EnvVaultEndpoint, ""), "/")
+		if endpoint == "" {
+			return nil, errors.New("kms: no Vault server endpoint provided")
+		}
+
+		conf := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			ClientSessionCache: tls.NewLRUClientSessionCache(tlsClientSessionCacheSize),
+		}
+		var caDir string
+		if opts != nil {
+			caDir = opts.CADir
+		}
+		rootCAs, err := certs.GetRootCAs(env.Get(EnvVaultCAPath, caDir))
+		if err != nil {
+			return nil, err
+		}
+		conf.RootCAs = rootCAs
+
+		return &KMS{
+			Type:       Vault,
+			DefaultKey: env.Get(EnvVaultDefaultKey, ""),
+			conn: &vaultConn{
+				endpoint:   endpoint,
+				enginePath: strings.Trim(env.Get(EnvVaultEnginePath, "transit"), "/"),
+				namespace:  env.Get(EnvVaultNamespace, ""),
+				token:      env.Get(EnvVaultToken, ""),
+				defaultKey: env.Get(EnvVaultDefaultKey, ""),
+				client: &http.Client{
+					Transport: &http.Transport{TLSClientConfig: conf},
+				},
+			},
+			latencyBuckets: defaultLatencyBuckets,
+			latency:        make([]atomic.Uint64, len(defaultLatencyBuckets)),
+		}, nil
 	default:
 		var s string
 		if lookup(EnvKMSSecretKeyFile) {
@@ -329,6 +378,14 @@ func IsPresent() (bool, error) {
 		EnvKESClientPassword,
 		EnvKESServerCA,
 	)
+	vaultPresent := isPresent(
+		EnvVaultEndpoint,
+		EnvVaultToken,
+		EnvVaultDefaultKey,
+		EnvVaultNamespace,
+		EnvVaultEnginePath,
+		EnvVaultCAPath,
+	)
 	// We have to handle a special case for MINIO_KMS_SECRET_KEY and
 	// MINIO_KMS_SECRET_KEY_FILE. The docker image always sets the
 	// MINIO_KMS_SECRET_KEY_FILE - either to the argument passed to
@@ -362,6 +419,12 @@ func IsPresent() (bool, error) {
 		return false, errors.New("kms: configuration for MinIO KMS and static KMS key is present")
 	case kesPresent && staticKeyPresent:
 		return false, errors.New("kms: configuration for MinIO KES and static KMS key is present")
+	case vaultPresent && kmsPresent:
+		return false, errors.New("kms: configuration for Hashicorp Vault and MinIO KMS is present")
+	case vaultPresent && kesPresent:
+		return false, errors.New("kms: configuration for Hashicorp Vault and MinIO KES is present")
+	case vaultPresent && staticKeyPresent:
+		return false, errors.New("kms: configuration for Hashicorp Vault and static KMS key is present")
 	}
 
 	// Next, we check that all required configuration for the concrete
@@ -413,6 +476,17 @@ func IsPresent() (bool, error) {
 			return false, errors.New("kms: incomplete configuration for MinIO KES: missing authentication method")
 		}
 		return true, nil
+	case vaultPresent:
+		if !isPresent(EnvVaultEndpoint) {
+			return false, fmt.Errorf("kms: incomplete configuration for Hashicorp Vault: missing '%s'", EnvVaultEndpoint)
+		}
+		if !isPresent(EnvVaultToken) {
+			return false, fmt.Errorf("kms: incomplete configuration for Hashicorp Vault: missing '%s'", EnvVaultToken)
+		}
+		if !isPresent(EnvVaultDefaultKey) {
+			return false, fmt.Errorf("kms: incomplete configuration for Hashicorp Vault: missing '%s'", EnvVaultDefaultKey)
+		}
+		return true, nil
 	}
 }
 
@@ -77,6 +77,7 @@ type conn interface {
 var ( // compiler checks
 	_ conn = (*kmsConn)(nil)
 	_ conn = (*kesConn)(nil)
+	_ conn = (*vaultConn)(nil)
 	_ conn = secretKey{}
 )
 
@@ -85,6 +86,7 @@ const (
 	MinKMS  Type = iota + 1 // MinIO KMS
 	MinKES                  // MinIO MinKES
 	Builtin                 // Builtin single key KMS implementation
+	Vault                   // Hashicorp Vault Transit engine
 )
 
 // Type identifies the KMS type.
@@ -99,6 +101,8 @@ func (t Type) String() string {
 		return "MinIO KES"
 	case Builtin:
 		return "MinIO builtin"
+	case Vault:
+		return "Hashicorp Vault Transit"
 	default:
 		return "!INVALID:" + strconv.Itoa(int(t))
 	}
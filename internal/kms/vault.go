@@ -0,0 +1,290 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/minio/madmin-go/v3"
+)
+
+// vaultConn talks to the Hashicorp Vault Transit engine directly,
+// without an intermediate KES server. Data keys never leave Vault in
+// sealed form: GenerateKey and Decrypt map to the Transit datakey and
+// decrypt APIs.
+//
+// The KMS context is passed as the Transit "context" parameter. Vault
+// binds it cryptographically only for keys created with derivation
+// enabled; for other keys it is ignored by Vault.
+type vaultConn struct {
+	endpoint   string
+	enginePath string
+	namespace  string
+	token      string
+	defaultKey string
+	client     *http.Client
+}
+
+// vaultResponse is the generic shape of a Vault API response.
+type vaultResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []string        `json:"errors"`
+}
+
+func (c *vaultConn) call(ctx context.Context, method, path string, body, data interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+"/v1/"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", c.token)
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var vResp vaultResponse
+	if resp.StatusCode != http.StatusNoContent {
+		if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&vResp); err != nil && resp.StatusCode < 300 {
+			return err
+		}
+	}
+	switch {
+	case resp.StatusCode == http.StatusForbidden:
+		return ErrPermission
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrKeyNotFound
+	case resp.StatusCode >= 300:
+		return fmt.Errorf("kms: vault returned %d: %s", resp.StatusCode, strings.Join(vResp.Errors, "; "))
+	}
+	if data != nil && vResp.Data != nil {
+		return json.Unmarshal(vResp.Data, data)
+	}
+	return nil
+}
+
+func (c *vaultConn) Version(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/v1/sys/health", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var health struct {
+		Version string `json:"version"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&health); err != nil {
+		return "", err
+	}
+	return health.Version, nil
+}
+
+func (c *vaultConn) APIs(ctx context.Context) ([]madmin.KMSAPI, error) {
+	return nil, ErrNotSupported
+}
+
+// Status reports whether the Vault server is reachable, initialized
+// and unsealed.
+func (c *vaultConn) Status(ctx context.Context) (map[string]madmin.ItemState, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/v1/sys/health", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		return map[string]madmin.ItemState{
+			c.endpoint: madmin.ItemOffline,
+		}, nil
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+
+	// Vault returns 200 for an active and 429 for a standby node.
+	// Sealed (503) and uninitialized (501) servers cannot serve
+	// transit requests.
+	item := madmin.ItemOffline
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusTooManyRequests {
+		item = madmin.ItemOnline
+	}
+	return map[string]madmin.ItemState{
+		c.endpoint: item,
+	}, nil
+}
+
+func (c *vaultConn) CreateKey(ctx context.Context, req *CreateKeyRequest) error {
+	// Vault's key creation endpoint is idempotent; probe for an
+	// existing key first to report ErrKeyExists consistently.
+	err := c.call(ctx, http.MethodGet, c.enginePath+"/keys/"+url.PathEscape(req.Name), nil, nil)
+	if err == nil {
+		return ErrKeyExists
+	}
+	if !errors.Is(err, ErrKeyNotFound) {
+		return errKeyCreationFailed(err)
+	}
+
+	if err = c.call(ctx, http.MethodPost, c.enginePath+"/keys/"+url.PathEscape(req.Name), map[string]interface{}{}, nil); err != nil {
+		if errors.Is(err, ErrPermission) {
+			return err
+		}
+		return errKeyCreationFailed(err)
+	}
+	return nil
+}
+
+func (c *vaultConn) ListKeys(ctx context.Context, req *ListRequest) ([]madmin.KMSKeyInfo, string, error) {
+	var data struct {
+		Keys []string `json:"keys"`
+	}
+	if err := c.call(ctx, "LIST", c.enginePath+"/keys", nil, &data); err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			// Vault returns 404 when no keys exist.
+			return []madmin.KMSKeyInfo{}, "", nil
+		}
+		if errors.Is(err, ErrPermission) {
+			return nil, "", err
+		}
+		return nil, "", errListingKeysFailed(err)
+	}
+
+	sort.Strings(data.Keys)
+	keyInfos := make([]madmin.KMSKeyInfo, 0, len(data.Keys))
+	for _, name := range data.Keys {
+		if req.Prefix != "" && !strings.HasPrefix(name, req.Prefix) {
+			continue
+		}
+		keyInfos = append(keyInfos, madmin.KMSKeyInfo{Name: name})
+	}
+	return keyInfos, "", nil
+}
+
+func (c *vaultConn) GenerateKey(ctx context.Context, req *GenerateKeyRequest) (DEK, error) {
+	aad, err := req.AssociatedData.MarshalText()
+	if err != nil {
+		return DEK{}, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = c.defaultKey
+	}
+
+	body := map[string]interface{}{}
+	if len(aad) > 0 {
+		body["context"] = base64.StdEncoding.EncodeToString(aad)
+	}
+	var data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	}
+	if err = c.call(ctx, http.MethodPost, c.enginePath+"/datakey/plaintext/"+url.PathEscape(name), body, &data); err != nil {
+		if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrPermission) {
+			return DEK{}, err
+		}
+		return DEK{}, errKeyGenerationFailed(err)
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(data.Plaintext)
+	if err != nil {
+		return DEK{}, errKeyGenerationFailed(err)
+	}
+	return DEK{
+		KeyID:      name,
+		Plaintext:  plaintext,
+		Ciphertext: []byte(data.Ciphertext),
+	}, nil
+}
+
+func (c *vaultConn) Decrypt(ctx context.Context, req *DecryptRequest) ([]byte, error) {
+	aad, err := req.AssociatedData.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"ciphertext": string(req.Ciphertext),
+	}
+	if len(aad) > 0 {
+		body["context"] = base64.StdEncoding.EncodeToString(aad)
+	}
+	var data struct {
+		Plaintext string `json:"plaintext"`
+	}
+	if err = c.call(ctx, http.MethodPost, c.enginePath+"/decrypt/"+url.PathEscape(req.Name), body, &data); err != nil {
+		if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrPermission) {
+			return nil, err
+		}
+		return nil, ErrDecrypt
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(data.Plaintext)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// MAC generates the checksum of the given req.Message using the key
+// with the req.Name at the KMS.
+func (c *vaultConn) MAC(ctx context.Context, req *MACRequest) ([]byte, error) {
+	body := map[string]interface{}{
+		"input": base64.StdEncoding.EncodeToString(req.Message),
+	}
+	var data struct {
+		HMAC string `json:"hmac"`
+	}
+	if err := c.call(ctx, http.MethodPost, c.enginePath+"/hmac/"+url.PathEscape(req.Name), body, &data); err != nil {
+		if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrPermission) {
+			return nil, err
+		}
+		return nil, ErrNotSupported
+	}
+	return []byte(data.HMAC), nil
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package orc
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	jsonfmt "github.com/minio/minio/internal/s3select/json"
+	"github.com/minio/minio/internal/s3select/jstream"
+	"github.com/minio/minio/internal/s3select/sql"
+	orcgo "github.com/scritchley/orc"
+)
+
+// Reader implements reading records from ORC input.
+type Reader struct {
+	io.Closer
+	r *orcgo.Reader
+
+	// Top-level columns to read, in schema order. Narrowed by
+	// SetSelectedPaths for projection pushdown.
+	fields []string
+
+	cursor   *orcgo.Cursor
+	inStripe bool
+}
+
+// readSeekerAt adapts the object stream to the random access reader the
+// ORC library requires; ORC reading jumps between the file tail and
+// individual stripes.
+type readSeekerAt struct {
+	mu   sync.Mutex
+	rs   io.ReadSeeker
+	size int64
+}
+
+func (r *readSeekerAt) ReadAt(p []byte, off int64) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err = r.rs.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err = io.ReadFull(r.rs, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *readSeekerAt) Size() int64 {
+	return r.size
+}
+
+// NewORCReader creates a Reader from an io.ReadSeekCloser.
+func NewORCReader(rsc io.ReadSeekCloser, _ *ReaderArgs) (*Reader, error) {
+	size, err := rsc.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, errORCParsingError(err)
+	}
+
+	or, err := orcgo.NewReader(&readSeekerAt{rs: rsc, size: size})
+	if err != nil {
+		return nil, errORCParsingError(err)
+	}
+
+	return &Reader{Closer: rsc, r: or, fields: or.Schema().Columns()}, nil
+}
+
+// SetSelectedPaths enables projection pushdown: only the streams of the
+// top-level columns named by the given paths are loaded and decoded.
+// Must be called before the first Read.
+func (r *Reader) SetSelectedPaths(paths [][]string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	selected := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		selected[path[0]] = struct{}{}
+	}
+
+	// Columns named in the query but absent from the schema evaluate to
+	// MISSING; keep schema column order for the emitted records.
+	fields := make([]string, 0, len(selected))
+	for _, name := range r.r.Schema().Columns() {
+		if _, ok := selected[name]; ok {
+			fields = append(fields, name)
+		}
+	}
+	if len(fields) > 0 {
+		r.fields = fields
+	}
+}
+
+// nextRow returns the next row of the file, advancing to the next stripe
+// as the current one is exhausted.
+func (r *Reader) nextRow() ([]interface{}, error) {
+	if r.cursor == nil {
+		r.cursor = r.r.Select(r.fields...)
+	}
+
+	for {
+		if r.inStripe {
+			if r.cursor.Next() {
+				return r.cursor.Row(), nil
+			}
+			r.inStripe = false
+		}
+		if !r.cursor.Stripes() {
+			if err := r.cursor.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		r.inStripe = true
+	}
+}
+
+// Read - reads single record.
+func (r *Reader) Read(dst sql.Record) (rec sql.Record, rerr error) {
+	row, err := r.nextRow()
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, errORCParsingError(err)
+	}
+
+	kvs := make(jstream.KVS, 0, len(r.fields))
+	for i, name := range r.fields {
+		td, _ := r.r.Schema().GetField(name)
+		kvs = append(kvs, jstream.KV{Key: name, Value: convertORCValue(td, row[i])})
+	}
+
+	// Reuse destination if we can.
+	dstRec, ok := dst.(*jsonfmt.Record)
+	if !ok {
+		dstRec = &jsonfmt.Record{}
+	}
+	dstRec.SelectFormat = sql.SelectFmtORC
+	dstRec.KVS = kvs
+	return dstRec, nil
+}
+
+// convertORCValue converts a column value as decoded by the ORC reader
+// into record form: binary becomes string, dates and timestamps are
+// formatted as SQL timestamps, structs and maps become nested KVS and
+// lists become plain arrays. The type description orders struct fields;
+// without one (e.g. inside lists) fields are ordered by name.
+func convertORCValue(td *orcgo.TypeDescription, v interface{}) interface{} {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return string(val)
+	case float32:
+		return float64(val)
+	case orcgo.Date:
+		return sql.FormatSQLTimestamp(val.Time)
+	case time.Time:
+		return sql.FormatSQLTimestamp(val)
+	case orcgo.Decimal:
+		return val.Float64()
+	case orcgo.Struct:
+		return convertORCStruct(td, val)
+	case []interface{}:
+		list := make([]interface{}, len(val))
+		for i, item := range val {
+			list[i] = convertORCValue(nil, item)
+		}
+		return list
+	case []orcgo.MapEntry:
+		kvs := make(jstream.KVS, 0, len(val))
+		for _, entry := range val {
+			key, ok := convertORCValue(nil, entry.Key).(string)
+			if !ok {
+				key = fmt.Sprint(entry.Key)
+			}
+			kvs = append(kvs, jstream.KV{Key: key, Value: convertORCValue(nil, entry.Value)})
+		}
+		return kvs
+	default:
+		return v
+	}
+}
+
+func convertORCStruct(td *orcgo.TypeDescription, val orcgo.Struct) jstream.KVS {
+	var names []string
+	if td != nil {
+		names = td.Columns()
+	} else {
+		names = make([]string, 0, len(val))
+		for name := range val {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	kvs := make(jstream.KVS, 0, len(names))
+	for _, name := range names {
+		fieldVal, ok := val[name]
+		if !ok {
+			continue
+		}
+		var fieldTD *orcgo.TypeDescription
+		if td != nil {
+			fieldTD, _ = td.GetField(name)
+		}
+		kvs = append(kvs, jstream.KV{Key: name, Value: convertORCValue(fieldTD, fieldVal)})
+	}
+	return kvs
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package orc
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/minio/minio/internal/s3select/jstream"
+	orcgo "github.com/scritchley/orc"
+)
+
+func writeTestORC(t *testing.T) string {
+	t.Helper()
+
+	schema, err := orcgo.ParseSchema("struct<id:bigint,name:string,nested:struct<a:string,b:bigint>>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join(t.TempDir(), "test.orc")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w, err := orcgo.NewWriter(f, orcgo.SetSchema(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := [][]interface{}{
+		{int64(1), "foo", []interface{}{"x", int64(10)}},
+		{int64(2), "bar", []interface{}{"y", int64(20)}},
+	}
+	for _, row := range rows {
+		if err = w.Write(row...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+func TestReadORC(t *testing.T) {
+	f, err := os.Open(writeTestORC(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	or, err := NewORCReader(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer or.Close()
+
+	expected := []jstream.KVS{
+		{
+			{Key: "id", Value: int64(1)},
+			{Key: "name", Value: "foo"},
+			{Key: "nested", Value: jstream.KVS{
+				{Key: "a", Value: "x"},
+				{Key: "b", Value: int64(10)},
+			}},
+		},
+		{
+			{Key: "id", Value: int64(2)},
+			{Key: "name", Value: "bar"},
+			{Key: "nested", Value: jstream.KVS{
+				{Key: "a", Value: "y"},
+				{Key: "b", Value: int64(20)},
+			}},
+		},
+	}
+	for i, expectedKVS := range expected {
+		rec, err := or.Read(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, rawVal := rec.Raw()
+		kvs, ok := rawVal.(jstream.KVS)
+		if !ok {
+			t.Fatalf("unexpected raw record type %T", rawVal)
+		}
+		if !reflect.DeepEqual(expectedKVS, kvs) {
+			t.Fatalf("row %d: expected %#v, got %#v", i, expectedKVS, kvs)
+		}
+	}
+	if _, err = or.Read(nil); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestReadORCProjection(t *testing.T) {
+	f, err := os.Open(writeTestORC(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	or, err := NewORCReader(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer or.Close()
+
+	or.SetSelectedPaths([][]string{{"name"}})
+
+	rec, err := or.Read(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, rawVal := rec.Raw()
+	kvs, ok := rawVal.(jstream.KVS)
+	if !ok {
+		t.Fatalf("unexpected raw record type %T", rawVal)
+	}
+
+	expected := jstream.KVS{
+		{Key: "name", Value: "foo"},
+	}
+	if !reflect.DeepEqual(expected, kvs) {
+		t.Fatalf("expected %#v, got %#v", expected, kvs)
+	}
+}
@@ -52,3 +52,12 @@ func errParquetParsingError(err error) *s3Error {
 		cause:      err,
 	}
 }
+
+func errParquetSerializationError(err error) *s3Error {
+	return &s3Error{
+		code:       "ParquetSerializationError",
+		message:    "Error serializing results as Parquet. Please check the query and try again.",
+		statusCode: 400,
+		cause:      err,
+	}
+}
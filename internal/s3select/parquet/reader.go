@@ -18,12 +18,16 @@
 package parquet
 
 import (
+	"encoding/binary"
 	"errors"
 	"io"
+	"math"
+	"slices"
 	"time"
 
 	parquetgo "github.com/fraugster/parquet-go"
 	parquettypes "github.com/fraugster/parquet-go/parquet"
+	"github.com/fraugster/parquet-go/parquetschema"
 	jsonfmt "github.com/minio/minio/internal/s3select/json"
 	"github.com/minio/minio/internal/s3select/jstream"
 	"github.com/minio/minio/internal/s3select/sql"
@@ -32,7 +36,25 @@ import (
 // Reader implements reading records from parquet input.
 type Reader struct {
 	io.Closer
-	r *parquetgo.FileReader
+	rs io.ReadSeeker
+	r  *parquetgo.FileReader
+
+	// Top-level column names selected by projection pushdown, empty
+	// when all columns are read.
+	selectedRoots map[string]struct{}
+
+	// Predicate pushdown state: row groups whose column statistics
+	// cannot satisfy preds are skipped entirely.
+	preds      []sql.ColumnPredicate
+	planned    bool
+	keptGroups []keptRowGroup
+	nextGroup  int
+	rowsLeft   int64
+}
+
+type keptRowGroup struct {
+	index   int
+	numRows int64
 }
 
 // NewParquetReader creates a Reader2 from a io.ReadSeekCloser.
@@ -42,11 +64,223 @@ func NewParquetReader(rsc io.ReadSeekCloser, _ *ReaderArgs) (r *Reader, err erro
 		return nil, errParquetParsingError(err)
 	}
 
-	return &Reader{Closer: rsc, r: fr}, nil
+	return &Reader{Closer: rsc, rs: rsc, r: fr}, nil
+}
+
+// SetSelectedPaths enables projection pushdown: only the parquet columns
+// under the given paths are decoded and emitted. Must be called before
+// the first Read.
+func (pr *Reader) SetSelectedPaths(paths [][]string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	pr.selectedRoots = make(map[string]struct{}, len(paths))
+	cols := make([]parquetgo.ColumnPath, 0, len(paths))
+	for _, path := range paths {
+		pr.selectedRoots[path[0]] = struct{}{}
+		cols = append(cols, parquetgo.ColumnPath(path))
+	}
+	pr.r.SetSelectedColumnsByPath(cols...)
+}
+
+// RowCount returns the total number of rows in the file per the file
+// metadata, without decoding any row groups.
+func (pr *Reader) RowCount() int64 {
+	return pr.r.NumRows()
+}
+
+// SetPredicates enables predicate pushdown: row groups whose column
+// min/max statistics show they cannot contain a matching row are not
+// decoded. Must be called before the first Read.
+func (pr *Reader) SetPredicates(preds []sql.ColumnPredicate) {
+	pr.preds = preds
+}
+
+func (pr *Reader) nextRow() (map[string]interface{}, error) {
+	if len(pr.preds) == 0 {
+		return pr.r.NextRow()
+	}
+
+	if !pr.planned {
+		if err := pr.planRowGroups(); err != nil {
+			// Statistics are advisory - fall back to reading all rows.
+			pr.preds = nil
+			return pr.r.NextRow()
+		}
+		pr.planned = true
+	}
+
+	for pr.rowsLeft == 0 {
+		if pr.nextGroup >= len(pr.keptGroups) {
+			return nil, io.EOF
+		}
+		kept := pr.keptGroups[pr.nextGroup]
+		pr.nextGroup++
+		// SeekToRowGroup takes 1-based row group positions.
+		if err := pr.r.SeekToRowGroup(kept.index + 1); err != nil {
+			return nil, err
+		}
+		pr.rowsLeft = kept.numRows
+	}
+
+	pr.rowsLeft--
+	return pr.r.NextRow()
+}
+
+// planRowGroups reads the file metadata and records the row groups whose
+// statistics do not rule out a match for every pushed down predicate.
+func (pr *Reader) planRowGroups() error {
+	meta, err := parquetgo.ReadFileMetaData(pr.rs, false)
+	if err != nil {
+		return err
+	}
+
+	for i, rowGroup := range meta.RowGroups {
+		if pr.rowGroupMayMatch(rowGroup) {
+			pr.keptGroups = append(pr.keptGroups, keptRowGroup{index: i, numRows: rowGroup.NumRows})
+		}
+	}
+	return nil
+}
+
+func (pr *Reader) rowGroupMayMatch(rowGroup *parquettypes.RowGroup) bool {
+	for _, pred := range pr.preds {
+		chunk := findColumnChunk(rowGroup, pred.Path)
+		if chunk == nil || chunk.MetaData == nil || chunk.MetaData.Statistics == nil {
+			continue
+		}
+		if pr.columnIsUnsigned(pred.Path) {
+			// Statistics of unsigned columns do not sort like their
+			// signed physical representation.
+			continue
+		}
+
+		stats := chunk.MetaData.Statistics
+		minBytes, maxBytes := stats.MinValue, stats.MaxValue
+		if minBytes == nil || maxBytes == nil {
+			minBytes, maxBytes = stats.Min, stats.Max
+		}
+		if minBytes == nil || maxBytes == nil {
+			continue
+		}
+
+		minVal, ok := statValue(chunk.MetaData.Type, minBytes)
+		if !ok {
+			continue
+		}
+		maxVal, _ := statValue(chunk.MetaData.Type, maxBytes)
+
+		var mayMatch bool
+		switch minV := minVal.(type) {
+		case float64:
+			if pred.Number == nil {
+				continue
+			}
+			mayMatch = intervalMayMatch(minV, maxVal.(float64), pred.Op, *pred.Number)
+		case string:
+			if pred.String == nil {
+				continue
+			}
+			mayMatch = intervalMayMatch(minV, maxVal.(string), pred.Op, *pred.String)
+		}
+		if !mayMatch {
+			return false
+		}
+	}
+	return true
+}
+
+// findColumnChunk returns the column chunk of the leaf column at path.
+func findColumnChunk(rowGroup *parquettypes.RowGroup, path []string) *parquettypes.ColumnChunk {
+	for _, chunk := range rowGroup.Columns {
+		if chunk.MetaData != nil && slices.Equal(chunk.MetaData.PathInSchema, path) {
+			return chunk
+		}
+	}
+	return nil
+}
+
+func (pr *Reader) columnIsUnsigned(path []string) bool {
+	col := pr.r.GetSchemaDefinition().RootColumn
+	for _, name := range path {
+		var next *parquetschema.ColumnDefinition
+		for _, child := range col.Children {
+			if child.SchemaElement.GetName() == name {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return false
+		}
+		col = next
+	}
+
+	se := col.SchemaElement
+	if logicalType := se.GetLogicalType(); logicalType != nil && logicalType.IsSetINTEGER() {
+		return !logicalType.INTEGER.IsSigned
+	}
+	if se.IsSetConvertedType() {
+		switch se.GetConvertedType() {
+		case parquettypes.ConvertedType_UINT_8, parquettypes.ConvertedType_UINT_16,
+			parquettypes.ConvertedType_UINT_32, parquettypes.ConvertedType_UINT_64:
+			return true
+		}
+	}
+	return false
+}
+
+// statValue decodes a min/max statistics value per the column's physical
+// type. Numeric types decode to float64 to match SQL literal handling.
+func statValue(typ parquettypes.Type, b []byte) (interface{}, bool) {
+	switch typ {
+	case parquettypes.Type_INT32:
+		if len(b) != 4 {
+			return nil, false
+		}
+		return float64(int32(binary.LittleEndian.Uint32(b))), true
+	case parquettypes.Type_INT64:
+		if len(b) != 8 {
+			return nil, false
+		}
+		return float64(int64(binary.LittleEndian.Uint64(b))), true
+	case parquettypes.Type_FLOAT:
+		if len(b) != 4 {
+			return nil, false
+		}
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), true
+	case parquettypes.Type_DOUBLE:
+		if len(b) != 8 {
+			return nil, false
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), true
+	case parquettypes.Type_BYTE_ARRAY, parquettypes.Type_FIXED_LEN_BYTE_ARRAY:
+		return string(b), true
+	}
+	return nil, false
+}
+
+// intervalMayMatch returns whether some value in [min, max] can satisfy
+// `value <op> literal`.
+func intervalMayMatch[T float64 | string](minVal, maxVal T, op string, literal T) bool {
+	switch op {
+	case "=":
+		return literal >= minVal && literal <= maxVal
+	case "<":
+		return minVal < literal
+	case "<=":
+		return minVal <= literal
+	case ">":
+		return maxVal > literal
+	case ">=":
+		return maxVal >= literal
+	}
+	return true
 }
 
 func (pr *Reader) Read(dst sql.Record) (rec sql.Record, rerr error) {
-	nextRow, err := pr.r.NextRow()
+	nextRow, err := pr.nextRow()
 	if err != nil {
 		if err == io.EOF {
 			return nil, err
@@ -55,15 +289,18 @@ func (pr *Reader) Read(dst sql.Record) (rec sql.Record, rerr error) {
 	}
 
 	kvs := jstream.KVS{}
-	for _, col := range pr.r.Columns() {
-		var value interface{}
-		if v, ok := nextRow[col.FlatName()]; ok {
-			value, err = convertFromAnnotation(col.Element(), v)
-			if err != nil {
-				return nil, errParquetParsingError(err)
+	for _, col := range pr.r.GetSchemaDefinition().RootColumn.Children {
+		name := col.SchemaElement.GetName()
+		if pr.selectedRoots != nil {
+			if _, ok := pr.selectedRoots[name]; !ok {
+				continue
 			}
 		}
-		kvs = append(kvs, jstream.KV{Key: col.FlatName(), Value: value})
+		value, err := convertColumnValue(col, nextRow[name])
+		if err != nil {
+			return nil, errParquetParsingError(err)
+		}
+		kvs = append(kvs, jstream.KV{Key: name, Value: value})
 	}
 
 	// Reuse destination if we can.
@@ -76,6 +313,132 @@ func (pr *Reader) Read(dst sql.Record) (rec sql.Record, rerr error) {
 	return dstRec, nil
 }
 
+// convertColumnValue - converts a column's raw value as assembled by the
+// parquet reader into record form: leaves are converted per their type
+// annotations, LIST groups become plain arrays and other groups become
+// nested KVS.
+func convertColumnValue(col *parquetschema.ColumnDefinition, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	se := col.SchemaElement
+	if se.Type != nil {
+		// Leaf column; repeated leaves are assembled as slices.
+		if vals, ok := v.([]interface{}); ok {
+			result := make([]interface{}, 0, len(vals))
+			for _, val := range vals {
+				cv, err := convertFromAnnotation(se, val)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, cv)
+			}
+			return result, nil
+		}
+		return convertFromAnnotation(se, v)
+	}
+
+	if isListAnnotated(se) {
+		return convertListValue(col, v)
+	}
+
+	return convertGroupValue(col, v)
+}
+
+// convertListValue - unwraps the parquet LIST structure
+// (<list-group>/<repeated-group>/<element>) into a plain array.
+func convertListValue(col *parquetschema.ColumnDefinition, v interface{}) (interface{}, error) {
+	group, ok := v.(map[string]interface{})
+	if !ok || len(col.Children) != 1 {
+		return convertGroupValue(col, v)
+	}
+
+	repeated := col.Children[0]
+	inner := group[repeated.SchemaElement.GetName()]
+	if inner == nil {
+		return []interface{}{}, nil
+	}
+
+	switch elems := inner.(type) {
+	case []map[string]interface{}:
+		// Standard three-level list: repeated group wrapping a single
+		// element field.
+		if len(repeated.Children) != 1 {
+			return convertGroupValue(col, v)
+		}
+		elemCol := repeated.Children[0]
+		elemName := elemCol.SchemaElement.GetName()
+		result := make([]interface{}, 0, len(elems))
+		for _, elem := range elems {
+			cv, err := convertColumnValue(elemCol, elem[elemName])
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, cv)
+		}
+		return result, nil
+	case []interface{}:
+		// Legacy two-level list: repeated element directly under the
+		// list group.
+		result := make([]interface{}, 0, len(elems))
+		for _, elem := range elems {
+			cv, err := convertColumnValue(repeated, elem)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, cv)
+		}
+		return result, nil
+	}
+
+	return convertGroupValue(col, v)
+}
+
+// convertGroupValue - converts a group (struct) column into nested KVS,
+// and a repeated group into an array of nested KVS.
+func convertGroupValue(col *parquetschema.ColumnDefinition, v interface{}) (interface{}, error) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		kvs := make(jstream.KVS, 0, len(col.Children))
+		for _, child := range col.Children {
+			name := child.SchemaElement.GetName()
+			v, ok := val[name]
+			if !ok {
+				// Null or deselected fields are omitted within groups.
+				continue
+			}
+			cv, err := convertColumnValue(child, v)
+			if err != nil {
+				return nil, err
+			}
+			kvs = append(kvs, jstream.KV{Key: name, Value: cv})
+		}
+		return kvs, nil
+	case []map[string]interface{}:
+		result := make([]interface{}, 0, len(val))
+		for _, elem := range val {
+			cv, err := convertGroupValue(col, elem)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, cv)
+		}
+		return result, nil
+	}
+
+	return v, nil
+}
+
+// isListAnnotated - returns true if the schema element carries the LIST
+// logical/converted type annotation.
+func isListAnnotated(se *parquettypes.SchemaElement) bool {
+	if logicalType := se.GetLogicalType(); logicalType != nil && logicalType.IsSetLIST() {
+		return true
+	}
+	return se.IsSetConvertedType() && se.GetConvertedType() == parquettypes.ConvertedType_LIST
+}
+
 // convertFromAnnotation - converts values based on the Parquet column's type
 // annotations. LogicalType annotations if present override the deprecated
 // ConvertedType annotations. Ref:
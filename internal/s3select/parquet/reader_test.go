@@ -0,0 +1,230 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package parquet
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	parquetgo "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/minio/minio/internal/s3select/jstream"
+	"github.com/minio/minio/internal/s3select/sql"
+)
+
+const nestedSchema = `message test {
+	required int64 id;
+	optional group s {
+		optional binary a (STRING);
+		optional group inner {
+			optional int64 b;
+		}
+	}
+	optional group l (LIST) {
+		repeated group list {
+			required int64 element;
+		}
+	}
+}`
+
+func writeNestedParquet(t *testing.T) string {
+	t.Helper()
+
+	sd, err := parquetschema.ParseSchemaDefinition(nestedSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join(t.TempDir(), "nested.parquet")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	fw := parquetgo.NewFileWriter(f, parquetgo.WithSchemaDefinition(sd))
+	err = fw.AddData(map[string]interface{}{
+		"id": int64(1),
+		"s": map[string]interface{}{
+			"a": []byte("foo"),
+			"inner": map[string]interface{}{
+				"b": int64(42),
+			},
+		},
+		"l": map[string]interface{}{
+			"list": []map[string]interface{}{
+				{"element": int64(10)},
+				{"element": int64(20)},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+func TestReadNested(t *testing.T) {
+	f, err := os.Open(writeNestedParquet(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pr, err := NewParquetReader(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+
+	rec, err := pr.Read(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, rawVal := rec.Raw()
+	kvs, ok := rawVal.(jstream.KVS)
+	if !ok {
+		t.Fatalf("unexpected raw record type %T", rawVal)
+	}
+
+	expected := jstream.KVS{
+		{Key: "id", Value: int64(1)},
+		{Key: "s", Value: jstream.KVS{
+			{Key: "a", Value: "foo"},
+			{Key: "inner", Value: jstream.KVS{
+				{Key: "b", Value: int64(42)},
+			}},
+		}},
+		{Key: "l", Value: []interface{}{int64(10), int64(20)}},
+	}
+	if !reflect.DeepEqual(expected, kvs) {
+		t.Fatalf("expected %#v, got %#v", expected, kvs)
+	}
+}
+
+func TestPredicatePushdown(t *testing.T) {
+	sd, err := parquetschema.ParseSchemaDefinition(`message test {
+	required int64 id;
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join(t.TempDir(), "groups.parquet")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fw := parquetgo.NewFileWriter(f, parquetgo.WithSchemaDefinition(sd))
+	for _, ids := range [][]int64{{1, 2, 3}, {100, 200, 300}} {
+		for _, id := range ids {
+			if err = fw.AddData(map[string]interface{}{"id": id}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = fw.FlushRowGroup(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	rf, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pr, err := NewParquetReader(rf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+
+	if count := pr.RowCount(); count != 6 {
+		t.Fatalf("expected row count 6, got %d", count)
+	}
+
+	literal := float64(50)
+	pr.SetPredicates([]sql.ColumnPredicate{{Path: []string{"id"}, Op: ">", Number: &literal}})
+
+	var got []int64
+	for {
+		rec, err := pr.Read(nil)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, rawVal := rec.Raw()
+		kvs := rawVal.(jstream.KVS)
+		got = append(got, kvs[0].Value.(int64))
+	}
+
+	expected := []int64{100, 200, 300}
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestReadNestedProjection(t *testing.T) {
+	f, err := os.Open(writeNestedParquet(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pr, err := NewParquetReader(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+
+	pr.SetSelectedPaths([][]string{{"s", "inner", "b"}})
+
+	rec, err := pr.Read(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, rawVal := rec.Raw()
+	kvs, ok := rawVal.(jstream.KVS)
+	if !ok {
+		t.Fatalf("unexpected raw record type %T", rawVal)
+	}
+
+	expected := jstream.KVS{
+		{Key: "s", Value: jstream.KVS{
+			{Key: "inner", Value: jstream.KVS{
+				{Key: "b", Value: int64(42)},
+			}},
+		}},
+	}
+	if !reflect.DeepEqual(expected, kvs) {
+		t.Fatalf("expected %#v, got %#v", expected, kvs)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package parquet
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	parquetgo "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/minio/minio/internal/s3select/jstream"
+	"github.com/minio/minio/internal/s3select/sql"
+)
+
+// Writer serializes select result records as a parquet file. The
+// schema is derived from the columns of the first record written.
+// Parquet stores its footer last, so the file bytes only become
+// available once Finish is called.
+type Writer struct {
+	buf     bytes.Buffer
+	scratch bytes.Buffer
+	fw      *parquetgo.FileWriter
+}
+
+// NewWriter creates a Writer.
+func NewWriter(_ *WriterArgs) *Writer {
+	return &Writer{}
+}
+
+// WriteRecord buffers a single record for the output file.
+func (w *Writer) WriteRecord(record sql.Record) error {
+	kvs, err := w.recordKVS(record)
+	if err != nil {
+		return err
+	}
+
+	if w.fw == nil {
+		sd, err := deriveSchema(kvs)
+		if err != nil {
+			return err
+		}
+		w.fw = parquetgo.NewFileWriter(&w.buf, parquetgo.WithSchemaDefinition(sd))
+	}
+
+	data, err := rowData(kvs)
+	if err != nil {
+		return err
+	}
+	if err = w.fw.AddData(data); err != nil {
+		return errParquetSerializationError(err)
+	}
+	return nil
+}
+
+// Finish writes the remaining row groups and the file footer into buf.
+// An empty result produces an empty payload since parquet has no
+// representation of a file without a schema.
+func (w *Writer) Finish(buf *bytes.Buffer) error {
+	if w.fw == nil {
+		return nil
+	}
+	if err := w.fw.Close(); err != nil {
+		return errParquetSerializationError(err)
+	}
+	_, err := buf.Write(w.buf.Bytes())
+	return err
+}
+
+// recordKVS extracts the columns of a record. Records of JSON-like
+// shape expose them directly; others (e.g. CSV) are round-tripped
+// through their JSON representation.
+func (w *Writer) recordKVS(record sql.Record) (jstream.KVS, error) {
+	if _, raw := record.Raw(); raw != nil {
+		if kvs, ok := raw.(jstream.KVS); ok {
+			return kvs, nil
+		}
+	}
+
+	w.scratch.Reset()
+	if err := record.WriteJSON(&w.scratch); err != nil {
+		return nil, err
+	}
+	d := jstream.NewDecoder(&w.scratch, 0).ObjectAsKVS()
+	for mv := range d.Stream() {
+		if kvs, ok := mv.Value.(jstream.KVS); ok {
+			return kvs, nil
+		}
+	}
+	return nil, errParquetSerializationError(fmt.Errorf("unsupported record type %T", record))
+}
+
+// deriveSchema builds a parquet schema definition from the columns of
+// the first record. All columns are optional; later records may omit
+// any of them.
+func deriveSchema(kvs jstream.KVS) (*parquetschema.SchemaDefinition, error) {
+	var sb strings.Builder
+	sb.WriteString("message s3select {\n")
+	for _, kv := range kvs {
+		if err := fieldSchema(&sb, kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
+	sb.WriteString("}")
+
+	sd, err := parquetschema.ParseSchemaDefinition(sb.String())
+	if err != nil {
+		return nil, errParquetSerializationError(err)
+	}
+	return sd, nil
+}
+
+func fieldSchema(sb *strings.Builder, name string, v interface{}) error {
+	switch val := v.(type) {
+	case bool:
+		fmt.Fprintf(sb, "optional boolean %s;\n", name)
+	case int64:
+		fmt.Fprintf(sb, "optional int64 %s;\n", name)
+	case float64:
+		fmt.Fprintf(sb, "optional double %s;\n", name)
+	case nil, string:
+		fmt.Fprintf(sb, "optional binary %s (STRING);\n", name)
+	case jstream.KVS:
+		fmt.Fprintf(sb, "optional group %s {\n", name)
+		for _, kv := range val {
+			if err := fieldSchema(sb, kv.Key, kv.Value); err != nil {
+				return err
+			}
+		}
+		sb.WriteString("}\n")
+	case []interface{}:
+		// Element type is taken from the first non-null element,
+		// defaulting to string.
+		var elem interface{}
+		for _, item := range val {
+			if item != nil {
+				elem = item
+				break
+			}
+		}
+		fmt.Fprintf(sb, "optional group %s (LIST) {\nrepeated group list {\n", name)
+		if err := fieldSchema(sb, "element", elem); err != nil {
+			return err
+		}
+		sb.WriteString("}\n}\n")
+	default:
+		return errParquetSerializationError(fmt.Errorf("unsupported value type %T for column %q", v, name))
+	}
+	return nil
+}
+
+// rowData converts record columns into the map form the parquet writer
+// expects; see convertColumnValue for the inverse.
+func rowData(kvs jstream.KVS) (map[string]interface{}, error) {
+	data := make(map[string]interface{}, len(kvs))
+	for _, kv := range kvs {
+		value, err := rowValue(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			continue
+		}
+		data[kv.Key] = value
+	}
+	return data, nil
+}
+
+func rowValue(v interface{}) (interface{}, error) {
+	switch val := v.(type) {
+	case nil:
+		return nil, nil
+	case bool, int64, float64:
+		return val, nil
+	case int:
+		return int64(val), nil
+	case string:
+		return []byte(val), nil
+	case jstream.KVS:
+		return rowData(val)
+	case []interface{}:
+		list := make([]map[string]interface{}, 0, len(val))
+		for _, item := range val {
+			element, err := rowValue(item)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, map[string]interface{}{"element": element})
+		}
+		return map[string]interface{}{"list": list}, nil
+	default:
+		return nil, errParquetSerializationError(fmt.Errorf("unsupported value type %T", v))
+	}
+}
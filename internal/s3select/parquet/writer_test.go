@@ -0,0 +1,103 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package parquet
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	jsonfmt "github.com/minio/minio/internal/s3select/json"
+	"github.com/minio/minio/internal/s3select/jstream"
+	"github.com/minio/minio/internal/s3select/sql"
+)
+
+func TestWriterRoundTrip(t *testing.T) {
+	rows := []jstream.KVS{
+		{
+			{Key: "id", Value: int64(1)},
+			{Key: "name", Value: "foo"},
+			{Key: "score", Value: 1.5},
+			{Key: "tags", Value: []interface{}{"a", "b"}},
+		},
+		{
+			{Key: "id", Value: int64(2)},
+			{Key: "name", Value: "bar"},
+			{Key: "score", Value: 2.5},
+			{Key: "tags", Value: []interface{}{"c"}},
+		},
+	}
+
+	w := NewWriter(nil)
+	for _, kvs := range rows {
+		rec := &jsonfmt.Record{SelectFormat: sql.SelectFmtJSON, KVS: kvs}
+		if err := w.WriteRecord(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := w.Finish(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	// Read the produced file back through the parquet reader.
+	name := filepath.Join(t.TempDir(), "out.parquet")
+	if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pr, err := NewParquetReader(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+
+	for i, expected := range rows {
+		rec, err := pr.Read(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, rawVal := rec.Raw()
+		kvs, ok := rawVal.(jstream.KVS)
+		if !ok {
+			t.Fatalf("unexpected raw record type %T", rawVal)
+		}
+		if !reflect.DeepEqual(expected, kvs) {
+			t.Fatalf("row %d: expected %#v, got %#v", i, expected, kvs)
+		}
+	}
+}
+
+func TestWriterEmptyResult(t *testing.T) {
+	w := NewWriter(nil)
+
+	var buf bytes.Buffer
+	if err := w.Finish(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected empty payload, got %d bytes", buf.Len())
+	}
+}
@@ -37,6 +37,7 @@ import (
 	xioutil "github.com/minio/minio/internal/ioutil"
 	"github.com/minio/minio/internal/s3select/csv"
 	"github.com/minio/minio/internal/s3select/json"
+	"github.com/minio/minio/internal/s3select/orc"
 	"github.com/minio/minio/internal/s3select/parquet"
 	"github.com/minio/minio/internal/s3select/simdj"
 	"github.com/minio/minio/internal/s3select/sql"
@@ -52,10 +53,22 @@ type recordReader interface {
 	Close() error
 }
 
+// metadataOnlyReader wraps a recordReader whose query result has
+// already been computed from file metadata; the scan terminates
+// immediately.
+type metadataOnlyReader struct {
+	recordReader
+}
+
+func (r *metadataOnlyReader) Read(sql.Record) (sql.Record, error) {
+	return nil, io.EOF
+}
+
 const (
 	csvFormat     = "csv"
 	jsonFormat    = "json"
 	parquetFormat = "parquet"
+	orcFormat     = "orc"
 )
 
 // CompressionType - represents value inside <CompressionType/> in request XML.
@@ -76,10 +89,14 @@ const (
 	maxRecordSize = 1 << 20 // 1 MiB
 )
 
-var parquetSupport bool
+var (
+	parquetSupport bool
+	orcSupport     bool
+)
 
 func init() {
 	parquetSupport = env.Get("MINIO_API_SELECT_PARQUET", config.EnableOff) == config.EnableOn
+	orcSupport = env.Get("MINIO_API_SELECT_ORC", config.EnableOff) == config.EnableOn
 }
 
 var bufPool = bpool.Pool[*bytes.Buffer]{
@@ -125,6 +142,7 @@ type InputSerialization struct {
 	CSVArgs         csv.ReaderArgs     `xml:"CSV"`
 	JSONArgs        json.ReaderArgs    `xml:"JSON"`
 	ParquetArgs     parquet.ReaderArgs `xml:"Parquet"`
+	ORCArgs         orc.ReaderArgs     `xml:"ORC"`
 	unmarshaled     bool
 	format          string
 }
@@ -165,6 +183,14 @@ func (input *InputSerialization) UnmarshalXML(d *xml.Decoder, start xml.StartEle
 		parsedInput.format = parquetFormat
 		found++
 	}
+	if !parsedInput.ORCArgs.IsEmpty() {
+		if parsedInput.CompressionType != "" && parsedInput.CompressionType != noneType {
+			return errInvalidRequestParameter(fmt.Errorf("CompressionType must be NONE for ORC format"))
+		}
+
+		parsedInput.format = orcFormat
+		found++
+	}
 
 	if found != 1 {
 		return errInvalidDataSource(nil)
@@ -177,8 +203,9 @@ func (input *InputSerialization) UnmarshalXML(d *xml.Decoder, start xml.StartEle
 
 // OutputSerialization - represents elements inside <OutputSerialization/> in request XML.
 type OutputSerialization struct {
-	CSVArgs     csv.WriterArgs  `xml:"CSV"`
-	JSONArgs    json.WriterArgs `xml:"JSON"`
+	CSVArgs     csv.WriterArgs     `xml:"CSV"`
+	JSONArgs    json.WriterArgs    `xml:"JSON"`
+	ParquetArgs parquet.WriterArgs `xml:"Parquet"`
 	unmarshaled bool
 	format      string
 }
@@ -206,8 +233,12 @@ func (output *OutputSerialization) UnmarshalXML(d *xml.Decoder, start xml.StartE
 		parsedOutput.format = jsonFormat
 		found++
 	}
+	if !parsedOutput.ParquetArgs.IsEmpty() {
+		parsedOutput.format = parquetFormat
+		found++
+	}
 	if found != 1 {
-		return errObjectSerializationConflict(fmt.Errorf("either CSV or JSON should be present in OutputSerialization"))
+		return errObjectSerializationConflict(fmt.Errorf("either CSV, JSON or Parquet should be present in OutputSerialization"))
 	}
 
 	*output = OutputSerialization(parsedOutput)
@@ -298,6 +329,7 @@ type S3Select struct {
 	statement      *sql.SelectStatement
 	progressReader *progressReader
 	recordReader   recordReader
+	parquetOutput  *parquet.Writer
 }
 
 var legacyXMLName = "SelectObjectContentRequest"
@@ -353,7 +385,7 @@ func (s3Select *S3Select) outputRecord() sql.Record {
 	switch s3Select.Output.format {
 	case csvFormat:
 		return csv.NewRecord()
-	case jsonFormat:
+	case jsonFormat, parquetFormat:
 		return json.NewRecord(sql.SelectFmtJSON)
 	}
 
@@ -371,6 +403,10 @@ func (s3Select *S3Select) getProgress() (bytesScanned, bytesProcessed int64) {
 // Open - opens S3 object by using callback for SQL selection query.
 // Currently CSV, JSON and Apache Parquet formats are supported.
 func (s3Select *S3Select) Open(rsc io.ReadSeekCloser) error {
+	if s3Select.Output.format == parquetFormat && !parquetSupport {
+		return errors.New("parquet format serialization not enabled on server")
+	}
+
 	offset, length, err := s3Select.ScanRange.StartLen()
 	if err != nil {
 		return err
@@ -457,9 +493,42 @@ func (s3Select *S3Select) Open(rsc io.ReadSeekCloser) error {
 			// Offsets do not make sense in parquet files.
 			return errors.New("parquet format does not support offsets")
 		}
-		var err error
-		s3Select.recordReader, err = parquet.NewParquetReader(rsc, &s3Select.Input.ParquetArgs)
-		return err
+		pr, err := parquet.NewParquetReader(rsc, &s3Select.Input.ParquetArgs)
+		if err != nil {
+			return err
+		}
+		if s3Select.statement.IsPlainCountStar() {
+			// Serve SELECT COUNT(*) from the file metadata without
+			// decoding any row groups.
+			s3Select.statement.SetCountStarResult(pr.RowCount())
+			s3Select.recordReader = &metadataOnlyReader{pr}
+			return nil
+		}
+		if paths, ok := s3Select.statement.SelectedColumnPaths(); ok {
+			pr.SetSelectedPaths(paths)
+		}
+		if preds := s3Select.statement.WhereColumnPredicates(); len(preds) > 0 {
+			pr.SetPredicates(preds)
+		}
+		s3Select.recordReader = pr
+		return nil
+	case orcFormat:
+		if !orcSupport {
+			return errors.New("orc format parsing not enabled on server")
+		}
+		if offset != 0 || length != -1 {
+			// Offsets do not make sense in ORC files.
+			return errors.New("orc format does not support offsets")
+		}
+		or, err := orc.NewORCReader(rsc, &s3Select.Input.ORCArgs)
+		if err != nil {
+			return err
+		}
+		if paths, ok := s3Select.statement.SelectedColumnPaths(); ok {
+			or.SetSelectedPaths(paths)
+		}
+		s3Select.recordReader = or
+		return nil
 	}
 
 	return fmt.Errorf("unknown input format '%v'", s3Select.Input.format)
@@ -508,6 +577,13 @@ func (s3Select *S3Select) marshal(buf *bytes.Buffer, record sql.Record) error {
 		buf.WriteString(s3Select.Output.JSONArgs.RecordDelimiter)
 
 		return nil
+	case parquetFormat:
+		// Records are buffered; the file bytes are emitted once the
+		// scan completes, since parquet stores its footer last.
+		if s3Select.parquetOutput == nil {
+			s3Select.parquetOutput = parquet.NewWriter(&s3Select.Output.ParquetArgs)
+		}
+		return s3Select.parquetOutput.WriteRecord(record)
 	}
 
 	panic(fmt.Errorf("unknown output format '%v'", s3Select.Output.format))
@@ -560,6 +636,28 @@ func (s3Select *S3Select) Evaluate(w http.ResponseWriter) {
 		return true
 	}
 
+	// flushOutput emits any output buffered by the serialization
+	// format (currently only parquet) once the scan completes.
+	flushOutput := func() bool {
+		if s3Select.parquetOutput == nil {
+			return true
+		}
+		buf := bufPool.Get()
+		buf.Reset()
+
+		if err = s3Select.parquetOutput.Finish(buf); err != nil {
+			bufPool.Put(buf)
+			return false
+		}
+		if err = writer.SendRecord(buf); err != nil {
+			// FIXME: log this error.
+			err = nil
+			bufPool.Put(buf)
+			return false
+		}
+		return true
+	}
+
 	var rec sql.Record
 OuterLoop:
 	for {
@@ -567,6 +665,9 @@ OuterLoop:
 			if !sendRecord() {
 				break
 			}
+			if !flushOutput() {
+				break
+			}
 			if err = writer.Finish(s3Select.getProgress()); err != nil {
 				// FIXME: log this error.
 				err = nil
@@ -591,6 +692,10 @@ OuterLoop:
 				break
 			}
 
+			if !flushOutput() {
+				break
+			}
+
 			if err = writer.Finish(s3Select.getProgress()); err != nil {
 				// FIXME: log this error.
 				err = nil
@@ -639,6 +744,9 @@ OuterLoop:
 					if !sendRecord() {
 						break
 					}
+					if !flushOutput() {
+						break
+					}
 					if err = writer.Finish(s3Select.getProgress()); err != nil {
 						// FIXME: log this error.
 						err = nil
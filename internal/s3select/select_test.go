@@ -124,6 +124,17 @@ func TestJSONQueries(t *testing.T) {
 			query:      "select SUM(s.id) from s3object s Where 2 in s.numbers[*] or 'some' in s.synonyms[*]",
 			wantResult: `{"_1":3}`,
 		},
+		{
+			name:  "limit-offset",
+			query: `SELECT id from s3object s LIMIT 2 OFFSET 1`,
+			wantResult: `{"id":1}
+{"id":2}`,
+		},
+		{
+			name:       "offset-with-where",
+			query:      `SELECT id from s3object s WHERE s.id > 0 OFFSET 2`,
+			wantResult: `{"id":3}`,
+		},
 		{
 			name:  "bignum-1",
 			query: `SELECT id from s3object s WHERE s.id <= 9223372036854775807`,
@@ -90,6 +90,7 @@ type Select struct {
 	From       *TableExpression  `parser:"\"FROM\" @@"`
 	Where      *Expression       `parser:"( \"WHERE\" @@ )?"`
 	Limit      *LitValue         `parser:"( \"LIMIT\" @@ )?"`
+	Offset     *LitValue         `parser:"( \"OFFSET\" @@ )?"`
 }
 
 // SelectExpression represents the items requested in the select
@@ -38,6 +38,8 @@ const (
 	SelectFmtSIMDJSON
 	// SelectFmtParquet - Parquet format
 	SelectFmtParquet
+	// SelectFmtORC - ORC format
+	SelectFmtORC
 )
 
 // WriteCSVOpts - encapsulates options for Select CSV output
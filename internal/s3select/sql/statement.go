@@ -26,7 +26,10 @@ import (
 	"github.com/minio/simdjson-go"
 )
 
-var errBadLimitSpecified = errors.New("Limit value must be a positive integer")
+var (
+	errBadLimitSpecified  = errors.New("Limit value must be a positive integer")
+	errBadOffsetSpecified = errors.New("Offset value must be a positive integer")
+)
 
 const (
 	baseTableName = "s3object"
@@ -43,6 +46,13 @@ type SelectStatement struct {
 	// (otherwise -1)
 	limitValue int64
 
+	// Result of parsing the offset clause if one is present
+	// (otherwise 0)
+	offsetValue int64
+
+	// Count of rows that have been skipped by the offset clause.
+	skippedCount int64
+
 	// Count of rows that have been output.
 	outputCount int64
 
@@ -83,6 +93,13 @@ func ParseSelectStatement(s string) (stmt SelectStatement, err error) {
 		return
 	}
 
+	// Check the parsed offset value
+	stmt.offsetValue, err = parseOffset(selectAST.Offset)
+	if err != nil {
+		err = errQueryAnalysisFailure(err)
+		return
+	}
+
 	// Analyze where clause
 	if selectAST.Where != nil {
 		whereQProp := selectAST.Where.analyze(&selectAST)
@@ -151,6 +168,21 @@ func parseLimit(v *LitValue) (int64, error) {
 	}
 }
 
+func parseOffset(v *LitValue) (int64, error) {
+	switch {
+	case v == nil:
+		return 0, nil
+	case v.Int == nil:
+		return 0, errBadOffsetSpecified
+	default:
+		r := int64(*v.Int)
+		if r < 0 {
+			return 0, errBadOffsetSpecified
+		}
+		return r, nil
+	}
+}
+
 // EvalFrom evaluates the From clause on the input record. It only
 // applies to JSON input data format (currently).
 func (e *SelectStatement) EvalFrom(format string, input Record) ([]*Record, error) {
@@ -235,6 +267,128 @@ func (e *SelectStatement) IsAggregated() bool {
 	return e.selectQProp.isAggregation
 }
 
+// SelectedColumnPaths returns the column paths directly projected by the
+// statement when they can be derived statically - no SELECT *, no WHERE
+// clause and every select expression a plain (possibly nested) column
+// reference. Index and wildcard steps select their whole column subtree.
+// Readers supporting projection pushdown use this to decode only the
+// referenced columns; ok is false when the full input is required.
+func (e *SelectStatement) SelectedColumnPaths() (paths [][]string, ok bool) {
+	sel := e.selectAST
+	if sel == nil || sel.Where != nil || sel.Expression == nil || sel.Expression.All {
+		return nil, false
+	}
+
+	alias := e.tableAlias
+	if alias == "" {
+		alias = baseTableName
+	}
+
+	for _, expr := range sel.Expression.Expressions {
+		jp := simpleJSONPath(expr.Expression)
+		if jp == nil {
+			return nil, false
+		}
+
+		var path []string
+		for _, elem := range jp.StripTableAlias(alias) {
+			if elem.Key == nil {
+				// Index and wildcard steps address within a column.
+				break
+			}
+			path = append(path, elem.Key.keyString())
+		}
+		if len(path) == 0 {
+			return nil, false
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, len(paths) > 0
+}
+
+// ColumnPredicate describes a simple comparison of a column against a
+// literal, extracted from the WHERE clause. Readers supporting predicate
+// pushdown use these to skip chunks of input that cannot satisfy the
+// query; each predicate is individually necessary for a row to match.
+type ColumnPredicate struct {
+	Path []string
+	Op   string // one of "=", "<", "<=", ">" or ">="
+
+	// Exactly one of the following literal values is set.
+	Number *float64
+	String *string
+}
+
+// WhereColumnPredicates returns the simple column-versus-literal
+// comparisons from the WHERE clause that can be derived statically. OR
+// expressions, negations and anything more complex than `path <op>
+// literal` yield no predicates.
+func (e *SelectStatement) WhereColumnPredicates() (preds []ColumnPredicate) {
+	where := e.selectAST.Where
+	if where == nil || len(where.And) != 1 {
+		return nil
+	}
+
+	alias := e.tableAlias
+	if alias == "" {
+		alias = baseTableName
+	}
+
+	for _, cond := range where.And[0].Condition {
+		if cond.Not != nil || cond.Operand == nil || cond.Operand.ConditionRHS == nil {
+			continue
+		}
+		cmp := cond.Operand.ConditionRHS.Compare
+		if cmp == nil {
+			continue
+		}
+		switch cmp.Operator {
+		case "=", "<", "<=", ">", ">=":
+		default:
+			continue
+		}
+
+		lhs := simplePrimaryTerm(cond.Operand.Operand)
+		rhs := simplePrimaryTerm(cmp.Operand)
+		if lhs == nil || lhs.JPathExpr == nil || rhs == nil || rhs.Value == nil {
+			continue
+		}
+
+		var path []string
+		for _, elem := range lhs.JPathExpr.StripTableAlias(alias) {
+			if elem.Key == nil {
+				// Index and wildcard steps address within a column -
+				// column level statistics cannot be applied.
+				path = nil
+				break
+			}
+			path = append(path, elem.Key.keyString())
+		}
+		if len(path) == 0 {
+			continue
+		}
+
+		pred := ColumnPredicate{Path: path, Op: cmp.Operator}
+		switch {
+		case rhs.Value.Int != nil:
+			number := *rhs.Value.Int
+			pred.Number = &number
+		case rhs.Value.Float != nil:
+			number := *rhs.Value.Float
+			pred.Number = &number
+		case rhs.Value.String != nil:
+			str := string(*rhs.Value.String)
+			pred.String = &str
+		default:
+			continue
+		}
+		preds = append(preds, pred)
+	}
+
+	return preds
+}
+
 // AggregateResult - returns the aggregated result after all input
 // records have been processed. Applies only to aggregation queries.
 func (e *SelectStatement) AggregateResult(output Record) error {
@@ -284,6 +438,12 @@ func (e *SelectStatement) AggregateRow(input Record) error {
 		return nil
 	}
 
+	if e.skippedCount < e.offsetValue {
+		// Row is consumed by the OFFSET clause.
+		e.skippedCount++
+		return nil
+	}
+
 	for _, expr := range e.selectAST.Expression.Expressions {
 		err := expr.aggregateRow(input, e.tableAlias)
 		if err != nil {
@@ -303,6 +463,12 @@ func (e *SelectStatement) Eval(input, output Record) (Record, error) {
 		return nil, err
 	}
 
+	if e.skippedCount < e.offsetValue {
+		// Row is consumed by the OFFSET clause.
+		e.skippedCount++
+		return nil, nil
+	}
+
 	if e.selectAST.Expression.All {
 		// Return the input record for `SELECT * FROM
 		// .. WHERE ..`
@@ -346,3 +512,33 @@ func (e *SelectStatement) LimitReached() bool {
 	}
 	return e.outputCount >= e.limitValue
 }
+
+// IsPlainCountStar returns whether the statement is exactly `SELECT
+// COUNT(*)` with no WHERE or OFFSET clause, in which case the result
+// can be computed from input metadata without decoding any records.
+func (e *SelectStatement) IsPlainCountStar() bool {
+	if e.selectAST.Where != nil || e.selectAST.Expression.All ||
+		len(e.selectAST.Expression.Expressions) != 1 ||
+		e.offsetValue > 0 {
+		return false
+	}
+
+	expr := e.selectAST.Expression.Expressions[0].Expression
+	if len(expr.And) != 1 || len(expr.And[0].Condition) != 1 ||
+		expr.And[0].Condition[0].Not != nil ||
+		expr.And[0].Condition[0].Operand.ConditionRHS != nil {
+		return false
+	}
+
+	primary := simplePrimaryTerm(expr.And[0].Condition[0].Operand.Operand)
+	return primary != nil && primary.FuncCall != nil &&
+		primary.FuncCall.Count != nil && primary.FuncCall.Count.StarArg
+}
+
+// SetCountStarResult sets the result of a plain `SELECT COUNT(*)`
+// statement that has been computed from input metadata. Must only be
+// called when IsPlainCountStar returns true.
+func (e *SelectStatement) SetCountStarResult(count int64) {
+	primary := simplePrimaryTerm(e.selectAST.Expression.Expressions[0].Expression.And[0].Condition[0].Operand.Operand)
+	primary.FuncCall.aggregate.runningCount = count
+}
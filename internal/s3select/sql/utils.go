@@ -127,6 +127,34 @@ func getLastKeypathComponent(e *Expression) (string, bool) {
 	return ps, true
 }
 
+// simpleJSONPath checks if the given expression is a plain path
+// expression without any operators or functions, and if so returns it.
+func simpleJSONPath(e *Expression) *JSONPath {
+	if e == nil || len(e.And) > 1 ||
+		len(e.And[0].Condition) > 1 ||
+		e.And[0].Condition[0].Not != nil ||
+		e.And[0].Condition[0].Operand.ConditionRHS != nil {
+		return nil
+	}
+
+	primary := simplePrimaryTerm(e.And[0].Condition[0].Operand.Operand)
+	if primary == nil {
+		return nil
+	}
+	return primary.JPathExpr
+}
+
+// simplePrimaryTerm returns the primary term the operand consists of, if
+// it carries no arithmetic operators or negation.
+func simplePrimaryTerm(op *Operand) *PrimaryTerm {
+	if op == nil || op.Right != nil ||
+		op.Left == nil || op.Left.Right != nil ||
+		op.Left.Left == nil || op.Left.Left.Negated != nil {
+		return nil
+	}
+	return op.Left.Left.Primary
+}
+
 // HasKeypath returns if the from clause has a key path -
 // e.g. S3object[*].id
 func (from *TableExpression) HasKeypath() bool {